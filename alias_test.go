@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type QualifiedDB interface {
+	DSN() string
+}
+
+type qualifiedDB struct {
+	dsn string
+}
+
+func (t *qualifiedDB) DSN() string {
+	return t.dsn
+}
+
+type namedReplicaDB struct {
+	qualifiedDB
+}
+
+func (t *namedReplicaDB) BeanName() string {
+	return "replicaDB"
+}
+
+type qualifiedDBConsumer struct {
+	Primary QualifiedDB `inject:"bean=primaryDB"`
+	Replica QualifiedDB `inject:"bean=replicaDB"`
+	Reports QualifiedDB `inject:"bean=reportsDB"`
+}
+
+func TestInjectQualifiedByNameAndAlias(t *testing.T) {
+
+	skipUnsupported(t, glue.FeatureConstructors)
+
+	consumer := &qualifiedDBConsumer{}
+	ctn, err := glue.New(
+		glue.Alias(&qualifiedDB{dsn: "primary"}, "primaryDB"),
+		&namedReplicaDB{qualifiedDB{dsn: "replica"}},
+		glue.Alias(func() *qualifiedDB { return &qualifiedDB{dsn: "reports"} }, "reportsDB"),
+		consumer,
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, "primary", consumer.Primary.DSN())
+	require.Equal(t, "replica", consumer.Replica.DSN())
+	require.Equal(t, "reports", consumer.Reports.DSN())
+
+	list := ctn.Lookup("primaryDB", glue.DefaultSearchLevel)
+	require.Equal(t, 1, len(list))
+	require.Equal(t, "primary", list[0].Object().(QualifiedDB).DSN())
+}
+
+func TestInjectQualifiedUnknown(t *testing.T) {
+
+	_, err := glue.New(
+		glue.Alias(&qualifiedDB{dsn: "primary"}, "primaryDB"),
+		&qualifiedDBConsumer{},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "replicaDB")
+}
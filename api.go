@@ -8,6 +8,7 @@ package glue
 import (
 	"context"
 	"io"
+	"io/fs"
 	"net/http"
 	"os"
 	"reflect"
@@ -16,9 +17,12 @@ import (
 
 type BeanLifecycle int32
 
-// ActiveProfilesProperty - use mostly in Child containers
+// ActiveProfilesProperty - use mostly in Child containers, the container sets it to the effective list of active profiles
 var ActiveProfilesProperty = "glue.profiles.active"
 
+// ConfigSchemaVersionProperty - the semantic version of the configuration layout, upgraded by ConfigMigration beans at load time
+var ConfigSchemaVersionProperty = "config.schema.version"
+
 const (
 	BeanAllocated BeanLifecycle = iota
 	BeanCreated
@@ -117,6 +121,11 @@ type Bean interface {
 	*/
 	Lifecycle() BeanLifecycle
 
+	/*
+		Returns description of the bean if it implements DescribedBean or was registered with glue.Described
+	*/
+	Description() string
+
 	/*
 		Returns information about the bean
 	*/
@@ -142,6 +151,55 @@ type ContainerOptions struct {
 	ActiveProfiles []string
 	Beans          []any
 	Logger         ContainerLogger
+	History        *History
+	LifecycleHooks []LifecycleHook
+	StartupTimeout time.Duration
+	ValueDefaults  *ValueDefaults
+
+	PostConstructTimeout time.Duration
+	DestroyTimeout       time.Duration
+
+	InjectionReport bool
+
+	LogLevel    LogLevel
+	LogSampling int
+
+	PropertyAuditor       PropertyAuditor
+	PropertyAuditSampling int
+
+	Detached bool
+
+	Labels Labels
+
+	PropertyFreeze FreezeMode
+
+	Seal bool
+
+	Chaos     bool
+	ChaosSeed int64
+}
+
+/**
+ValueDefaults configures 'value' tag behavior for all beans of the container,
+tag options of the field take precedence.
+*/
+
+type ValueDefaults struct {
+	/*
+		TimeLayout is used for time.Time fields without 'layout' option, RFC3339 if empty
+	*/
+	TimeLayout string
+
+	/*
+		Separator splits property values in to slice fields, ";" if empty
+	*/
+	Separator string
+
+	/*
+		AllowMissing keeps the zero value of the field if the property is missing and has no default value,
+		otherwise container creation fails
+	*/
+	AllowMissing bool
 }
 
 type ContainerOption func(*ContainerOptions)
@@ -174,6 +232,95 @@ func WithBeans(scan ...any) ContainerOption {
 	}
 }
 
+/*
+WithHistory records lifecycle transitions and container events in the given history,
+available even if the container failed to start.
+*/
+
+func WithHistory(history *History) ContainerOption {
+	return func(opts *ContainerOptions) {
+		opts.History = history
+	}
+}
+
+/*
+WithLifecycleHook calls hooks on every lifecycle transition of beans of the container and its children,
+e.g. to record timing of phases.
+*/
+
+func WithLifecycleHook(hooks ...LifecycleHook) ContainerOption {
+	return func(opts *ContainerOptions) {
+		opts.LifecycleHooks = append(opts.LifecycleHooks, hooks...)
+	}
+}
+
+/*
+WithStartupTimeout aborts container creation that takes longer than timeout
+with the report of pending and the slowest beans.
+*/
+
+func WithStartupTimeout(timeout time.Duration) ContainerOption {
+	return func(opts *ContainerOptions) {
+		opts.StartupTimeout = timeout
+	}
+}
+
+/*
+WithPostConstructTimeout limits PostConstruct of every bean, the bean that did not finish in time fails container creation.
+Child containers inherit the timeout unless they have their own.
+*/
+
+func WithPostConstructTimeout(timeout time.Duration) ContainerOption {
+	return func(opts *ContainerOptions) {
+		opts.PostConstructTimeout = timeout
+	}
+}
+
+/*
+WithDestroyTimeout limits Destroy of every bean, separately from PostConstruct.
+Close logs the goroutine stack of the stuck Destroy and continues with other beans after the timeout.
+Child containers inherit the timeout unless they have their own.
+*/
+
+func WithDestroyTimeout(timeout time.Duration) ContainerOption {
+	return func(opts *ContainerOptions) {
+		opts.DestroyTimeout = timeout
+	}
+}
+
+/*
+WithPropertyAuditor notifies the auditor about every sampling-th read of container properties
+with the origin of the value and the bean reading it during injection.
+*/
+
+func WithPropertyAuditor(auditor PropertyAuditor, sampling int) ContainerOption {
+	return func(opts *ContainerOptions) {
+		opts.PropertyAuditor = auditor
+		opts.PropertyAuditSampling = sampling
+	}
+}
+
+/*
+WithInjectionReport records values injected in to fields of beans, available by Container.Injections().
+Values of properties matching mask patterns are masked.
+*/
+
+func WithInjectionReport() ContainerOption {
+	return func(opts *ContainerOptions) {
+		opts.InjectionReport = true
+	}
+}
+
+/*
+WithValueDefaults sets defaults of 'value' tags, child containers inherit them unless they have their own.
+*/
+
+func WithValueDefaults(defaults ValueDefaults) ContainerOption {
+	return func(opts *ContainerOptions) {
+		opts.ValueDefaults = &defaults
+	}
+}
+
 func WithScanner(scanner Scanner) ContainerOption {
 	return func(opts *ContainerOptions) {
 		opts.Beans = append(opts.Beans, scanner.ScannerBeans()...)
@@ -186,6 +333,83 @@ func WithLogger(log ContainerLogger) ContainerOption {
 	}
 }
 
+/**
+WithLogLevel limits messages of the container logger, child containers inherit the level.
+*/
+
+func WithLogLevel(level LogLevel) ContainerOption {
+	return func(opts *ContainerOptions) {
+		opts.LogLevel = level
+	}
+}
+
+/**
+WithLogSampling writes only every Nth wiring message of LogDebug level, e.g. bean construction and field injection,
+so verbose mode stays cheap in large graphs. Registration, error and summary messages are not sampled.
+*/
+
+func WithLogSampling(every int) ContainerOption {
+	return func(opts *ContainerOptions) {
+		opts.LogSampling = every
+	}
+}
+
+/**
+WithChaos turns on the test-only chaos mode: the order of independent beans on initialization and destruction,
+the order of event listeners without explicit order and the timing of property refresh are randomized by the seed,
+so suites shake out order-dependent bugs and reproduce them by the same seed. Child containers inherit the mode.
+*/
+
+func WithChaos(seed int64) ContainerOption {
+	return func(opts *ContainerOptions) {
+		opts.Chaos = true
+		opts.ChaosSeed = seed
+	}
+}
+
+/**
+WithPropertyFreeze freezes properties of the container after it started, see FreezeMode.
+*/
+
+func WithPropertyFreeze(mode FreezeMode) ContainerOption {
+	return func(opts *ContainerOptions) {
+		opts.PropertyFreeze = mode
+	}
+}
+
+/**
+WithLabels adds labels identifying the container in metrics, graphs and debug outputs.
+*/
+
+func WithLabels(labels Labels) ContainerOption {
+	return func(opts *ContainerOptions) {
+		opts.Labels = labels
+	}
+}
+
+/**
+WithSeal seals the container after it started, see Container.Seal.
+Combine with WithPropertyFreeze(FreezeStrict) to make properties read-only as well.
+*/
+
+func WithSeal() ContainerOption {
+	return func(opts *ContainerOptions) {
+		opts.Seal = true
+	}
+}
+
+/**
+WithDetached creates the child container that is not closed together with its parent,
+by default children created by Extend are closed before the parent destroys its beans.
+The detached child must be closed before the parent, since its beans may depend on beans of the parent.
+*/
+
+func WithDetached() ContainerOption {
+	return func(opts *ContainerOptions) {
+		opts.Detached = true
+	}
+}
+
 /**
 Container interface is why this framework exist, maintains the set of beans and relations between them.
 */
@@ -211,11 +435,23 @@ type Container interface {
 	*/
 	ExtendWithOptions(options ...ContainerOption) (Container, error)
 
+	/*
+		CloneWith - creates a new container with the same scan list and options as the current one,
+		given properties override properties of the original container
+	*/
+	CloneWith(props map[string]string) (Container, error)
+
 	/*
 		Children - Returns list of ctx container inside the current container only
 	*/
 	Children() []ChildContainer
 
+	/*
+		Find returns the child container by name or by path of names separated by ChildPathSeparator, e.g. "tenants/acme".
+		Names are not unique, the first declared child matches. Intermediate containers of the path are built on demand.
+	*/
+	Find(path string) (ChildContainer, error)
+
 	/*
 		Close - Destroy all beans that implement interface DisposableBean
 	*/
@@ -226,6 +462,12 @@ type Container interface {
 	*/
 	CloseWithContext(ctx context.Context) error
 
+	/*
+		OnClose - Registers the cleanup function called on close after child containers are closed
+		and before beans are destroyed, functions are called in reverse order of registration
+	*/
+	OnClose(fn func())
+
 	/*
 		Reload - Re-resolve static value properties and reinitialize bean by calling
 		Destroy then PostConstruct. Dynamic func() properties are not affected since
@@ -240,6 +482,53 @@ type Container interface {
 	*/
 	ReloadWithContext(ctx context.Context, bean Bean) error
 
+	/*
+		Register - Injects and initializes the bean after the container creation and adds it
+		to the live container, so the following lookups and injections find it.
+		Accepts the same wrappers as scan, except factory beans.
+		The bean is destroyed on close together with other beans.
+	*/
+	Register(obj any) (Bean, error)
+
+	/*
+		RegisterWithContext - same as Register but with provided context for context-aware lifecycle interfaces
+	*/
+	RegisterWithContext(ctx context.Context, obj any) (Bean, error)
+
+	/*
+		Unregister - Destroys the bean added by Register and removes it from the container.
+		Fails if the bean is injected into live beans, lazy fields referencing it are set to nil
+		or lose the element, providers resolve again and fail if there is no other candidate.
+	*/
+	Unregister(name string) error
+
+	/*
+		UnregisterWithContext - same as Unregister but with provided context for context-aware lifecycle interfaces
+	*/
+	UnregisterWithContext(ctx context.Context, name string) error
+
+	/*
+		Seal - Forbids further changes of the object graph, Register, Unregister and Extend return ErrContainerSealed.
+		Child containers declared by glue.Child are still created on demand. Sealing can not be undone.
+	*/
+	Seal()
+
+	/*
+		Sealed - returns true if the container was sealed
+	*/
+	Sealed() bool
+
+	/*
+		Refresh - Reloads beans of the given types in the current container and, transitively, all beans depending on them:
+		dependents are destroyed first, then beans are reinitialized from dependencies to dependents.
+	*/
+	Refresh(types ...reflect.Type) error
+
+	/*
+		RefreshWithContext - same as Refresh but with provided context for context-aware lifecycle interfaces
+	*/
+	RefreshWithContext(ctx context.Context, types ...reflect.Type) error
+
 	/*
 		Core - Get list of all registered instances on creation of container with scope 'core'
 	*/
@@ -266,6 +555,12 @@ type Container interface {
 	*/
 	Bean(typ reflect.Type, level int) []Bean
 
+	/*
+		Single returns the only bean of the type in the container or its parents,
+		the error lists all candidates when zero or many beans match, primary beans are not preferred.
+	*/
+	Single(typ reflect.Type) (Bean, error)
+
 	/*
 		Lookup registered beans in container by name.
 		The name is the local package plus name of the interface, for example 'app.UserService'
@@ -303,6 +598,20 @@ type Container interface {
 	*/
 	Resource(path string) (Resource, bool)
 
+	/*
+		Resources returns resources with the path starting with the prefix, for example "resources:migrations/",
+		merged across resource sources with the same name of this and parent containers, sorted by path.
+		Elements implement NamedResource. Paths ending with "/" are directories for Resource, their files support Readdir.
+	*/
+	Resources(prefix string) []Resource
+
+	/*
+		WatchResource - polls the resource or the "file:path" with ResourceWatchIntervalProperty and calls cb
+		from the watching goroutine when its content changes. Returns the function stopping the watch,
+		watching stops on close before beans are destroyed.
+	*/
+	WatchResource(path string, cb func(Resource)) (func(), error)
+
 	/*
 		Returns container placeholder properties
 	*/
@@ -314,6 +623,113 @@ type Container interface {
 	*/
 	Graph() string
 
+	/*
+		DependencyGraph returns beans of the current container with lifecycle states and injection points
+		annotated as lazy or optional, render it by BeanGraph.DOT or BeanGraph.JSON.
+	*/
+	DependencyGraph() *BeanGraph
+
+	/*
+		Beans returns descriptors of all beans of the container, registered at runtime and of live extended containers
+		with lifecycle, origin, dependencies and injection points sorted by origin and name,
+		optionally only beans in the given states.
+	*/
+	Beans(states ...BeanLifecycle) []BeanInfo
+
+	/*
+		ContainerReport returns the dump of beans, masked properties, resources, declared children
+		and live extended containers for bug reports and admin endpoints.
+	*/
+	ContainerReport() *ContainerReport
+
+	/*
+		Report - same as ContainerReport rendered in the format, ReportJSON or ReportYAML
+	*/
+	Report(format ReportFormat) ([]byte, error)
+
+	/*
+		History returns recorded lifecycle transitions and container events from the oldest to the newest.
+	*/
+	History() []HistoryEvent
+
+	/*
+		InitReport returns timing of bean phases collected by the container, the slowest PostConstruct first.
+	*/
+	InitReport() InitReport
+
+	/*
+		Health runs checks of HealthChecker beans of the container and its extended containers concurrently
+		and returns the aggregated report.
+	*/
+	Health() HealthReport
+
+	/*
+		HealthWithContext - same as Health but with provided context passed to checks
+	*/
+	HealthWithContext(ctx context.Context) HealthReport
+
+	/*
+		Injections returns values injected in to fields of beans of the current container,
+		recorded only if the container was created with WithInjectionReport option.
+	*/
+	Injections() []InjectionRecord
+
+	/*
+		UsageReport returns how many times each bean of the container was injected or looked up at runtime
+		and the statistics of requested interface and pointer types, helps to find obsolete beans and interfaces
+	*/
+	UsageReport() UsageReport
+
+	/*
+		NewRequestScope creates the request scope bound to the container, so request scoped beans
+		are resolved from the context by RequestScope.Resolve and GetScopedBean without injected providers.
+	*/
+	NewRequestScope() RequestScope
+
+	/*
+		Labels returns the copy of labels of the container including inherited from the parent
+	*/
+	Labels() Labels
+
+	/*
+		Profiles returns active profiles of the container, the same bean is injected by glue.Profiles
+	*/
+	Profiles() Profiles
+
+	/*
+		HasProfile returns true if the profile is active
+	*/
+	HasProfile(name string) bool
+
+	/*
+		ConfigMigrations returns the chain of configuration migrations applied at load time in order
+	*/
+	ConfigMigrations() []AppliedMigration
+
+	/*
+		NewScope creates the lightweight scope with scope-local beans for high-frequency use, e.g. per HTTP request.
+		Beans are injected from each other and from the container, types are analyzed once and scopes are pooled,
+		Close destroys only scope-local beans and request scoped instances.
+	*/
+	NewScope(scan ...any) (ScopedContext, error)
+
+	/*
+		NewScopeWithContext - same as NewScope but with provided context for context-aware PostConstruct
+	*/
+	NewScopeWithContext(ctx context.Context, scan ...any) (ScopedContext, error)
+
+	/*
+		Describe returns the human readable list of beans in the current container
+		with type, lifecycle and description.
+	*/
+	Describe() string
+
+	/*
+		Partition splits beans of the current container in to weakly connected clusters,
+		shared beans are excluded from clustering and the edges to them are reported as cross-cluster edges.
+	*/
+	Partition(shared ...string) GraphPartition
+
 	/*
 		Returns information about container
 	*/
@@ -346,6 +762,58 @@ request-scoped disposables when the scope is closed.
 type RequestScope interface {
 	Close() error
 	CloseWithContext(ctx context.Context) error
+
+	/*
+		Resolve returns the instance of the request scoped bean of the type cached in the scope,
+		works only for scopes created by Container.NewRequestScope.
+	*/
+	Resolve(ctx context.Context, typ reflect.Type) (any, error)
+}
+
+/*
+ScopedContext is the scope created by Container.NewScope, it is also the RequestScope of request scoped beans.
+The scope returns to the pool on Close, so it must not be used after that.
+*/
+type ScopedContext interface {
+	RequestScope
+
+	/*
+		Container returns the container of the scope
+	*/
+	Container() Container
+
+	/*
+		Context returns the context carrying the scope, request scoped beans are resolved from it
+	*/
+	Context(parent context.Context) context.Context
+}
+
+var ProfilesClass = reflect.TypeOf((*Profiles)(nil)).Elem()
+
+/*
+	Profiles is the bean with active profiles of the container, so beans adapt behavior to the environment:
+
+	type server struct {
+		Profiles glue.Profiles `inject:""`
+	}
+*/
+
+type Profiles interface {
+
+	/*
+		List returns active profiles in the order of activation
+	*/
+	List() []string
+
+	/*
+		Has returns true if the profile is active
+	*/
+	Has(name string) bool
+
+	/*
+		Matches evaluates the profile expression, e.g. "dev|staging", "!prod" or "dev&local"
+	*/
+	Matches(expression string) bool
 }
 
 var ProfileBeanClass = reflect.TypeOf((*ProfileBean)(nil)).Elem()
@@ -391,6 +859,20 @@ type ConditionalBean interface {
 	ShouldRegisterBean() bool
 }
 
+var PropertyConditionalBeanClass = reflect.TypeOf((*PropertyConditionalBean)(nil)).Elem()
+
+/*
+PropertyConditionalBean is optionally implemented by beans that are registered depending on properties.
+It is evaluated after scanning, when property sources and property resolvers are loaded, but before injection.
+*/
+type PropertyConditionalBean interface {
+
+	/*
+	   ShouldRegisterWithProperties returns true if this bean should be registered in the container.
+	*/
+	ShouldRegisterWithProperties(properties Properties) bool
+}
+
 var ScannerClass = reflect.TypeOf((*Scanner)(nil)).Elem()
 
 /*
@@ -537,6 +1019,144 @@ type ContextFactoryBean interface {
 	Singleton() bool
 }
 
+var RunnableClass = reflect.TypeOf((*Runnable)(nil)).Elem()
+
+/*
+Runnable beans are started by glue.Run in background and work until the context is cancelled on shutdown.
+Returning nil finishes the runnable, returning the error stops the application.
+*/
+
+type Runnable interface {
+	Run(ctx context.Context) error
+}
+
+var ServerClass = reflect.TypeOf((*Server)(nil)).Elem()
+
+/*
+Server beans are served by glue.Run in background and shut down gracefully, e.g. the wrapper of *http.Server.
+Serve returning http.ErrServerClosed is the normal stop.
+*/
+
+type Server interface {
+	Serve() error
+	Shutdown(ctx context.Context) error
+}
+
+var StarterClass = reflect.TypeOf((*Starter)(nil)).Elem()
+
+/*
+Starter beans are long-running components, e.g. servers and consumers, started after all beans of the container
+are initialized in the ascending Phase order and stopped on close in reverse order, before beans are destroyed.
+The failed Start stops already started beans and fails the container creation.
+*/
+
+type Starter interface {
+	Start() error
+	Stop() error
+	Phase() int
+}
+
+var HealthCheckerClass = reflect.TypeOf((*HealthChecker)(nil)).Elem()
+
+/*
+HealthChecker beans report their health, e.g. ping the database, see Container.Health.
+Each check is bounded by DefaultHealthTimeout, the error or panic reports the bean as down.
+*/
+
+type HealthChecker interface {
+	CheckHealth(ctx context.Context) error
+}
+
+var FactoryBeanCtxClass = reflect.TypeOf((*FactoryBeanCtx)(nil)).Elem()
+
+/*
+FactoryBeanCtx is implemented by FactoryBean in addition to Object() to receive the construction context,
+ObjectCtx is called instead of Object by the container. The context is cancelled when the container creation
+fails or exceeds the startup timeout, so slow factories (dialing brokers, loading models) abort cooperatively.
+The object returned after cancellation is destroyed if it is DisposableBean or ContextDisposableBean.
+*/
+
+type FactoryBeanCtx interface {
+	FactoryBean
+
+	/*
+		returns an object produced by the factory, ctx is the construction context of the container
+	*/
+	ObjectCtx(ctx context.Context) (any, error)
+}
+
+var TypeFactoryClass = reflect.TypeOf((*TypeFactory)(nil)).Elem()
+
+/*
+TypeFactory creates beans declared in properties, e.g. 'beans.userCache.type=lru' and 'beans.userCache.size=1000'
+declare the bean 'userCache' created by the factory with BeanType "lru". Declared beans are registered
+as if they were in the scan list, 'value' fields are read relative to 'beans.<name>', so the number of instances
+and their parameters change in configuration without code changes.
+*/
+
+type TypeFactory interface {
+
+	/*
+		returns the value of the 'type' property of declarations created by this factory
+	*/
+	BeanType() string
+
+	/*
+		returns the new object of the declared bean, props are properties of the declaration without the prefix and the type
+	*/
+	NewBean(name string, props map[string]string) (any, error)
+}
+
+var CollectionFactoryBeanClass = reflect.TypeOf((*CollectionFactoryBean)(nil)).Elem()
+
+/*
+CollectionFactoryBean produces the dynamic set of beans from configuration, e.g. one consumer per configured topic.
+Objects is called once after property sources are loaded, when only 'value' fields of the factory are injected,
+returned objects are registered as if they were in the scan list, so they are injected, post-constructed and destroyed
+by the container. The factory itself is registered as the regular bean.
+*/
+
+type CollectionFactoryBean interface {
+
+	/*
+		returns objects registered in the container as beans, glue.Facet or glue.Alias give them distinct names
+	*/
+	Objects() ([]any, error)
+}
+
+var InjectingFactoryBeanClass = reflect.TypeOf((*InjectingFactoryBean)(nil)).Elem()
+
+/*
+InjectingFactoryBean is implemented by FactoryBean or ContextFactoryBean producing partially wired objects,
+if InjectResult returns true the container injects 'inject' and 'value' fields of the produced object and calls
+its PostConstruct before use. The object type of the factory must be the pointer to struct,
+dependencies of the object are constructed before the factory.
+*/
+
+type InjectingFactoryBean interface {
+
+	/*
+		returns true if the object produced by the factory should be injected and post-constructed by the container
+	*/
+	InjectResult() bool
+}
+
+var InjectSetterClass = reflect.TypeOf((*InjectSetter)(nil)).Elem()
+
+/*
+InjectSetter interface is using to inject dependencies in to unexported fields with 'inject' tag
+for beans without the exported Set<Field> method of the field, e.g. SetUserRepo for the field 'userRepo'.
+*/
+
+type InjectSetter interface {
+
+	/*
+		SetInjected receives the value of the unexported field by the field name before PostConstruct
+	*/
+
+	SetInjected(field string, value any) error
+}
+
 var InitializingBeanClass = reflect.TypeOf((*InitializingBean)(nil)).Elem()
 
 /*
@@ -625,6 +1245,38 @@ type OrderedBean interface {
 	BeanOrder() int
 }
 
+var DestroyOrderedBeanClass = reflect.TypeOf((*DestroyOrderedBean)(nil)).Elem()
+
+/*
+DestroyOrderedBean interface used to control the teardown order of beans independent of their creation order.
+Beans with lower DestroyOrder are destroyed first, beans without it have order 0.
+The injection graph still wins: a bean is never destroyed before the beans that injected it.
+*/
+
+type DestroyOrderedBean interface {
+
+	/*
+		DestroyOrder - returns order using for acceding sorting of beans on container close
+	*/
+	DestroyOrder() int
+}
+
+var DescribedBeanClass = reflect.TypeOf((*DescribedBean)(nil)).Elem()
+
+/*
+DescribedBean interface used to attach the human readable description to the bean.
+Description is shown in Container.Describe() and in the DOT graph.
+For beans that can not implement the interface use glue.Described(obj, description) wrapper.
+*/
+
+type DescribedBean interface {
+
+	/*
+		BeanDescription - returns what this component does
+	*/
+	BeanDescription() string
+}
+
 var PrimaryBeanClass = reflect.TypeOf((*PrimaryBean)(nil)).Elem()
 
 /*
@@ -688,6 +1340,104 @@ type Decorator interface {
 	Decorate(original any) (any, error)
 }
 
+var PreflightCheckClass = reflect.TypeOf((*PreflightCheck)(nil)).Elem()
+
+/*
+PreflightCheck is implemented by beans that verify the runtime environment before the
+PostConstruct phase, for example port availability or file permissions.
+
+All preflight checks run after injection and property loading, in OrderedBean order.
+Every check is executed and all failures are reported together, so the operator
+gets the complete list of problems in one start attempt.
+*/
+type PreflightCheck interface {
+
+	/*
+		Preflight verifies the environment, properties are fully loaded at this point.
+	*/
+	Preflight(ctx context.Context, properties Properties) error
+}
+
+var MetricsClass = reflect.TypeOf((*Metrics)(nil)).Elem()
+
+/*
+Metrics is the metrics bean that hands out recorders pre-tagged for the bean.
+Register a single Metrics bean, for example glue.NewMetricsRegistry(), in the container.
+
+Recorder is called after injection but before PostConstruct, so the implementation
+should not rely on its own initialization.
+*/
+type Metrics interface {
+
+	/*
+		Recorder returns the metrics recorder tagged with label bean=<beanName>.
+	*/
+	Recorder(beanName string) MetricsRecorder
+}
+
+/*
+MetricsRecorder records metrics for one component. Labels are key/value pairs.
+*/
+type MetricsRecorder interface {
+
+	/*
+		Add increments the counter by delta
+	*/
+	Add(name string, delta float64, labels ...string)
+
+	/*
+		Set sets the gauge value
+	*/
+	Set(name string, value float64, labels ...string)
+
+	/*
+		Observe records the observation in summary
+	*/
+	Observe(name string, value float64, labels ...string)
+}
+
+var MeteredBeanClass = reflect.TypeOf((*MeteredBean)(nil)).Elem()
+
+/*
+MeteredBean is implemented by beans that need per-component instrumentation.
+The container calls SetMetricsRecorder with the recorder from the Metrics bean
+tagged by the bean name. Without a Metrics bean the recorder is a no-op.
+*/
+type MeteredBean interface {
+
+	/*
+		SetMetricsRecorder receives the recorder before PostConstruct.
+	*/
+	SetMetricsRecorder(recorder MetricsRecorder)
+}
+
+var ModuleClass = reflect.TypeOf((*Module)(nil)).Elem()
+
+/*
+Module groups constructor functions as methods of one struct, for example
+
+	type dataModule struct {
+		Properties glue.Properties `inject:""`
+	}
+
+	func (t *dataModule) Providers() []string { return nil }
+
+	func (t *dataModule) ProvideDB(ctx context.Context) (*sql.DB, error) { ... }
+
+	func (t *dataModule) ProvideUserRepo(db *sql.DB) *UserRepo { ... }
+
+Exported methods named ProvideXxx and methods listed by Providers are registered as constructor functions,
+parameters are resolved from the container. The module itself is the bean initialized before its providers are called.
+*/
+
+type Module interface {
+
+	/*
+		Providers returns names of provider methods not following the ProvideXxx convention, may be nil
+	*/
+	Providers() []string
+}
+
 var ResourceSourceClass = reflect.TypeOf((*ResourceSource)(nil))
 
 /**
@@ -711,12 +1461,49 @@ type ResourceSource struct {
 		FileSystem to access or serve assets or resources
 	*/
 	AssetFiles http.FileSystem
+
+	/*
+		FS is used instead of AssetFiles if set, for example embed.FS.
+		AssetNames are discovered by walking FS if they are not given.
+	*/
+	FS fs.FS
+
+	/*
+		Dir is the directory of the OS filesystem enumerated at startup, files of subdirectories are added if Recursive.
+		Can not be combined with FS.
+	*/
+	Dir       string
+	Recursive bool
+
+	/*
+		Glob filters discovered files by base name, e.g. "*.yaml", all files are added if empty
+	*/
+	Glob string
+
+	/*
+		Archive is the name of .zip, .tar, .tar.gz or .tgz file in FS, Dir or AssetFiles, its entries become
+		resources of the source instead of files. Only the index is kept in memory, entries are decompressed on Open.
+	*/
+	Archive string
+
+	/*
+		Checksums are SHA-256 hex checksums of resources by name verified on every Open,
+		Manifest is the file of the source in the sha256sum format merged with Checksums.
+		Integrity selects between failing Open and logging on mismatch,
+		VerifyOnStart verifies all resources of the manifest when the container is created.
+	*/
+	Checksums     map[string]string
+	Manifest      string
+	Integrity     IntegrityMode
+	VerifyOnStart bool
 }
 
 var PropertySourceClass = reflect.TypeOf((*PropertySource)(nil))
 
 /*
-PropertySource is serving as a property placeholder of file if it's ending with ".properties", ".yaml", ".yml", ".json" or ".toml".
+PropertySource is serving as a property placeholder of file if it's ending with ".properties", ".yaml", ".yml", ".json", ".toml" or ".env".
+
+Dotenv files are converted to property-style keys: "APP_DB_HOST" is stored as "app.db.host".
 */
 
 type PropertySource struct {
@@ -726,12 +1513,22 @@ type PropertySource struct {
 	*/
 	File string
 
+	/*
+		Paths are loaded in order after File, later paths override keys of earlier ones.
+		Paths prefixed by OptionalPathPrefix are skipped if the file or resource does not exist,
+		for example "optional:file:./override.properties".
+	*/
+	Paths []string
+
 	/*
 		Map of properties
 	*/
 	Map map[string]any
 }
 
+// OptionalPathPrefix marks the path of PropertySource skipped if it does not exist.
+const OptionalPathPrefix = "optional:"
+
 var FilePropertySourceClass = reflect.TypeOf((*FilePropertySource)(nil)).Elem()
 
 type FilePropertySource string
@@ -740,6 +1537,19 @@ var MapPropertySourceClass = reflect.TypeOf((*MapPropertySource)(nil)).Elem()
 
 type MapPropertySource map[string]any
 
+var PropertyMapClass = reflect.TypeOf((*PropertyMap)(nil)).Elem()
+
+/*
+PropertyMap is the in-memory property override accepted by glue.New, for example
+
+	glue.New(glue.PropertyMap{"server.port": "8080"}, ...)
+
+It is registered as a PropertyResolver with priority 1000, higher than environment
+and .env resolvers, so tests and embedders can override configuration programmatically.
+The map is copied on registration.
+*/
+type PropertyMap map[string]string
+
 var PropertyResolverClass = reflect.TypeOf((*PropertyResolver)(nil))
 
 /*
@@ -759,6 +1569,63 @@ type PropertyResolver interface {
 	GetProperty(key string) (value string, ok bool)
 }
 
+var ConfigMigrationClass = reflect.TypeOf((*ConfigMigration)(nil)).Elem()
+
+/*
+ConfigMigration transforms properties of the older configuration layout to the newer one, e.g. renames keys or splits values.
+Migrations are called at load time, before beans are injected, in the order of versions, starting from the version
+in 'config.schema.version'. Each migration upgrades configurations with versions from FromVersion inclusive
+to ToVersion exclusive, after the migration the version property is set to ToVersion.
+*/
+
+type ConfigMigration interface {
+
+	/*
+		FromVersion - the lowest semantic version of the configuration the migration applies to
+	*/
+	FromVersion() string
+
+	/*
+		ToVersion - the semantic version of the configuration after the migration
+	*/
+	ToVersion() string
+
+	/*
+		Migrate - changes properties in place
+	*/
+	Migrate(props Properties) error
+}
+
+/*
+AppliedMigration is the step of the configuration migration chain.
+*/
+
+type AppliedMigration struct {
+	From      string
+	To        string
+	Migration string
+}
+
+var RefreshableSourceClass = reflect.TypeOf((*RefreshableSource)(nil)).Elem()
+
+/*
+RefreshableSource is the bean fetching properties from the remote service or file, reloaded by PropertyRefresher.
+Each source has its own refresh interval configured by 'config.refresh.<SourceName>.interval'.
+*/
+
+type RefreshableSource interface {
+
+	/*
+		SourceName selects the refresh interval of the source
+	*/
+	SourceName() string
+
+	/*
+		FetchProperties returns the current properties of the source loaded in to the container properties
+	*/
+	FetchProperties(ctx context.Context) (map[string]any, error)
+}
+
 /*
 EnumerablePropertyResolver is an optional extension of PropertyResolver.
 Resolvers that can enumerate their keys implement this interface so that
@@ -817,10 +1684,25 @@ type Properties interface {
 	Parse(content string) error
 
 	/*
-		Dumps all properties to UTF-8 string
+		Dumps all properties to UTF-8 string, values of keys matching mask patterns are masked
 	*/
 	Dump() string
 
+	/*
+		Sets glob patterns like "*.password" or "*.secret" of keys masked in Dump and Save
+	*/
+	SetMaskPatterns(patterns ...string)
+
+	/*
+		Sets function used to mask values in Dump and Save, for example to encrypt them. By default values are replaced by DefaultMask
+	*/
+	SetMaskFunc(mask func(key, value string) string)
+
+	/*
+		Returns value as it would appear in Dump
+	*/
+	MaskValue(key, value string) string
+
 	/*
 		Extends parent properties
 	*/
@@ -872,15 +1754,31 @@ type Properties interface {
 	GetDuration(key string, def time.Duration) time.Duration
 	GetFileMode(key string, def os.FileMode) os.FileMode
 
+	/*
+		Gets property value with the scalar type decoded from YAML, JSON or TOML source.
+		Values set by Set, Parse or resolvers are PropertyString, missing key is PropertyMissing.
+	*/
+	GetTyped(key string) (any, PropertyKind)
+
 	// properties conversion error handler
 	GetErrorHandler() func(string, error)
 	SetErrorHandler(onError func(string, error))
 
 	/*
-		Sets property value
+		Sets property value, comments of the key are kept
 	*/
 	Set(key string, value string)
 
+	/*
+		Gets property value with comments and origin from the local store, resolvers are not used
+	*/
+	GetEntry(key string) (PropertyEntry, bool)
+
+	/*
+		Sets property value, comments and origin in one call, empty origin is recorded as "SetEntry"
+	*/
+	SetEntry(key string, entry PropertyEntry)
+
 	/*
 		Remove property by key
 	*/
@@ -890,6 +1788,230 @@ type Properties interface {
 		Delete all properties
 	*/
 	Clear()
+
+	/*
+		Adds listener notified after Set, LoadMap, Load, Parse, Remove or Clear changed the property value
+	*/
+	AddChangeListener(listener PropertyChangeListener)
+
+	/*
+		Sets the auditor notified about every sampling-th read of the property, nil auditor stops auditing
+	*/
+	SetAuditor(auditor PropertyAuditor, sampling int)
+
+	/*
+		Removes previously added listener
+	*/
+	RemoveChangeListener(listener PropertyChangeListener)
+
+	/*
+		Freezes loaded values: changes go to the dynamic layer on top of them or are rejected, FreezeNone merges the dynamic layer back
+	*/
+	Freeze(mode FreezeMode)
+
+	/*
+		Explain returns entries of the key from all layers and resolvers in precedence order, the first one wins
+	*/
+	Explain(key string) []PropertyEntry
+}
+
+/*
+PropertyEntry is the property value with comment lines written before it by Dump and Save
+and the origin of the value: the file name of the property source or the operation, e.g. "Set", "LoadMap" or "Parse".
+*/
+
+type PropertyEntry struct {
+	Value    string
+	Comments []string
+	Origin   string
+	Dynamic  bool // value of the dynamic layer set after Freeze
+}
+
+/*
+PropertyKind is the type of the property value decoded from the structured source.
+Values are int64 for PropertyInt, float64 for PropertyFloat, bool, string, time.Time and []any for PropertyList.
+*/
+
+type PropertyKind int
+
+const (
+	PropertyMissing PropertyKind = iota
+	PropertyString
+	PropertyBool
+	PropertyInt
+	PropertyFloat
+	PropertyTime
+	PropertyList
+)
+
+func (t PropertyKind) String() string {
+	switch t {
+	case PropertyMissing:
+		return "PropertyMissing"
+	case PropertyString:
+		return "PropertyString"
+	case PropertyBool:
+		return "PropertyBool"
+	case PropertyInt:
+		return "PropertyInt"
+	case PropertyFloat:
+		return "PropertyFloat"
+	case PropertyTime:
+		return "PropertyTime"
+	case PropertyList:
+		return "PropertyList"
+	default:
+		return "PropertyUnknown"
+	}
+}
+
+/*
+PropertyChangedEvent describes the change of one property in the store.
+Old is empty for the new property, New is empty for the removed one.
+Source is the operation that changed the store: "Set", "LoadMap", "Parse", "Remove" or "Clear".
+*/
+
+type PropertyChangedEvent struct {
+	Key    string
+	Old    string
+	New    string
+	Source string
+}
+
+var EventPublisherClass = reflect.TypeOf((*EventPublisher)(nil)).Elem()
+
+/*
+EventPublisher delivers application events to EventListener beans of the container and its parents.
+Inject it as `Events glue.EventPublisher \`inject:""\`` - the container itself is the publisher.
+*/
+
+type EventPublisher interface {
+
+	/*
+		PublishEvent calls synchronous listeners in order and starts asynchronous listeners in background.
+	*/
+	PublishEvent(event any)
+}
+
+var EventListenerClass = reflect.TypeOf((*EventListener)(nil)).Elem()
+
+/*
+EventListener beans are subscribed after PostConstruct and receive every published event,
+use glue.OnEvent to receive events of one type. Register with glue.AsyncListener to be called in background.
+*/
+
+type EventListener interface {
+	OnEvent(event any)
+}
+
+/*
+LifecycleHook observes lifecycle transitions of beans, registered by WithLifecycleHook at the container creation.
+Hooks are called synchronously by the goroutine changing the lifecycle, also from parallel initialization,
+so they must be fast and safe for concurrent use. Panics of hooks are logged and ignored.
+*/
+
+type LifecycleHook interface {
+	OnTransition(bean Bean, from, to BeanLifecycle)
+}
+
+var LifecycleListenerClass = reflect.TypeOf((*LifecycleListener)(nil)).Elem()
+
+/*
+LifecycleListener beans are notified about phases of the container they are registered in
+and about lifecycle transitions of beans of the container and its children once subscribed after PostConstruct.
+Transitions are delivered synchronously by the goroutine changing the lifecycle, also from parallel initialization,
+so listeners must be fast and safe for concurrent use.
+*/
+
+type LifecycleListener interface {
+	OnLifecycleEvent(event LifecycleEvent)
+	OnBeanTransition(event BeanTransitionEvent)
+}
+
+type ContainerPhase int
+
+const (
+	// ContainerRefreshed - all beans are constructed and listeners are subscribed
+	ContainerRefreshed ContainerPhase = iota
+	// ContainerClosing - close started, beans are not destroyed yet
+	ContainerClosing
+	// ContainerClosed - all beans are destroyed
+	ContainerClosed
+)
+
+func (t ContainerPhase) String() string {
+	switch t {
+	case ContainerRefreshed:
+		return "ContainerRefreshed"
+	case ContainerClosing:
+		return "ContainerClosing"
+	case ContainerClosed:
+		return "ContainerClosed"
+	default:
+		return "ContainerUnknown"
+	}
+}
+
+type LifecycleEvent struct {
+	Phase     ContainerPhase
+	Container Container
+	Err       error // error of close for ContainerClosed
+}
+
+type BeanTransitionEvent struct {
+	Bean Bean
+	From BeanLifecycle
+	To   BeanLifecycle
+}
+
+/*
+ContainerStartedEvent is published when the container is created and all listeners are subscribed.
+*/
+
+type ContainerStartedEvent struct {
+	Container Container
+}
+
+/*
+ContainerClosingEvent is published before beans are destroyed.
+*/
+
+type ContainerClosingEvent struct {
+	Container Container
+}
+
+var PropertyChangeListenerClass = reflect.TypeOf((*PropertyChangeListener)(nil)).Elem()
+
+/*
+PropertyChangeListener beans are subscribed to the container properties after PostConstruct
+and unsubscribed on close, so caches and connection pools can react to configuration changes.
+*/
+
+type PropertyChangeListener interface {
+	OnPropertyChanged(event PropertyChangedEvent)
+}
+
+var PropertyAuditorClass = reflect.TypeOf((*PropertyAuditor)(nil)).Elem()
+
+/*
+PropertyAuditor is notified about reads of properties for security auditing of secret access patterns,
+it is called synchronously on the reading goroutine, so it must be fast and must not read properties.
+*/
+
+type PropertyAuditor interface {
+	OnPropertyAccess(access PropertyAccess)
+}
+
+/*
+PropertyAccess is the read of the property: Origin is the file or the operation that set the value
+or the type of the resolver that found it, Bean is the bean reading the property during injection.
+*/
+
+type PropertyAccess struct {
+	Key    string
+	Found  bool
+	Origin string
+	Bean   string
 }
 
 /*
@@ -901,3 +2023,12 @@ var ResourceClass = reflect.TypeOf((*Resource)(nil)).Elem()
 type Resource interface {
 	Open() (http.File, error)
 }
+
+/*
+NamedResource is the resource returned by Container.Resources with its "source:name" path.
+*/
+type NamedResource interface {
+	Resource
+
+	Path() string
+}
@@ -0,0 +1,352 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+type archiveFormat int
+
+const (
+	archiveZip archiveFormat = iota
+	archiveTar
+	archiveTarGz
+)
+
+func detectArchiveFormat(name string) (archiveFormat, error) {
+	lower := strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(lower, ".zip"):
+		return archiveZip, nil
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		return archiveTarGz, nil
+	case strings.HasSuffix(lower, ".tar"):
+		return archiveTar, nil
+	default:
+		return 0, fmt.Errorf("unknown archive format of '%s', expected .zip, .tar, .tar.gz or .tgz", name)
+	}
+}
+
+type archiveEntry struct {
+	size    int64
+	modTime time.Time
+}
+
+/**
+The http.FileSystem of entries of the archive, only the index is kept in memory,
+entries are decompressed from the archive on every Open
+*/
+
+type archiveFileSystem struct {
+	files   http.FileSystem
+	archive string
+	format  archiveFormat
+	entries map[string]archiveEntry
+}
+
+/**
+Returns names of entries and the file system of the source with Archive, Glob filters entries by base name
+*/
+
+func archiveAssets(source *ResourceSource) ([]string, http.FileSystem, error) {
+	files := source.AssetFiles
+	switch {
+	case source.Dir != "" && source.FS != nil:
+		return nil, nil, fmt.Errorf("resource source '%s' has both FS and Dir", source.Name)
+	case source.Dir != "":
+		files = http.FS(os.DirFS(source.Dir))
+	case source.FS != nil:
+		files = http.FS(source.FS)
+	}
+	if files == nil {
+		return nil, nil, fmt.Errorf("resource source '%s' has archive '%s' without FS, Dir or AssetFiles", source.Name, source.Archive)
+	}
+	format, err := detectArchiveFormat(source.Archive)
+	if err != nil {
+		return nil, nil, err
+	}
+	t := &archiveFileSystem{
+		files:   files,
+		archive: source.Archive,
+		format:  format,
+		entries: make(map[string]archiveEntry),
+	}
+	if err := t.index(); err != nil {
+		return nil, nil, fmt.Errorf("resource source '%s' archive '%s': %w", source.Name, source.Archive, err)
+	}
+	names := source.AssetNames
+	if len(names) == 0 {
+		for name := range t.entries {
+			if source.Glob != "" {
+				if ok, err := path.Match(source.Glob, path.Base(name)); err != nil {
+					return nil, nil, fmt.Errorf("resource source '%s' glob '%s': %w", source.Name, source.Glob, err)
+				} else if !ok {
+					continue
+				}
+			}
+			names = append(names, name)
+		}
+	}
+	return names, t, nil
+}
+
+func (t *archiveFileSystem) index() error {
+	switch t.format {
+	case archiveZip:
+		file, zr, err := t.openZip()
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		for _, f := range zr.File {
+			if !f.FileInfo().IsDir() {
+				t.entries[strings.TrimPrefix(f.Name, "./")] = archiveEntry{size: int64(f.UncompressedSize64), modTime: f.Modified}
+			}
+		}
+		return nil
+	default:
+		return t.walkTar(func(hdr *tar.Header, r io.Reader) (bool, error) {
+			if hdr.Typeflag == tar.TypeReg {
+				t.entries[strings.TrimPrefix(hdr.Name, "./")] = archiveEntry{size: hdr.Size, modTime: hdr.ModTime}
+			}
+			return false, nil
+		})
+	}
+}
+
+func (t *archiveFileSystem) openZip() (http.File, *zip.Reader, error) {
+	file, err := t.files.Open(t.archive)
+	if err != nil {
+		return nil, nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+	readerAt, ok := file.(io.ReaderAt)
+	if !ok {
+		readerAt = &seekReaderAt{file: file}
+	}
+	zr, err := zip.NewReader(readerAt, info.Size())
+	if err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+	return file, zr, nil
+}
+
+/**
+Streams the tar archive and calls fn for every entry until it returns true
+*/
+
+func (t *archiveFileSystem) walkTar(fn func(hdr *tar.Header, r io.Reader) (bool, error)) error {
+	file, err := t.files.Open(t.archive)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	var r io.Reader = file
+	if t.format == archiveTarGz {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if stop, err := fn(hdr, tr); stop || err != nil {
+			return err
+		}
+	}
+}
+
+/**
+Opens the decompressing stream of the entry
+*/
+
+func (t *archiveFileSystem) stream(name string) (io.ReadCloser, error) {
+	switch t.format {
+	case archiveZip:
+		file, zr, err := t.openZip()
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range zr.File {
+			if strings.TrimPrefix(f.Name, "./") == name {
+				rc, err := f.Open()
+				if err != nil {
+					file.Close()
+					return nil, err
+				}
+				return &archiveStream{Reader: rc, closers: []io.Closer{rc, file}}, nil
+			}
+		}
+		file.Close()
+		return nil, fs.ErrNotExist
+	default:
+		// the tar stream is read in the background, so the entry reader stays valid after walkTar
+		pr, pw := io.Pipe()
+		found := make(chan bool, 1)
+		go func() {
+			var sent bool
+			err := t.walkTar(func(hdr *tar.Header, r io.Reader) (bool, error) {
+				if strings.TrimPrefix(hdr.Name, "./") != name {
+					return false, nil
+				}
+				sent = true
+				found <- true
+				_, err := io.Copy(pw, r)
+				return true, err
+			})
+			if !sent {
+				found <- false
+			}
+			pw.CloseWithError(err)
+		}()
+		if !<-found {
+			pr.Close()
+			return nil, fs.ErrNotExist
+		}
+		return pr, nil
+	}
+}
+
+func (t *archiveFileSystem) Open(name string) (http.File, error) {
+	name = strings.TrimPrefix(name, "/")
+	entry, ok := t.entries[name]
+	if !ok {
+		return nil, fs.ErrNotExist
+	}
+	return &archiveFile{fs: t, name: name, entry: entry}, nil
+}
+
+type archiveStream struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (t *archiveStream) Close() error {
+	var err error
+	for _, c := range t.closers {
+		if e := c.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+/**
+The entry of the archive, seeking backwards restarts decompression, seeking is applied on the next Read
+*/
+
+type archiveFile struct {
+	fs     *archiveFileSystem
+	name   string
+	entry  archiveEntry
+	stream io.ReadCloser
+	pos    int64 // position of the stream
+	offset int64 // position requested by Seek
+}
+
+func (t *archiveFile) Read(p []byte) (int, error) {
+	if t.offset >= t.entry.size {
+		return 0, io.EOF
+	}
+	if t.stream == nil || t.offset < t.pos {
+		if t.stream != nil {
+			t.stream.Close()
+		}
+		stream, err := t.fs.stream(t.name)
+		if err != nil {
+			return 0, err
+		}
+		t.stream, t.pos = stream, 0
+	}
+	if t.offset > t.pos {
+		n, err := io.CopyN(io.Discard, t.stream, t.offset-t.pos)
+		t.pos += n
+		if err != nil {
+			return 0, err
+		}
+	}
+	n, err := t.stream.Read(p)
+	t.pos += int64(n)
+	t.offset = t.pos
+	return n, err
+}
+
+func (t *archiveFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += t.offset
+	case io.SeekEnd:
+		offset += t.entry.size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	t.offset = offset
+	return offset, nil
+}
+
+func (t *archiveFile) Close() error {
+	if t.stream != nil {
+		return t.stream.Close()
+	}
+	return nil
+}
+
+func (t *archiveFile) Readdir(count int) ([]fs.FileInfo, error) {
+	return nil, os.ErrInvalid
+}
+
+func (t *archiveFile) Stat() (fs.FileInfo, error) {
+	return &renderedFile{name: path.Base(t.name), size: t.entry.size, modTime: t.entry.modTime}, nil
+}
+
+/**
+io.ReaderAt over http.File without ReadAt, used to read the zip index
+*/
+
+type seekReaderAt struct {
+	file http.File
+}
+
+func (t *seekReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if _, err := t.file.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+	n, err := io.ReadFull(t.file, p)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return n, err
+}
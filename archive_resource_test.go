@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+var archiveEntries = map[string]string{
+	"app.properties":  "app.name = bundled\n",
+	"static/app.js":   strings.Repeat("console.log(1);\n", 1000),
+	"static/app.css":  "body {}",
+	"docs/readme.txt": "readme",
+}
+
+func zipArchive(t *testing.T) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range archiveEntries {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func tarGzArchive(t *testing.T) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "static/", Typeflag: tar.TypeDir, Mode: 0755}))
+	for name, content := range archiveEntries {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: "./" + name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestArchiveResourceSource(t *testing.T) {
+
+	for archive, data := range map[string][]byte{"assets.zip": zipArchive(t), "assets.tar.gz": tarGzArchive(t)} {
+		t.Run(archive, func(t *testing.T) {
+			ctn, err := glue.New(
+				&glue.ResourceSource{Name: "bundle", FS: fstest.MapFS{archive: {Data: data}}, Archive: archive},
+				&glue.PropertySource{File: "bundle:app.properties"},
+			)
+			require.NoError(t, err)
+			defer ctn.Close()
+
+			require.Equal(t, "bundled", ctn.Properties().GetString("app.name", ""))
+
+			_, ok := ctn.Resource("bundle:" + archive)
+			require.False(t, ok)
+			_, ok = ctn.Resource("bundle:static")
+			require.False(t, ok)
+
+			res, ok := ctn.Resource("bundle:static/app.js")
+			require.True(t, ok)
+			file, err := res.Open()
+			require.NoError(t, err)
+			defer file.Close()
+
+			info, err := file.Stat()
+			require.NoError(t, err)
+			require.Equal(t, int64(len(archiveEntries["static/app.js"])), info.Size())
+
+			// seeking as http.ServeContent does
+			size, err := file.Seek(0, io.SeekEnd)
+			require.NoError(t, err)
+			require.Equal(t, info.Size(), size)
+			_, err = file.Seek(16, io.SeekStart)
+			require.NoError(t, err)
+			content, err := ioutil.ReadAll(file)
+			require.NoError(t, err)
+			require.Equal(t, archiveEntries["static/app.js"][16:], string(content))
+
+			_, err = file.Seek(0, io.SeekStart)
+			require.NoError(t, err)
+			content, err = ioutil.ReadAll(file)
+			require.NoError(t, err)
+			require.Equal(t, archiveEntries["static/app.js"], string(content))
+		})
+	}
+}
+
+func TestArchiveResourceGlob(t *testing.T) {
+
+	ctn, err := glue.New(
+		&glue.ResourceSource{Name: "bundle", FS: fstest.MapFS{"assets.zip": {Data: zipArchive(t)}}, Archive: "assets.zip", Glob: "*.css"},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	_, ok := ctn.Resource("bundle:static/app.css")
+	require.True(t, ok)
+	_, ok = ctn.Resource("bundle:static/app.js")
+	require.False(t, ok)
+
+	_, err = glue.New(&glue.ResourceSource{Name: "bundle", FS: fstest.MapFS{"assets.rar": {Data: []byte("x")}}, Archive: "assets.rar"})
+	require.Error(t, err)
+}
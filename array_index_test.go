@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type arrayIndexConfig struct {
+	Second  string   `value:"hosts[1]"`
+	Port    int      `value:"ports[0]"`
+	Missing string   `value:"hosts[5],default=none"`
+	Hosts   []string `value:"hosts"`
+	Primary string   `value:"servers[0].name"`
+}
+
+func TestArrayIndexAccess(t *testing.T) {
+
+	cfg := &arrayIndexConfig{}
+	ctn, err := glue.New(
+		cfg,
+		glue.MapPropertySource{
+			"hosts": []any{"alpha", "beta", "gamma"},
+			"ports": "8080;9090",
+			"servers": []any{
+				map[string]any{"name": "main", "weight": 10},
+				map[string]any{"name": "backup", "weight": 1},
+			},
+		},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, "beta", cfg.Second)
+	require.Equal(t, 8080, cfg.Port)
+	require.Equal(t, "none", cfg.Missing)
+	require.Equal(t, []string{"alpha", "beta", "gamma"}, cfg.Hosts)
+	require.Equal(t, "main", cfg.Primary)
+
+	props := ctn.Properties()
+	require.Equal(t, "alpha;beta;gamma", props.GetString("hosts", ""))
+	require.Equal(t, "gamma", props.GetString("hosts[2]", ""))
+	require.Equal(t, 9090, props.GetInt("ports[1]", 0))
+	require.Equal(t, "backup", props.GetString("servers[1].name", ""))
+	require.Equal(t, "", props.GetString("hosts[-1]", ""))
+	require.Equal(t, "", props.GetString("hosts[x]", ""))
+
+	props.Set("hosts", "${ports[1]}; delta")
+	require.Equal(t, "9090", props.GetString("hosts[0]", ""))
+	require.Equal(t, "delta", props.GetString("hosts[1]", ""))
+}
+
+func TestArrayIndexTables(t *testing.T) {
+
+	props := glue.NewProperties()
+	props.LoadMap(map[string]any{"list": []map[string]any{{"id": "x"}, {"id": "y"}}})
+	require.Equal(t, "y", props.GetString("list[1].id", ""))
+}
@@ -12,6 +12,8 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 	"unsafe"
 )
 
@@ -27,7 +29,9 @@ const (
 // stubField describes an anonymous field that needs a stub set on each instance.
 type stubField struct {
 	fieldIndex int
-	fieldType  reflect.Type
+	// index path of the field lifted from the embedded struct, nil for own fields
+	index     []int
+	fieldType reflect.Type
 }
 
 type beanDef struct {
@@ -58,6 +62,11 @@ type beanDef struct {
 	Properties that are going to be injected
 	*/
 	properties []*propInjectionDef
+
+	/**
+	Resources that are going to be injected
+	*/
+	resources []*resourceInjectionDef
 }
 
 // globalBeanDefCache caches parsed beanDef by classPtr.
@@ -107,11 +116,26 @@ func (t *beanDef) applyStubs(value reflect.Value) {
 		default:
 			continue
 		}
-		value.Field(s.fieldIndex).Set(reflect.ValueOf(stub))
+		if s.index != nil {
+			value.FieldByIndex(s.index).Set(reflect.ValueOf(stub))
+		} else {
+			value.Field(s.fieldIndex).Set(reflect.ValueOf(stub))
+		}
 	}
 }
 
 type bean struct {
+	/**
+	Runtime lookups and injections by Inject, first fields to be 64-bit aligned for atomic access
+	*/
+	lookups    uint64
+	injections uint64
+
+	/**
+	Unique number of the bean in the process, assigned by uniqueID on the first call
+	*/
+	uid uint64
+
 	/**
 	Name of the bean
 	*/
@@ -122,17 +146,68 @@ type bean struct {
 	*/
 	qualifier string
 
+	/**
+	Registration aliases matched by the name lookup and inject:"bean=..." qualifier
+	*/
+	aliases []string
+
 	/**
 	Order of the bean
 	*/
 	ordered bool
 	order   int
 
+	/**
+	Registration sequence number, unique in the process, breaks ties of the order
+	*/
+	seq uint64
+
 	/**
 	Primary flag - true if this is the primary bean for its type
 	*/
 	primary bool
 
+	/**
+	Bean is injected only by its own type and name, never by interfaces it implements
+	*/
+	concreteOnly bool
+
+	/**
+	Property prefix of the bean registered by glue.Facet, empty otherwise
+	*/
+	propertyPrefix string
+
+	/**
+	Human readable description of the bean
+	*/
+	description string
+
+	/**
+	PostConstruct and Destroy timeouts of the bean, zero if the container timeouts are used
+	*/
+	postConstructTimeout time.Duration
+	destroyTimeout       time.Duration
+
+	/**
+	EventListener bean is called in background
+	*/
+	asyncEvents bool
+
+	/**
+	Guards of the untrusted bean registered with glue.Sandbox
+	*/
+	sandbox *SandboxOptions
+
+	/**
+	Proxies of the bean injected instead of obj by interface type
+	*/
+	proxies map[reflect.Type]any
+
+	/**
+	Values of unexported fields injected by setters, by field number
+	*/
+	setterFields map[int]*setterField
+
 	/**
 	Factory of the bean if exist
 	*/
@@ -168,6 +243,11 @@ type bean struct {
 	*/
 	factoryDependencies []*factoryDependency
 
+	/**
+	Beans injected in to fields of the current bean, used by the dependency graph
+	*/
+	injectedBeans []injectedBean
+
 	/**
 	Next bean in the list
 	*/
@@ -177,6 +257,11 @@ type bean struct {
 	Constructor mutex for the bean
 	*/
 	ctorMu sync.Mutex
+
+	/**
+	Set to 1 by Unregister before dependents are checked, registrations depending on the bean are rejected
+	*/
+	unregistered int32
 }
 
 type beanlist struct {
@@ -188,6 +273,30 @@ func (t beanlist) String() string {
 	return fmt.Sprintf("container{level=%d, beans=%v}", t.level, t.list)
 }
 
+/**
+Returns the unique number of the bean, beans with the same name have different numbers
+*/
+
+func (t *bean) uniqueID() uint64 {
+	if id := atomic.LoadUint64(&t.uid); id != 0 {
+		return id
+	}
+	atomic.CompareAndSwapUint64(&t.uid, 0, atomic.AddUint64(&beanUIDs, 1))
+	return atomic.LoadUint64(&t.uid)
+}
+
+func (t *bean) hasName(name string) bool {
+	if t.name == name {
+		return true
+	}
+	for _, alias := range t.aliases {
+		if alias == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (t *bean) String() string {
 	pointer := uintptr(unsafe.Pointer(&t.obj))
 	if t.beenFactory != nil {
@@ -232,6 +341,10 @@ func (t *bean) Lifecycle() BeanLifecycle {
 	return t.lifecycle
 }
 
+func (t *bean) Description() string {
+	return t.description
+}
+
 /*
 *
 Check if bean definition can implement interface type
@@ -273,6 +386,11 @@ type factory struct {
 	Created bean instances by this factory
 	*/
 	instances []*bean
+
+	/**
+	Injects and post-constructs the produced object if the factory is InjectingFactoryBean
+	*/
+	injectResult func(ctx context.Context, obj any) error
 }
 
 func (t *factory) String() string {
@@ -330,18 +448,36 @@ func (t *factory) ctor(ctx context.Context) (*bean, bool, error) {
 		}
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, false, fmt.Errorf("factory bean '%v' skipped creation of bean '%v': %w", t.factoryClassPtr, t.objectType(), err)
+	}
+
 	var (
 		obj any
 		err error
 	)
-	if contextFactoryBean, ok := t.factoryObj.(ContextFactoryBean); ok {
-		obj, err = contextFactoryBean.Object(ctx)
-	} else {
+	switch factoryBean := t.factoryObj.(type) {
+	case ContextFactoryBean:
+		obj, err = factoryBean.Object(callerContext(ctx))
+	case FactoryBeanCtx:
+		obj, err = factoryBean.ObjectCtx(ctx)
+	default:
 		obj, err = t.factoryBean.Object()
 	}
 	if err != nil {
 		return nil, false, fmt.Errorf("factory bean '%v' failed to create bean '%v': %w", t.factoryClassPtr, t.objectType(), err)
 	}
+	if err := ctx.Err(); err != nil {
+		// the object created after cancellation is never used
+		discardObject(obj)
+		return nil, false, fmt.Errorf("factory bean '%v' created bean '%v' after cancellation: %w", t.factoryClassPtr, t.objectType(), err)
+	}
+	if t.injectResult != nil {
+		if err := t.injectResult(ctx, obj); err != nil {
+			discardObject(obj)
+			return nil, false, fmt.Errorf("factory bean '%v' failed to wire bean '%v': %w", t.factoryClassPtr, t.objectType(), err)
+		}
+	}
 
 	b.obj = obj
 	b.lifecycle = BeanInitialized
@@ -364,6 +500,17 @@ type factoryDependency struct {
 	injection func(instance *bean) error
 }
 
+/**
+Returns the index path of the field of the embedded struct on position j
+*/
+
+func embeddedIndex(j int, index []int, fieldNum int) []int {
+	if index == nil {
+		return []int{j, fieldNum}
+	}
+	return append([]int{j}, index...)
+}
+
 /*
 *
 parseBeanDef parses the type-level metadata from classPtr using reflection.
@@ -373,9 +520,12 @@ can be cached globally and reused across all instances of the same type.
 func parseBeanDef(classPtr reflect.Type) (*beanDef, error) {
 	var fields []*injectionDef
 	var properties []*propInjectionDef
+	var resources []*resourceInjectionDef
 	var anonymousFields []reflect.Type
 	var stubs []stubField
 	class := classPtr.Elem()
+	// unique numbers of fields of embedded structs
+	embeddedNum := class.NumField()
 	for j := 0; j < class.NumField(); j++ {
 		field := class.Field(j)
 
@@ -397,6 +547,50 @@ func parseBeanDef(classPtr reflect.Type) (*beanDef, error) {
 			case ContainerClass:
 				return nil, fmt.Errorf("exposing by anonymous field '%s' in '%v' interface glue.Container is not allowed", field.Name, classPtr)
 			}
+
+			_, hasInject := field.Tag.Lookup("inject")
+			_, hasValue := field.Tag.Lookup("value")
+			if field.Type.Kind() == reflect.Struct && !hasInject && !hasValue {
+				/**
+				Fields of the embedded struct are injected as part of the bean
+				*/
+				embedded, err := parseBeanDef(reflect.PtrTo(field.Type))
+				if err != nil {
+					return nil, fmt.Errorf("embedded struct '%s' in '%v': %w", field.Name, classPtr, err)
+				}
+				for _, stub := range embedded.stubs {
+					stubs = append(stubs, stubField{
+						fieldIndex: j,
+						index:      embeddedIndex(j, stub.index, stub.fieldIndex),
+						fieldType:  stub.fieldType,
+					})
+				}
+				for _, def := range embedded.fields {
+					lifted := *def
+					lifted.class = class
+					lifted.index = embeddedIndex(j, def.index, def.fieldNum)
+					lifted.fieldNum = embeddedNum
+					embeddedNum++
+					fields = append(fields, &lifted)
+				}
+				for _, def := range embedded.properties {
+					lifted := *def
+					lifted.class = class
+					lifted.index = embeddedIndex(j, def.index, def.fieldNum)
+					lifted.fieldNum = embeddedNum
+					embeddedNum++
+					properties = append(properties, &lifted)
+				}
+				for _, def := range embedded.resources {
+					lifted := *def
+					lifted.class = class
+					lifted.index = embeddedIndex(j, def.index, def.fieldNum)
+					lifted.fieldNum = embeddedNum
+					embeddedNum++
+					resources = append(resources, &lifted)
+				}
+				continue
+			}
 		}
 
 		if valueTag, hasValueTag := field.Tag.Lookup("value"); hasValueTag {
@@ -407,11 +601,20 @@ func parseBeanDef(classPtr reflect.Type) (*beanDef, error) {
 			var defaultValue string
 			var hasDefaultValue bool
 			var timeFormat string
+			var path bool
+			expression, options, isExpression, err := cutExpressionTag(valueTag)
+			if err != nil {
+				return nil, fmt.Errorf("field '%s' in '%v' with 'value' tag: %w", field.Name, classPtr, err)
+			}
 			pairs := strings.Split(valueTag, ",")
+			if isExpression {
+				// options follow the expression, e.g. "#{ ${a} * 2 },default=1"
+				pairs = append([]string{expression}, strings.Split(options, ",")[1:]...)
+			}
 			for i, pair := range pairs {
 				p := strings.TrimSpace(pair)
 				if i == 0 {
-					// property name
+					// property name or expression
 					propertyName = p
 					continue
 				}
@@ -426,13 +629,18 @@ func parseBeanDef(classPtr reflect.Type) (*beanDef, error) {
 					if len(kv) > 1 {
 						timeFormat = strings.TrimSpace(kv[1])
 					}
+				case "path":
+					path = len(kv) == 1 || strings.TrimSpace(kv[1]) == "true"
 				}
 			}
 			if propertyName == "" {
 				return nil, fmt.Errorf("empty property name in field '%s' with type '%v' on position %d in %v with 'value' tag", field.Name, field.Type, j, classPtr)
 			}
+			if path && !isPathType(field.Type) {
+				return nil, fmt.Errorf("path option of field '%s' in '%v' requires the string or []string type", field.Name, classPtr)
+			}
 			// detect prefix map injection: value:"prefix=db" on map[string]string
-			if strings.HasPrefix(propertyName, "prefix=") {
+			if !isExpression && strings.HasPrefix(propertyName, "prefix=") {
 				prefixValue := strings.TrimSpace(propertyName[len("prefix="):])
 				if prefixValue == "" {
 					return nil, fmt.Errorf("empty prefix in field '%s' with type '%v' in %v with 'value' tag", field.Name, field.Type, classPtr)
@@ -460,6 +668,8 @@ func parseBeanDef(classPtr reflect.Type) (*beanDef, error) {
 				defaultValue:    defaultValue,
 				hasDefaultValue: hasDefaultValue,
 				timeFormat:      timeFormat,
+				expression:      isExpression,
+				path:            path,
 			}
 			if field.Type.Kind() == reflect.Func {
 				ft := field.Type
@@ -468,7 +678,7 @@ func parseBeanDef(classPtr reflect.Type) (*beanDef, error) {
 				}
 				funcReturnsError := ft.NumOut() == 2
 				funcTakesContext := ft.NumIn() == 1
-				if !funcReturnsError && !funcTakesContext && !hasDefaultValue {
+				if !funcReturnsError && !funcTakesContext && !hasDefaultValue && !isExpression {
 					return nil, fmt.Errorf("dynamic value field '%s' in '%v': func() T requires a 'default' option since it cannot return an error", field.Name, classPtr)
 				}
 				def.dynamic = true
@@ -480,6 +690,18 @@ func parseBeanDef(classPtr reflect.Type) (*beanDef, error) {
 			continue
 		}
 
+		if resourceTag, hasResourceTag := field.Tag.Lookup("resource"); hasResourceTag {
+			if field.Anonymous {
+				return nil, fmt.Errorf("injection to anonymous field '%s' in '%v' is not allowed", field.Name, classPtr)
+			}
+			def, err := parseResourceTag(class, j, field, resourceTag)
+			if err != nil {
+				return nil, fmt.Errorf("field '%s' in '%v' with 'resource' tag: %w", field.Name, classPtr, err)
+			}
+			resources = append(resources, def)
+			continue
+		}
+
 		injectTag, hasInjectTag := field.Tag.Lookup("inject")
 		if field.Tag == "inject" || hasInjectTag {
 			if field.Anonymous {
@@ -489,6 +711,7 @@ func parseBeanDef(classPtr reflect.Type) (*beanDef, error) {
 			var optional bool
 			var lazy bool
 			var scopeStr string
+			var method string
 			level := DefaultSearchLevel
 			if hasInjectTag {
 				pairs := strings.Split(injectTag, ",")
@@ -512,6 +735,10 @@ func parseBeanDef(classPtr reflect.Type) (*beanDef, error) {
 						if len(kv) > 1 {
 							scopeStr = strings.TrimSpace(kv[1])
 						}
+					case "method":
+						if len(kv) > 1 {
+							method = strings.TrimSpace(kv[1])
+						}
 					default:
 						// shorthand: bare name (no "=") treated as qualifier; "-" is the no-op marker
 						if len(kv) == 1 && p != "" && p != "-" {
@@ -553,8 +780,21 @@ func parseBeanDef(classPtr reflect.Type) (*beanDef, error) {
 
 			kind := field.Type.Kind()
 			fieldType := field.Type
-			var fieldSlice, fieldMap bool
-			if scope == ScopeSingleton {
+			var fieldSlice, fieldMap, getter, funcField bool
+			if method != "" && kind != reflect.Func {
+				return nil, fmt.Errorf("field '%s' in '%v' with method=%s must be a function type, got %v", field.Name, classPtr, method, field.Type)
+			}
+			if elemType, alwaysOptional, ok := getterType(field.Type); ok && scope == ScopeSingleton && method == "" {
+				if k := elemType.Kind(); k != reflect.Ptr && k != reflect.Interface {
+					return nil, fmt.Errorf("getter field '%s' in '%v' must get pointer or interface, got '%v'", field.Name, classPtr, elemType)
+				}
+				getter = true
+				optional = optional || alwaysOptional
+				fieldType = elemType
+			} else if scope == ScopeSingleton && kind == reflect.Func {
+				// bound to the method of the bean
+				funcField = true
+			} else if scope == ScopeSingleton {
 				switch kind {
 				case reflect.Slice:
 					fieldSlice = true
@@ -586,11 +826,14 @@ func parseBeanDef(classPtr reflect.Type) (*beanDef, error) {
 				isSlice:                   fieldSlice,
 				isMap:                     fieldMap,
 				optional:                  optional,
+				getter:                    getter,
 				qualifier:                 qualifier,
 				level:                     level,
 				scope:                     scope,
 				scopeProviderTakesContext: scopeProviderTakesContext,
 				scopeReturnType:           scopeReturnType,
+				funcField:                 funcField,
+				method:                    method,
 			}
 			fields = append(fields, def)
 		}
@@ -601,6 +844,7 @@ func parseBeanDef(classPtr reflect.Type) (*beanDef, error) {
 		stubs:           stubs,
 		fields:          fields,
 		properties:      properties,
+		resources:       resources,
 	}, nil
 }
 
@@ -634,16 +878,21 @@ func investigate(obj any, classPtr reflect.Type) (*bean, error) {
 	if primaryBean, ok := obj.(PrimaryBean); ok {
 		primary = primaryBean.IsPrimaryBean()
 	}
+	var description string
+	if describedBean, ok := obj.(DescribedBean); ok {
+		description = describedBean.BeanDescription()
+	}
 	return &bean{
-		name:      name,
-		qualifier: qualifier,
-		ordered:   ordered,
-		order:     order,
-		primary:   primary,
-		obj:       obj,
-		valuePtr:  valuePtr,
-		beanDef:   bd,
-		lifecycle: BeanCreated,
+		name:        name,
+		qualifier:   qualifier,
+		ordered:     ordered,
+		order:       order,
+		primary:     primary,
+		description: description,
+		obj:         obj,
+		valuePtr:    valuePtr,
+		beanDef:     bd,
+		lifecycle:   BeanCreated,
 	}, nil
 }
 
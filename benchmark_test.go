@@ -7,6 +7,8 @@ package glue_test
 
 import (
 	"fmt"
+	"io"
+	"log"
 	"reflect"
 	"testing"
 
@@ -108,6 +110,31 @@ func BenchmarkStartupInterface_100(b *testing.B)  { benchmarkStartupInterface(b,
 func BenchmarkStartupInterface_1000(b *testing.B) { benchmarkStartupInterface(b, 1000) }
 func BenchmarkStartupInterface_5000(b *testing.B) { benchmarkStartupInterface(b, 5000) }
 
+func benchmarkStartupLogging(b *testing.B, count int, opts ...glue.ContainerOption) {
+	restore := disableVerbose()
+	defer restore()
+	beans := generateServiceBeans(count)
+	opts = append(opts, glue.WithBeans(beans...))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ctx, err := glue.NewWithOptions(opts...)
+		if err != nil {
+			b.Fatal(err)
+		}
+		ctx.Close()
+	}
+}
+
+func BenchmarkStartupLogNop_1000(b *testing.B) {
+	benchmarkStartupLogging(b, 1000, glue.WithLogger(glue.NopLogger))
+}
+func BenchmarkStartupLogVerbose_1000(b *testing.B) {
+	benchmarkStartupLogging(b, 1000, glue.WithLogger(log.New(io.Discard, "", 0)))
+}
+func BenchmarkStartupLogSampled_1000(b *testing.B) {
+	benchmarkStartupLogging(b, 1000, glue.WithLogger(log.New(io.Discard, "", 0)), glue.WithLogSampling(100))
+}
+
 // --- Lookup by Type Benchmarks ---
 
 func benchmarkLookupByType(b *testing.B, count int) {
@@ -185,3 +212,32 @@ func benchmarkLookupByName(b *testing.B, count int) {
 func BenchmarkLookupByName_100(b *testing.B)  { benchmarkLookupByName(b, 100) }
 func BenchmarkLookupByName_1000(b *testing.B) { benchmarkLookupByName(b, 1000) }
 func BenchmarkLookupByName_5000(b *testing.B) { benchmarkLookupByName(b, 5000) }
+
+type benchScopeHandler struct {
+	Tx   *benchScopeTx `inject:""`
+	Name string        `value:"bench.name,default=bench"`
+}
+
+type benchScopeTx struct {
+	Dep *benchDep0 `inject:""`
+}
+
+type benchDep0 struct{}
+
+func BenchmarkNewScope(b *testing.B) {
+	ctn, err := glue.New(&benchDep0{})
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer ctn.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		scope, err := ctn.NewScope(&benchScopeHandler{}, &benchScopeTx{})
+		if err != nil {
+			b.Fatal(err)
+		}
+		scope.Close()
+	}
+}
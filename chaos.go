@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"math/rand"
+	"strconv"
+	"sync"
+)
+
+// ChaosRefreshJitter is the refresh jitter of PropertyRefresher in the chaos mode without configured RefreshJitterProperty.
+var ChaosRefreshJitter = 0.5
+
+/**
+Randomizes legal but unspecified behaviors of the container by the seed, see WithChaos
+*/
+
+type chaosSource struct {
+	mu   sync.Mutex
+	src  *rand.Rand
+	seed int64
+}
+
+func newChaosSource(seed int64) *chaosSource {
+	return &chaosSource{src: rand.New(rand.NewSource(seed)), seed: seed}
+}
+
+func (t *chaosSource) shuffle(list []*bean) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.src.Shuffle(len(list), func(i, j int) {
+		list[i], list[j] = list[j], list[i]
+	})
+}
+
+/**
+Shuffles beans after ordered ones, the order of OrderedBean and glue.Order is kept
+*/
+
+func (t *chaosSource) shuffleUnordered(list []*bean) {
+	for i, b := range list {
+		if !b.ordered {
+			t.shuffle(list[i:])
+			return
+		}
+	}
+}
+
+/**
+Returns the chaos source of the options or inherited from the parent, nil if the chaos mode is off
+*/
+
+func containerChaos(parent *container, options ContainerOptions) *chaosSource {
+	if options.Chaos {
+		return newChaosSource(options.ChaosSeed)
+	}
+	if parent != nil {
+		return parent.chaos
+	}
+	return nil
+}
+
+/**
+Seeds the Rand bean and turns on the refresh jitter, so reload timing follows the chaos seed
+*/
+
+func (t *container) applyChaosProperties() {
+	if t.chaos == nil {
+		return
+	}
+	if !t.properties.Contains(RandSeedProperty) {
+		t.properties.SetEntry(RandSeedProperty, PropertyEntry{Value: strconv.FormatInt(t.chaos.seed, 10), Origin: "chaos"})
+	}
+	if !t.properties.Contains(RefreshJitterProperty) {
+		t.properties.SetEntry(RefreshJitterProperty, PropertyEntry{Value: strconv.FormatFloat(ChaosRefreshJitter, 'g', -1, 64), Origin: "chaos"})
+	}
+}
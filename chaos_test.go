@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type chaosBean struct {
+	name  string
+	trace *[]string
+}
+
+func (t *chaosBean) BeanName() string {
+	return t.name
+}
+
+func (t *chaosBean) PostConstruct() error {
+	*t.trace = append(*t.trace, t.name)
+	return nil
+}
+
+type chaosDependent struct {
+	First *chaosRoot `inject:""`
+	trace *[]string
+}
+
+func (t *chaosDependent) PostConstruct() error {
+	*t.trace = append(*t.trace, "dependent")
+	return nil
+}
+
+type chaosRoot struct {
+	trace *[]string
+}
+
+func (t *chaosRoot) PostConstruct() error {
+	*t.trace = append(*t.trace, "root")
+	return nil
+}
+
+func chaosInitOrder(t *testing.T, seed int64) string {
+	var trace []string
+	beans := []any{&chaosDependent{trace: &trace}}
+	for i := 0; i < 8; i++ {
+		beans = append(beans, &chaosBean{name: fmt.Sprintf("b%d", i), trace: &trace})
+	}
+	beans = append(beans, &chaosRoot{trace: &trace})
+
+	ctn, err := glue.NewWithOptions(glue.WithChaos(seed), glue.WithBeans(beans...))
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprint(seed), ctn.Properties().GetString(glue.RandSeedProperty, ""))
+	require.NoError(t, ctn.Close())
+
+	order := strings.Join(trace, ",")
+	// dependencies are constructed first in any order
+	require.Less(t, strings.Index(order, "root"), strings.Index(order, "dependent"))
+	return order
+}
+
+func TestChaosInitOrder(t *testing.T) {
+
+	require.Equal(t, chaosInitOrder(t, 42), chaosInitOrder(t, 42))
+
+	orders := make(map[string]bool)
+	for seed := int64(1); seed <= 10; seed++ {
+		orders[chaosInitOrder(t, seed)] = true
+	}
+	require.Greater(t, len(orders), 1)
+}
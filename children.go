@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrChildNotFound = errors.New("child container not found")
+
+// ChildPathSeparator separates names of nested child containers in the path of Container.Find
+var ChildPathSeparator = "/"
+
+func (t *container) Find(path string) (ChildContainer, error) {
+	names := strings.Split(strings.Trim(path, ChildPathSeparator), ChildPathSeparator)
+	var current Container = t
+	var found ChildContainer
+	for i, name := range names {
+		if found != nil {
+			ctn, err := found.Object()
+			if err != nil {
+				return nil, fmt.Errorf("create child container '%s': %w", strings.Join(names[:i], ChildPathSeparator), err)
+			}
+			current = ctn
+		}
+		found = findChild(current.Children(), name)
+		if found == nil {
+			return nil, fmt.Errorf("%w: '%s'", ErrChildNotFound, strings.Join(names[:i+1], ChildPathSeparator))
+		}
+	}
+	return found, nil
+}
+
+func findChild(list []ChildContainer, name string) ChildContainer {
+	for _, child := range list {
+		if child.ChildName() == name {
+			return child
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type tenantBean struct {
+	Tenant string `value:"tenant.name"`
+}
+
+func TestFindChild(t *testing.T) {
+
+	acme := &tenantBean{}
+	ctn, err := glue.New(
+		glue.Child("worker"),
+		glue.Child("tenants",
+			glue.Child("acme", glue.MapPropertySource{"tenant.name": "acme"}, acme),
+			glue.Child("globex", glue.MapPropertySource{"tenant.name": "globex"}, &tenantBean{}),
+		),
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	worker, err := ctn.Find("worker")
+	require.NoError(t, err)
+	require.Equal(t, "worker", worker.ChildName())
+
+	child, err := ctn.Find("tenants/acme")
+	require.NoError(t, err)
+	require.Equal(t, "acme", child.ChildName())
+
+	tenant, err := child.Object()
+	require.NoError(t, err)
+	list := tenant.Bean(reflect.TypeOf(acme), 1)
+	require.Len(t, list, 1)
+	require.Same(t, acme, list[0].Object())
+	require.Equal(t, "acme", acme.Tenant)
+
+	_, err = ctn.Find("tenants/initech")
+	require.True(t, errors.Is(err, glue.ErrChildNotFound))
+	require.Contains(t, err.Error(), "tenants/initech")
+
+	_, err = ctn.Find("acme")
+	require.True(t, errors.Is(err, glue.ErrChildNotFound))
+}
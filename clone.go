@@ -0,0 +1,215 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+var gluePkgPath = reflect.TypeOf(container{}).PkgPath()
+
+/**
+Declarations of the scan list read by the container, they do not receive injections and are shared by clones
+*/
+
+var cloneDeclarations = map[reflect.Type]bool{
+	ResourceSourceClass:                     true,
+	RemoteResourceSourceClass:               true,
+	TemplateResourceClass:                   true,
+	PropertySourceClass:                     true,
+	reflect.TypeOf((*ParallelInit)(nil)):    true,
+	reflect.TypeOf((*ParallelDestroy)(nil)): true,
+	reflect.TypeOf((*Strict)(nil)):          true,
+	reflect.TypeOf((*Exports)(nil)):         true,
+	reflect.TypeOf((*Imports)(nil)):         true,
+	reflect.TypeOf((*Wiring)(nil)):          true,
+	reflect.TypeOf((*Interceptor)(nil)):     true,
+	reflect.TypeOf((*PluginLoader)(nil)):    true,
+}
+
+/**
+Constructors of glue beans that are initialized by them, the clone gets a new bean as if it was declared by the constructor
+*/
+
+var cloneConstructors = map[reflect.Type]func() any{
+	reflect.TypeOf((*Locks)(nil)):             func() any { return NewLocks() },
+	reflect.TypeOf((*PropertyRefresher)(nil)): func() any { return NewPropertyRefresher() },
+	reflect.TypeOf((*EnvironmentInfo)(nil)):   func() any { return NewEnvironmentInfo() },
+	reflect.TypeOf((*HealthHandler)(nil)):     func() any { return NewHealthHandler() },
+	reflect.TypeOf((*MetricsRegistry)(nil)):   func() any { return NewMetricsRegistry() },
+	reflect.TypeOf((*MetricsHandler)(nil)):    func() any { return NewMetricsHandler() },
+	reflect.TypeOf((*Rand)(nil)):              func() any { return NewRand() },
+	reflect.TypeOf((*IDGenerator)(nil)):       func() any { return NewIDGenerator() },
+}
+
+const cloneOverridesPriority = math.MaxInt32
+
+/**
+Properties of CloneWith, resolved before all other resolvers of the clone and kept by clones of the clone
+*/
+
+type cloneOverrides struct {
+	store map[string]string
+}
+
+func (t *cloneOverrides) Priority() int {
+	return cloneOverridesPriority
+}
+
+func (t *cloneOverrides) GetProperty(key string) (string, bool) {
+	value, ok := t.store[key]
+	return value, ok
+}
+
+func (t *cloneOverrides) Keys() []string {
+	keys := make([]string, 0, len(t.store))
+	for k := range t.store {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+/**
+Records options of the container before the scan, beans are not touched until CloneWith is called.
+*/
+
+func snapshotOptions(options ContainerOptions) (ContainerOptions, map[string]string) {
+	template := options
+	template.Properties = nil
+	template.History = nil
+	var properties map[string]string
+	if options.Properties != nil {
+		properties = options.Properties.Map()
+	}
+	return template, properties
+}
+
+/**
+Builds the scan list of the clone. Constructor functions, factories and scanners are reused, so they produce new beans,
+struct instances are copied and injected again by the clone. The same instance is replaced by the same new one,
+so overrides still match their originals.
+*/
+
+func cloneScan(scan []any, instances map[any]any) ([]any, error) {
+	list := make([]any, len(scan))
+	for i, item := range scan {
+		var err error
+		switch obj := item.(type) {
+		case *beanWrapper:
+			var inner any
+			if inner, err = cloneBean(obj.obj, instances); err == nil {
+				list[i] = &beanWrapper{obj: inner, options: obj.options, conditions: obj.conditions}
+			}
+		case *prototypeFactoryBean:
+			// the template is copied by every request of the prototype, the clone does the same
+			list[i] = obj
+		case *beanOverride:
+			var original, replacement any
+			if original, err = cloneBean(obj.original, instances); err == nil {
+				var cloned []any
+				if cloned, err = cloneScan([]any{obj.replacement}, instances); err == nil {
+					replacement = cloned[0]
+					list[i] = &beanOverride{original: original, replacement: replacement}
+				}
+			}
+		case *childContext:
+			var children []any
+			if children, err = cloneScan(obj.scan, instances); err == nil {
+				list[i] = &childContext{name: obj.name, scan: children}
+			}
+		case []any:
+			list[i], err = cloneScan(obj, instances)
+		default:
+			list[i], err = cloneBean(item, instances)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+func cloneBean(obj any, instances map[any]any) (any, error) {
+	classPtr := reflect.TypeOf(obj)
+	if classPtr == nil || classPtr.Kind() != reflect.Ptr || classPtr.Elem().Kind() != reflect.Struct || cloneDeclarations[classPtr] {
+		return obj, nil
+	}
+	if _, ok := obj.(Scanner); ok {
+		// asked for beans again by the clone
+		return obj, nil
+	}
+	if instance, ok := instances[obj]; ok {
+		return instance, nil
+	}
+	if classPtr.Elem().PkgPath() == gluePkgPath {
+		switch obj.(type) {
+		case FactoryBean, ContextFactoryBean, *cloneOverrides:
+			// wrappers of factory functions and immutable overrides
+			return obj, nil
+		}
+		ctor, ok := cloneConstructors[classPtr]
+		if !ok {
+			return nil, fmt.Errorf("bean '%v' is initialized by its constructor, declare it by the constructor function to clone the container", classPtr)
+		}
+		instance := ctor()
+		instances[obj] = instance
+		return instance, nil
+	}
+	// fields set by the user are kept, injected fields are replaced by the clone
+	copied := reflect.New(classPtr.Elem())
+	copied.Elem().Set(reflect.ValueOf(obj).Elem())
+	instance := copied.Interface()
+	instances[obj] = instance
+	return instance, nil
+}
+
+func (t *container) CloneWith(props map[string]string) (Container, error) {
+	options := t.template
+	beans, err := cloneScan(t.template.Beans, make(map[any]any))
+	if err != nil {
+		return nil, err
+	}
+	options.Beans = beans
+
+	properties := NewProperties()
+	if t.parent != nil {
+		properties.Extend(t.parent.properties)
+	}
+	for key, value := range t.templateProperties {
+		properties.Set(key, value)
+	}
+	options.Properties = properties
+
+	if len(props) > 0 {
+		// overrides of the clone being cloned are merged, so the new ones win and only one resolver is kept
+		overrides := &cloneOverrides{store: make(map[string]string, len(props))}
+		list := options.Beans[:0:0]
+		for _, item := range options.Beans {
+			if previous, ok := item.(*cloneOverrides); ok {
+				for key, value := range previous.store {
+					overrides.store[key] = value
+				}
+				continue
+			}
+			list = append(list, item)
+		}
+		for key, value := range props {
+			overrides.store[key] = value
+		}
+		options.Beans = append(list, overrides)
+	}
+
+	c, err := createContainer(t.parent, options)
+	if err != nil {
+		return nil, err
+	}
+	if t.parent != nil {
+		t.parent.trackExtended(c)
+	}
+	return c, nil
+}
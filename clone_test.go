@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type cloneRates struct {
+	Rate float64 `value:"sim.rate,default=1.5"`
+	Days int     `value:"sim.days"`
+}
+
+type cloneModel struct {
+	Rates *cloneRates `inject:""`
+}
+
+func TestCloneWith(t *testing.T) {
+
+	model := &cloneModel{}
+	ctn, err := glue.New(model, &cloneRates{}, glue.MapPropertySource{"sim.days": 30})
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, 1.5, model.Rates.Rate)
+	require.Equal(t, 30, model.Rates.Days)
+
+	clone, err := ctn.CloneWith(map[string]string{"sim.rate": "2.5"})
+	require.NoError(t, err)
+	defer clone.Close()
+
+	cloned, err := glue.GetBean[*cloneModel](clone)
+	require.NoError(t, err)
+	require.NotSame(t, model, cloned)
+	require.NotSame(t, model.Rates, cloned.Rates)
+	require.Equal(t, 2.5, cloned.Rates.Rate)
+	require.Equal(t, 30, cloned.Rates.Days)
+
+	// original is not affected
+	require.Equal(t, 1.5, model.Rates.Rate)
+	require.False(t, ctn.Properties().Contains("sim.rate"))
+
+	again, err := clone.CloneWith(map[string]string{"sim.days": "7"})
+	require.NoError(t, err)
+	defer again.Close()
+
+	rates, err := glue.GetBean[*cloneRates](again)
+	require.NoError(t, err)
+	// overrides of the clone are kept
+	require.Equal(t, 2.5, rates.Rate)
+	require.Equal(t, 7, rates.Days)
+}
+
+type cloneWorker struct {
+	Locks *glue.Locks `inject:""`
+}
+
+type cloneClient struct {
+	Addr string
+	Port int `value:"client.port,default=80"`
+}
+
+func TestCloneWithCopiesFields(t *testing.T) {
+
+	client := &cloneClient{Addr: "localhost"}
+	ctn, err := glue.New(client, glue.PropertyMap{"client.port": "8080"})
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, 8080, client.Port)
+
+	clone, err := ctn.CloneWith(map[string]string{"client.port": "9090"})
+	require.NoError(t, err)
+	defer clone.Close()
+
+	cloned, err := glue.GetBean[*cloneClient](clone)
+	require.NoError(t, err)
+	require.NotSame(t, client, cloned)
+	require.Equal(t, "localhost", cloned.Addr)
+	// overrides win over PropertyMap
+	require.Equal(t, 9090, cloned.Port)
+	require.Equal(t, 8080, client.Port)
+}
+
+func TestCloneWithGlueBeans(t *testing.T) {
+
+	// beans initialized by glue constructors are created again by them
+	ctn, err := glue.New(glue.NewLocks(), glue.NewPropertyRefresher(), &cloneWorker{})
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	clone, err := ctn.CloneWith(nil)
+	require.NoError(t, err)
+	defer clone.Close()
+
+	original, err := glue.GetBean[*cloneWorker](ctn)
+	require.NoError(t, err)
+	cloned, err := glue.GetBean[*cloneWorker](clone)
+	require.NoError(t, err)
+	require.NotSame(t, original.Locks, cloned.Locks)
+
+	refresher, err := glue.GetBean[*glue.PropertyRefresher](ctn)
+	require.NoError(t, err)
+	clonedRefresher, err := glue.GetBean[*glue.PropertyRefresher](clone)
+	require.NoError(t, err)
+	require.NotSame(t, refresher, clonedRefresher)
+}
+
+func TestCloneWithConstructors(t *testing.T) {
+
+	skipUnsupported(t, glue.FeatureConstructors)
+
+	// constructors are called again for the clone
+	ctn, err := glue.New(glue.NewLocks, &cloneWorker{})
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	clone, err := ctn.CloneWith(nil)
+	require.NoError(t, err)
+	defer clone.Close()
+
+	original, err := glue.GetBean[*cloneWorker](ctn)
+	require.NoError(t, err)
+	cloned, err := glue.GetBean[*cloneWorker](clone)
+	require.NoError(t, err)
+	require.NotSame(t, original.Locks, cloned.Locks)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			cloned.Locks.Lock("a/b")()
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		original.Locks.Lock("a/c")()
+	}
+	<-done
+}
@@ -0,0 +1,33 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"reflect"
+)
+
+/**
+Injects 'value' fields of the collection factory and returns produced objects,
+beans of the container are not available yet, since the set of beans is still growing
+*/
+
+func (t *container) collectionObjects(factory CollectionFactoryBean) ([]any, error) {
+	valuePtr := reflect.ValueOf(factory)
+	if valuePtr.Kind() == reflect.Ptr && valuePtr.Elem().Kind() == reflect.Struct {
+		bd, err := cachedBeanDef(valuePtr.Type())
+		if err != nil {
+			return nil, err
+		}
+		value := valuePtr.Elem()
+		properties := auditedProperties(t.properties, valuePtr.Type().String())
+		for _, def := range bd.properties {
+			if err := def.inject(&value, properties, &t.valueDefaults); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return factory.Objects()
+}
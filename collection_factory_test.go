@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type topicConsumer struct {
+	Topic     string
+	Group     string `value:"consumer.group"`
+	started   bool
+	destroyed *int
+}
+
+func (t *topicConsumer) PostConstruct() error {
+	t.started = true
+	return nil
+}
+
+func (t *topicConsumer) Destroy() error {
+	*t.destroyed++
+	return nil
+}
+
+type topicConsumerFactory struct {
+	Topics    []string `value:"consumer.topics"`
+	destroyed int
+}
+
+func (t *topicConsumerFactory) Objects() ([]any, error) {
+	var list []any
+	for _, topic := range t.Topics {
+		list = append(list, glue.Facet(&topicConsumer{Topic: topic, destroyed: &t.destroyed}, "consumer."+topic, ""))
+	}
+	return list, nil
+}
+
+type topicDispatcher struct {
+	Consumers []*topicConsumer `inject:""`
+	Orders    *topicConsumer   `inject:"bean=consumer.orders"`
+}
+
+type brokenCollectionFactory struct{}
+
+func (t *brokenCollectionFactory) Objects() ([]any, error) {
+	return nil, errors.New("no broker")
+}
+
+func TestCollectionFactoryBean(t *testing.T) {
+
+	factory := &topicConsumerFactory{}
+	dispatcher := &topicDispatcher{}
+	ctn, err := glue.New(
+		factory,
+		dispatcher,
+		glue.MapPropertySource{"consumer.topics": "orders;payments", "consumer.group": "billing"},
+	)
+	require.NoError(t, err)
+
+	require.Len(t, dispatcher.Consumers, 2)
+	var topics []string
+	for _, c := range dispatcher.Consumers {
+		topics = append(topics, c.Topic)
+		require.True(t, c.started)
+		require.Equal(t, "billing", c.Group)
+	}
+	sort.Strings(topics)
+	require.Equal(t, []string{"orders", "payments"}, topics)
+	require.Equal(t, "orders", dispatcher.Orders.Topic)
+
+	require.NoError(t, ctn.Close())
+	require.Equal(t, 2, factory.destroyed)
+}
+
+func TestCollectionFactoryBeanError(t *testing.T) {
+
+	_, err := glue.New(&brokenCollectionFactory{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no broker")
+}
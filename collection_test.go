@@ -6,6 +6,7 @@
 package glue_test
 
 import (
+	"context"
 	"github.com/stretchr/testify/require"
 	"go.arpabet.com/glue"
 	"reflect"
@@ -464,3 +465,152 @@ func TestMapSpecificByInterface(t *testing.T) {
 	require.Equal(t, 1, len(holder.Elements()))
 
 }
+
+type elementFactory struct {
+	name string
+}
+
+func (t *elementFactory) Object() (any, error) {
+	return &elementImpl{name: t.name}, nil
+}
+
+func (t *elementFactory) ObjectType() reflect.Type {
+	return reflect.TypeOf((*elementImpl)(nil))
+}
+
+func (t *elementFactory) ObjectName() string {
+	return t.name
+}
+
+func (t *elementFactory) Singleton() bool {
+	return true
+}
+
+type elementMapConsumer struct {
+	Elements map[string]Element `inject:""`
+}
+
+func TestMapByInterfaceWithFactory(t *testing.T) {
+
+	consumer := &elementMapConsumer{}
+	ctx, err := glue.New(
+		&elementImpl{name: "card"},
+		&elementFactory{name: "wire"},
+		consumer,
+	)
+	require.NoError(t, err)
+	defer ctx.Close()
+
+	require.Equal(t, 2, len(consumer.Elements))
+	require.Equal(t, "card", consumer.Elements["card"].BeanName())
+	require.Equal(t, "wire", consumer.Elements["wire"].BeanName())
+
+	runtime := &elementMapConsumer{}
+	require.NoError(t, ctx.Inject(runtime))
+	require.Equal(t, 2, len(runtime.Elements))
+	require.Same(t, consumer.Elements["card"], runtime.Elements["card"])
+	require.Same(t, consumer.Elements["wire"], runtime.Elements["wire"])
+}
+
+type elementNameKey struct{}
+
+type elementContextFactory struct {
+}
+
+func (t *elementContextFactory) Object(ctx context.Context) (any, error) {
+	name, _ := ctx.Value(elementNameKey{}).(string)
+	return &elementImpl{name: name}, nil
+}
+
+func (t *elementContextFactory) ObjectType() reflect.Type {
+	return reflect.TypeOf((*elementImpl)(nil))
+}
+
+func (t *elementContextFactory) ObjectName() string {
+	return "scoped"
+}
+
+func (t *elementContextFactory) Singleton() bool {
+	return false
+}
+
+func TestMapByInterfaceWithContextFactory(t *testing.T) {
+
+	ctn, err := glue.New(
+		&elementContextFactory{},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	// prototype elements are produced again with the context of every scope
+	for _, name := range []string{"first", "second"} {
+		consumer := &elementMapConsumer{}
+		scope, err := ctn.NewScopeWithContext(context.WithValue(context.Background(), elementNameKey{}, name), consumer)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(consumer.Elements))
+		for _, element := range consumer.Elements {
+			require.Equal(t, name, element.BeanName())
+		}
+		scope.Close()
+	}
+}
+
+type orderStep interface {
+	Step() string
+}
+
+type plainStep struct {
+	name string
+}
+
+func (t *plainStep) Step() string {
+	return t.name
+}
+
+type orderedStep struct {
+	plainStep
+	order int
+}
+
+func (t *orderedStep) BeanOrder() int {
+	return t.order
+}
+
+type otherStep struct {
+	plainStep
+}
+
+type stepHolder struct {
+	Steps []orderStep `inject:""`
+}
+
+func TestCollectionOrderGuarantees(t *testing.T) {
+
+	for i := 0; i < 10; i++ {
+		holder := &stepHolder{}
+		ctn, err := glue.New(
+			&plainStep{name: "plain-1"},
+			&otherStep{plainStep{name: "other"}},
+			glue.Order(&plainStep{name: "wrapped-5"}, 5),
+			&orderedStep{plainStep{name: "ordered-5"}, 5},
+			&orderedStep{plainStep{name: "ordered-1"}, 1},
+			&plainStep{name: "plain-2"},
+			holder,
+		)
+		require.NoError(t, err)
+
+		var names []string
+		for _, s := range holder.Steps {
+			names = append(names, s.Step())
+		}
+		// ordered beans first, ties and unordered beans in registration order
+		require.Equal(t, []string{"ordered-1", "wrapped-5", "ordered-5", "plain-1", "other", "plain-2"}, names)
+
+		list := ctn.Bean(reflect.TypeOf((*orderStep)(nil)).Elem(), glue.DefaultSearchLevel)
+		require.Equal(t, len(names), len(list))
+		for j, b := range list {
+			require.Equal(t, names[j], b.Object().(orderStep).Step())
+		}
+		ctn.Close()
+	}
+}
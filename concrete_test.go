@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type concreteLabeled interface {
+	Label() string
+}
+
+type concreteBuffer struct {
+	destroyed bool
+}
+
+func (t *concreteBuffer) Label() string {
+	return "buffer"
+}
+
+func (t *concreteBuffer) Destroy() error {
+	t.destroyed = true
+	return nil
+}
+
+type concreteGreeter struct{}
+
+func (t *concreteGreeter) Label() string {
+	return "greeter"
+}
+
+type concreteConsumer struct {
+	Buffer  *concreteBuffer   `inject:""`
+	Labeled concreteLabeled   `inject:""`
+	All     []concreteLabeled `inject:""`
+}
+
+func TestConcreteOnly(t *testing.T) {
+
+	buffer := &concreteBuffer{}
+	consumer := &concreteConsumer{}
+
+	ctn, err := glue.New(glue.ConcreteOnly(buffer), &concreteGreeter{}, consumer)
+	require.NoError(t, err)
+
+	require.Same(t, buffer, consumer.Buffer)
+	require.Equal(t, "greeter", consumer.Labeled.Label())
+	require.Equal(t, 1, len(consumer.All))
+
+	require.Equal(t, 1, len(glue.GetBeans[concreteLabeled](ctn)))
+
+	require.Equal(t, 1, len(ctn.Lookup("*glue_test.concreteBuffer", glue.DefaultSearchLevel)))
+
+	require.NoError(t, ctn.Close())
+	require.True(t, buffer.destroyed)
+
+	_, err = glue.New(glue.ConcreteOnly(&concreteBuffer{}), &concreteConsumer{})
+	require.Error(t, err)
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import "reflect"
+
+/**
+ConditionalOnProperty registers the object only if the property resolves to the value.
+Empty value requires the property to exist and not to be 'false'.
+*/
+
+func ConditionalOnProperty(obj any, key, value string) any {
+	return conditionalBean(obj, func(c *container) bool {
+		actual, ok, err := c.properties.Resolve(key)
+		if err != nil || !ok {
+			return false
+		}
+		if value == "" {
+			return actual != "false"
+		}
+		return actual == value
+	})
+}
+
+/**
+ConditionalOnMissingBean registers the object only if there is no bean of the type in the container or its parents.
+Beans registered by conditions evaluated earlier are taken in to account, so the order of conditional beans matters.
+*/
+
+func ConditionalOnMissingBean(obj any, typ reflect.Type) any {
+	return conditionalBean(obj, func(c *container) bool {
+		return !c.hasBean(typ)
+	})
+}
+
+/**
+ConditionalOnBean registers the object only if there is the bean of the type in the container or its parents.
+*/
+
+func ConditionalOnBean(obj any, typ reflect.Type) any {
+	return conditionalBean(obj, func(c *container) bool {
+		return c.hasBean(typ)
+	})
+}
+
+func (t *container) shouldRegisterDeferred(obj any) bool {
+	if conditional, ok := unwrapBeanObj(obj).(PropertyConditionalBean); ok {
+		if !conditional.ShouldRegisterWithProperties(t.properties) {
+			return false
+		}
+	}
+	for _, condition := range beanConditions(obj) {
+		if !condition(t) {
+			return false
+		}
+	}
+	return true
+}
+
+/**
+Checks registered beans without the interface cache, since the container is still under construction
+*/
+
+func (t *container) hasBean(typ reflect.Type) bool {
+	for ctx := t; ctx != nil; ctx = ctx.parent {
+		switch typ.Kind() {
+		case reflect.Interface:
+			if len(t.visible(ctx, ctx.searchInterfaceCandidates(typ))) > 0 {
+				return true
+			}
+		default:
+			if len(t.visible(ctx, ctx.core[typ])) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
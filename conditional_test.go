@@ -6,6 +6,7 @@
 package glue_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -100,3 +101,76 @@ func TestProfileFailsConditionSkipped(t *testing.T) {
 	list := ctx.Bean(glue.ConditionalBeanClass, glue.DefaultSearchLevel)
 	require.Len(t, list, 0)
 }
+
+type CacheStore interface {
+	Kind() string
+}
+
+type memoryCacheStore struct{}
+
+func (t *memoryCacheStore) Kind() string {
+	return "memory"
+}
+
+type redisCacheStore struct{}
+
+func (t *redisCacheStore) Kind() string {
+	return "redis"
+}
+
+type cacheStoreConsumer struct {
+	Store CacheStore `inject:""`
+}
+
+var cacheStoreClass = reflect.TypeOf((*CacheStore)(nil)).Elem()
+
+func autoConfiguredCache() []any {
+	return []any{
+		glue.ConditionalOnProperty(&redisCacheStore{}, "cache.type", "redis"),
+		glue.ConditionalOnMissingBean(&memoryCacheStore{}, cacheStoreClass),
+	}
+}
+
+func TestConditionalOnPropertyAndMissingBean(t *testing.T) {
+
+	consumer := &cacheStoreConsumer{}
+	ctx, err := glue.New(autoConfiguredCache(), consumer, glue.MapPropertySource{"cache.type": "redis"})
+	require.NoError(t, err)
+	defer ctx.Close()
+	require.Equal(t, "redis", consumer.Store.Kind())
+
+	consumer = &cacheStoreConsumer{}
+	ctx, err = glue.New(autoConfiguredCache(), consumer)
+	require.NoError(t, err)
+	defer ctx.Close()
+	require.Equal(t, "memory", consumer.Store.Kind())
+}
+
+func TestConditionalOnMissingBeanInParent(t *testing.T) {
+
+	parent, err := glue.New(&redisCacheStore{})
+	require.NoError(t, err)
+	defer parent.Close()
+
+	child, err := parent.Extend(glue.ConditionalOnMissingBean(&memoryCacheStore{}, cacheStoreClass))
+	require.NoError(t, err)
+	defer child.Close()
+
+	require.Equal(t, 0, len(child.Bean(reflect.TypeOf((*memoryCacheStore)(nil)), glue.SearchCurrent)))
+}
+
+func TestConditionalOnPropertyPresence(t *testing.T) {
+
+	ctx, err := glue.New(
+		glue.Primary(glue.ConditionalOnProperty(&redisCacheStore{}, "cache.redis.url", "")),
+		glue.ConditionalOnBean(&memoryCacheStore{}, reflect.TypeOf((*redisCacheStore)(nil))),
+		glue.MapPropertySource{"cache.redis.url": "redis://localhost"},
+	)
+	require.NoError(t, err)
+	defer ctx.Close()
+
+	store, err := glue.BeanOf[CacheStore](ctx)
+	require.NoError(t, err)
+	require.Equal(t, "redis", store.Kind())
+	require.Equal(t, 2, len(glue.GetBeans[CacheStore](ctx)))
+}
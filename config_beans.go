@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BeansProperty is the prefix of beans declared in properties, e.g. 'beans.userCache.type=lru'
+var BeansProperty = "beans"
+
+// BeanTypeProperty is the key of the declared bean selecting the TypeFactory by BeanType
+var BeanTypeProperty = "type"
+
+/**
+Creates beans declared under BeansProperty by TypeFactory beans, every object is registered with the name
+of the declaration and reads its 'value' fields relative to the declaration, like glue.Facet does
+*/
+
+func (t *container) declaredBeans(factories []TypeFactory) ([]any, error) {
+	byType := make(map[string]TypeFactory)
+	for _, f := range factories {
+		typ := f.BeanType()
+		if prev, ok := byType[typ]; ok {
+			return nil, fmt.Errorf("type factories '%T' and '%T' declare the same bean type '%s'", prev, f, typ)
+		}
+		byType[typ] = f
+	}
+
+	prefix := BeansProperty + "."
+	declared := make(map[string]map[string]string)
+	for _, key := range t.properties.Keys() {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		rest := key[len(prefix):]
+		i := strings.IndexByte(rest, '.')
+		if i <= 0 {
+			continue
+		}
+		name, prop := rest[:i], rest[i+1:]
+		value, ok := t.properties.Get(key)
+		if !ok {
+			continue
+		}
+		if declared[name] == nil {
+			declared[name] = make(map[string]string)
+		}
+		declared[name][prop] = value
+	}
+
+	var list []any
+	for _, name := range sortedKeys(declared) {
+		props := declared[name]
+		typ, ok := props[BeanTypeProperty]
+		if !ok {
+			return nil, fmt.Errorf("declared bean '%s' has no property '%s%s.%s'", name, prefix, name, BeanTypeProperty)
+		}
+		factory, ok := byType[typ]
+		if !ok {
+			return nil, fmt.Errorf("declared bean '%s' has unknown type '%s', known types %v", name, typ, sortedKeys(byType))
+		}
+		delete(props, BeanTypeProperty)
+		obj, err := factory.NewBean(name, props)
+		if err != nil {
+			return nil, fmt.Errorf("type factory '%T' failed to create declared bean '%s': %w", factory, name, err)
+		}
+		if obj == nil {
+			return nil, fmt.Errorf("type factory '%T' returned nil for declared bean '%s'", factory, name)
+		}
+		t.logf(LogInfo, "Declared bean '%s' of type '%s' %T\n", name, typ, obj)
+		list = append(list, Facet(obj, name, prefix+name))
+	}
+	return list, nil
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type declaredCache interface {
+	Capacity() int
+}
+
+type lruCache struct {
+	Size int    `value:"size,default=16"`
+	Name string `value:"name,default=cache"`
+}
+
+func (t *lruCache) Capacity() int {
+	return t.Size
+}
+
+type lruCacheFactory struct {
+	names []string
+}
+
+func (t *lruCacheFactory) BeanType() string {
+	return "lru"
+}
+
+func (t *lruCacheFactory) NewBean(name string, props map[string]string) (any, error) {
+	t.names = append(t.names, name)
+	return &lruCache{}, nil
+}
+
+type declaredCacheUser struct {
+	Caches    []declaredCache `inject:""`
+	UserCache *lruCache       `inject:"bean=userCache"`
+}
+
+func TestDeclaredBeans(t *testing.T) {
+
+	factory := &lruCacheFactory{}
+	user := &declaredCacheUser{}
+	ctn, err := glue.New(
+		factory,
+		user,
+		&glue.PropertySource{Map: map[string]any{
+			"beans": map[string]any{
+				"userCache":    map[string]any{"type": "lru", "size": 1000},
+				"sessionCache": map[string]any{"type": "lru"},
+			},
+		}},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, []string{"sessionCache", "userCache"}, factory.names)
+	require.Len(t, user.Caches, 2)
+	require.Equal(t, 1000, user.UserCache.Size)
+	require.Equal(t, "cache", user.UserCache.Name)
+
+	list := ctn.Lookup("sessionCache", 0)
+	require.Len(t, list, 1)
+	require.Equal(t, 16, list[0].Object().(*lruCache).Size)
+}
+
+func TestDeclaredBeansUnknownType(t *testing.T) {
+
+	_, err := glue.New(
+		&lruCacheFactory{},
+		glue.MapPropertySource{"beans.store.type": "redis"},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unknown type 'redis'")
+
+	_, err = glue.New(
+		&lruCacheFactory{},
+		glue.MapPropertySource{"beans.store.size": "10"},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "beans.store.type")
+}
@@ -0,0 +1,203 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/**
+PropertyMigration is the declarative ConfigMigration renaming and splitting keys,
+Fn is called after renames and splits for other changes.
+*/
+
+type PropertyMigration struct {
+	From string
+	To   string
+
+	// old key to new key, the value, origin and comments are moved
+	Rename map[string]string
+
+	// old key to new keys, the value is split by Separator, the last key gets the rest
+	Split     map[string][]string
+	Separator string
+
+	Fn func(props Properties) error
+}
+
+func (t *PropertyMigration) FromVersion() string {
+	return t.From
+}
+
+func (t *PropertyMigration) ToVersion() string {
+	return t.To
+}
+
+func (t *PropertyMigration) Migrate(props Properties) error {
+	for _, from := range sortedKeys(t.Rename) {
+		if entry, ok := props.GetEntry(from); ok {
+			props.Remove(from)
+			props.SetEntry(t.Rename[from], entry)
+		}
+	}
+	sep := t.Separator
+	if sep == "" {
+		sep = ","
+	}
+	for _, from := range sortedKeys(t.Split) {
+		keys := t.Split[from]
+		entry, ok := props.GetEntry(from)
+		if !ok || len(keys) == 0 {
+			continue
+		}
+		props.Remove(from)
+		for i, part := range strings.SplitN(entry.Value, sep, len(keys)) {
+			props.SetEntry(keys[i], PropertyEntry{Value: strings.TrimSpace(part), Origin: entry.Origin})
+		}
+	}
+	if t.Fn != nil {
+		return t.Fn(props)
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+/**
+Semantic version, pre-release versions precede the release, build metadata is ignored
+*/
+
+type schemaVersion struct {
+	parts      [3]int
+	preRelease string
+}
+
+func parseSchemaVersion(s string) (schemaVersion, error) {
+	var v schemaVersion
+	str := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if i := strings.IndexByte(str, '+'); i >= 0 {
+		str = str[:i]
+	}
+	if i := strings.IndexByte(str, '-'); i >= 0 {
+		str, v.preRelease = str[:i], str[i+1:]
+	}
+	list := strings.Split(str, ".")
+	if str == "" || len(list) > 3 {
+		return v, fmt.Errorf("invalid semantic version '%s'", s)
+	}
+	for i, part := range list {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return v, fmt.Errorf("invalid semantic version '%s'", s)
+		}
+		v.parts[i] = n
+	}
+	return v, nil
+}
+
+func (t schemaVersion) compare(other schemaVersion) int {
+	for i := range t.parts {
+		if t.parts[i] != other.parts[i] {
+			if t.parts[i] < other.parts[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case t.preRelease == other.preRelease:
+		return 0
+	case t.preRelease == "":
+		return 1
+	case other.preRelease == "":
+		return -1
+	case t.preRelease < other.preRelease:
+		return -1
+	default:
+		return 1
+	}
+}
+
+type versionedMigration struct {
+	migration ConfigMigration
+	from, to  schemaVersion
+}
+
+/**
+Applies the chain of migrations to the loaded properties, configuration without version is not migrated
+*/
+
+func (t *container) migrateConfig(migrations []ConfigMigration) error {
+	if len(migrations) == 0 {
+		return nil
+	}
+	value, ok := t.properties.GetProperty(ConfigSchemaVersionProperty)
+	if !ok {
+		t.logf(LogDebug, "Property '%s' is not defined, config migrations skipped\n", ConfigSchemaVersionProperty)
+		return nil
+	}
+	current, err := parseSchemaVersion(value)
+	if err != nil {
+		return fmt.Errorf("property '%s': %w", ConfigSchemaVersionProperty, err)
+	}
+
+	list := make([]versionedMigration, 0, len(migrations))
+	for _, m := range migrations {
+		from, err := parseSchemaVersion(m.FromVersion())
+		if err != nil {
+			return fmt.Errorf("config migration '%T' from version: %w", m, err)
+		}
+		to, err := parseSchemaVersion(m.ToVersion())
+		if err != nil {
+			return fmt.Errorf("config migration '%T' to version: %w", m, err)
+		}
+		if to.compare(from) <= 0 {
+			return fmt.Errorf("config migration '%T' must upgrade the version, from '%s' to '%s'", m, m.FromVersion(), m.ToVersion())
+		}
+		list = append(list, versionedMigration{migration: m, from: from, to: to})
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].from.compare(list[j].from) < 0
+	})
+	for i := 1; i < len(list); i++ {
+		if list[i].from.compare(list[i-1].from) == 0 {
+			return fmt.Errorf("config migrations '%T' and '%T' both upgrade from version '%s'", list[i-1].migration, list[i].migration, list[i].migration.FromVersion())
+		}
+	}
+
+	version := value
+	for _, m := range list {
+		if m.from.compare(current) > 0 || current.compare(m.to) >= 0 {
+			continue
+		}
+		step := AppliedMigration{From: version, To: m.migration.ToVersion(), Migration: fmt.Sprintf("%T", m.migration)}
+		if err := m.migration.Migrate(t.properties); err != nil {
+			return fmt.Errorf("config migration '%s' from '%s' to '%s': %w", step.Migration, step.From, step.To, err)
+		}
+		t.logf(LogInfo, "Config migration '%s' from '%s' to '%s'\n", step.Migration, step.From, step.To)
+		t.recordEvent(fmt.Sprintf("config migrated from '%s' to '%s'", step.From, step.To), nil)
+		t.migrations = append(t.migrations, step)
+		current, version = m.to, step.To
+	}
+	if version != value {
+		t.properties.SetEntry(ConfigSchemaVersionProperty, PropertyEntry{Value: version, Origin: "migration"})
+	}
+	return nil
+}
+
+func (t *container) ConfigMigrations() []AppliedMigration {
+	return append([]AppliedMigration(nil), t.migrations...)
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type migratedServer struct {
+	Host    string `value:"server.host"`
+	Port    int    `value:"server.port"`
+	Timeout string `value:"server.timeout"`
+}
+
+type timeoutMigration struct{}
+
+func (t *timeoutMigration) FromVersion() string { return "1.1" }
+
+func (t *timeoutMigration) ToVersion() string { return "2.0.0" }
+
+func (t *timeoutMigration) Migrate(props glue.Properties) error {
+	if v, ok := props.GetProperty("server.timeout.seconds"); ok {
+		props.Remove("server.timeout.seconds")
+		props.Set("server.timeout", v+"s")
+	}
+	return nil
+}
+
+func TestConfigMigration(t *testing.T) {
+
+	server := &migratedServer{}
+	ctn, err := glue.New(
+		&glue.PropertySource{Map: map[string]any{
+			"config.schema.version":  "1.0.0",
+			"listen.address":         "localhost:8080",
+			"server.timeout.seconds": "30",
+		}},
+		&timeoutMigration{},
+		&glue.PropertyMigration{
+			From:      "1.0.0",
+			To:        "1.1.0",
+			Split:     map[string][]string{"listen.address": {"server.host", "server.port"}},
+			Separator: ":",
+		},
+		server,
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, "localhost", server.Host)
+	require.Equal(t, 8080, server.Port)
+	require.Equal(t, "30s", server.Timeout)
+	require.Equal(t, "2.0.0", ctn.Properties().GetString("config.schema.version", ""))
+	require.Equal(t, []glue.AppliedMigration{
+		{From: "1.0.0", To: "1.1.0", Migration: "*glue.PropertyMigration"},
+		{From: "1.1.0", To: "2.0.0", Migration: "*glue_test.timeoutMigration"},
+	}, ctn.ConfigMigrations())
+	require.False(t, ctn.Properties().Contains("listen.address"))
+}
+
+func TestConfigMigrationCurrentVersion(t *testing.T) {
+
+	ctn, err := glue.New(
+		&glue.PropertySource{Map: map[string]any{
+			"config.schema.version": "2.0.0",
+			"db.url":                "mem",
+		}},
+		&glue.PropertyMigration{From: "1.0.0", To: "2.0.0", Rename: map[string]string{"db.url": "db.address"}},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Empty(t, ctn.ConfigMigrations())
+	require.Equal(t, "mem", ctn.Properties().GetString("db.url", ""))
+
+	_, err = glue.New(
+		&glue.PropertySource{Map: map[string]any{"config.schema.version": "1.0.0"}},
+		&glue.PropertyMigration{From: "1.0.0", To: "2.0.0"},
+		&glue.PropertyMigration{From: "1.0", To: "1.5.0"},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "both upgrade from version")
+}
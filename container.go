@@ -16,6 +16,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"errors"
@@ -26,6 +27,12 @@ import (
 
 var DefaultCloseTimeout = time.Minute
 
+// beanSeq numbers registered beans of all containers
+var beanSeq uint64
+
+// beanUIDs numbers beans of all containers in history events
+var beanUIDs uint64
+
 type container struct {
 
 	/**
@@ -38,6 +45,12 @@ type container struct {
 	*/
 	children []ChildContainer
 
+	/**
+	Live containers created by Extend and CloneWith of children in creation order, closed before this container
+	*/
+	extended   []*container
+	extendedMu sync.Mutex
+
 	/**
 		All instances scanned during creation of container.
 	    No modifications on runtime allowed.
@@ -53,13 +66,76 @@ type container struct {
 	/**
 	List of beans in initialization order that should depose on close
 	*/
-	disposables []*bean
+	disposables   []*bean
+	disposablesMu sync.Mutex
+
+	/**
+	Concurrent initialization of independent beans if present in the scan list
+	*/
+	parallelInit *ParallelInit
+
+	/**
+	Checks of unused beans and misspelled tags if present in the scan list
+	*/
+	strict *Strict
+
+	/**
+	Beans of the container visible to children and beans of parents visible to the container, nil if not limited
+	*/
+	exports *Exports
+	imports *Imports
+
+	/**
+	Concurrent destruction of independent beans if present in the scan list
+	*/
+	parallelDestroy *ParallelDestroy
+
+	/**
+	Interceptors of injected interfaces in registration order
+	*/
+	interceptors []*Interceptor
 
 	/**
 	Mutable cache for interface-to-implementation lookups
 	*/
 	ifaceCache interfaceCache
 
+	/**
+	Runtime lookups and injections by requested type, reflect.Type to *typeUsage
+	*/
+	usage sync.Map
+
+	/**
+	Bean names selected by Wiring manifests for interface types
+	*/
+	wiringSelect map[string]string
+
+	/**
+	Active profiles of the container
+	*/
+	profiles *activeProfiles
+
+	/**
+	Random source of the chaos mode, nil if it is off
+	*/
+	chaos *chaosSource
+
+	/**
+	Configuration migrations applied at load time
+	*/
+	migrations []AppliedMigration
+
+	/**
+	Lifecycle hooks and built-in timing of bean phases
+	*/
+	hooks   []LifecycleHook
+	timings timingCollector
+
+	/**
+	Cancels the construction context passed to FactoryBeanCtx factories, on failed creation or close
+	*/
+	startupCancel context.CancelFunc
+
 	/**
 	Resource sources registered during container creation.
 	No modifications on runtime allowed.
@@ -81,6 +157,81 @@ type container struct {
 	*/
 	logger ContainerLogger
 
+	/*
+		Level of written messages, wiring messages are sampled by logSampling, counted atomically
+	*/
+	logLevel       LogLevel
+	logSampling    int
+	wiringMessages uint64
+
+	/*
+		Defaults of 'value' tags
+	*/
+	valueDefaults ValueDefaults
+
+	/*
+		Values injected in to fields if the report is enabled
+	*/
+	injectionReport bool
+	injections      []InjectionRecord
+
+	/*
+		Timeouts of PostConstruct and Destroy for beans without their own
+	*/
+	postConstructTimeout time.Duration
+	destroyTimeout       time.Duration
+
+	/**
+	Options and properties recorded before the scan, used by CloneWith
+	*/
+	template           ContainerOptions
+	templateProperties map[string]string
+
+	/**
+	Beans subscribed to property changes, unsubscribed on close
+	*/
+	propertyListeners []PropertyChangeListener
+
+	/**
+	Beans added by Register after the container creation
+	*/
+	registry   runtimeRegistry
+	registryMu sync.RWMutex
+
+	/**
+	Beans subscribed to application events and in-flight asynchronous deliveries
+	*/
+	eventListeners []*bean
+	eventsMu       sync.RWMutex
+	asyncEvents    sync.WaitGroup
+
+	/**
+	Lifecycle listeners and cleanup functions registered by OnClose
+	*/
+	lifecycleListeners []*bean
+	closeHooks         []func()
+	closeHooksMu       sync.Mutex
+
+	/**
+	Bounded history of lifecycle transitions and container events
+	*/
+	history *History
+
+	/**
+	Labels of the container, immutable after the scan
+	*/
+	labels Labels
+
+	/**
+	Pre-analyzed types of scope beans created by NewScope
+	*/
+	scopeTemplates sync.Map
+
+	/**
+	Starter beans in start order, stopped on close
+	*/
+	started []Starter
+
 	/**
 	Guarantees that container would be closed once
 	*/
@@ -149,6 +300,13 @@ func (t *container) ExtendWithContext(ctx context.Context, scan ...any) (Contain
 }
 
 func (t *container) ExtendWithOptions(options ...ContainerOption) (Container, error) {
+	if t.Sealed() {
+		return nil, ErrContainerSealed
+	}
+	return t.extend(options...)
+}
+
+func (t *container) extend(options ...ContainerOption) (Container, error) {
 
 	opts := buildContainerOptions(options)
 	overrideProperties := false
@@ -169,7 +327,53 @@ func (t *container) ExtendWithOptions(options ...ContainerOption) (Container, er
 		opts.Properties = childProperties
 	}
 
-	return createContainer(t, opts)
+	c, err := createContainer(t, opts)
+	if err != nil {
+		return nil, err
+	}
+	t.trackExtended(c)
+	return c, nil
+}
+
+func (t *container) trackExtended(c *container) {
+	if c.template.Detached {
+		return
+	}
+	t.extendedMu.Lock()
+	t.extended = append(t.extended, c)
+	t.extendedMu.Unlock()
+}
+
+func (t *container) untrackExtended(c *container) {
+	t.extendedMu.Lock()
+	defer t.extendedMu.Unlock()
+	for i, e := range t.extended {
+		if e == c {
+			t.extended = append(t.extended[:i], t.extended[i+1:]...)
+			return
+		}
+	}
+}
+
+/**
+Closes live children in reverse creation order, so they never use destroyed beans of this container
+*/
+
+func (t *container) closeExtended(ctx context.Context) []error {
+	t.extendedMu.Lock()
+	list := t.extended
+	t.extended = nil
+	t.extendedMu.Unlock()
+
+	var listErr []error
+	for i := len(list) - 1; i >= 0; i-- {
+		child := list[i]
+		t.logf(LogInfo, "Close child container on parent close\n")
+		if err := child.CloseWithContext(ctx); err != nil {
+			listErr = append(listErr, err)
+		}
+	}
+	return listErr
 }
 
 func (t *container) Parent() (Container, bool) {
@@ -198,13 +402,34 @@ func getActiveProfiles(properties Properties) []string {
 
 func createContainer(parent *container, options ContainerOptions) (c *container, err error) {
 
+	if options.StartupTimeout > 0 {
+		return createContainerWithTimeout(parent, options)
+	}
+
+	template, templateProperties := snapshotOptions(options)
+
+	// FactoryBeanCtx factories abort cooperatively when the creation fails, the context ends on close otherwise
+	if options.Context == nil {
+		options.Context = context.Background()
+	}
+	constructCtx, startupCancel := constructionContext(options.Context)
+	defer func() {
+		if err != nil {
+			startupCancel()
+		}
+	}()
+
 	core := make(map[reflect.Type][]*bean)
 	localNames := make(map[string][]*bean)
 	pointers := make(map[reflect.Type][]*injection)
 	interfaces := make(map[reflect.Type][]*injection)
 
 	var propertySources []*PropertySource
+	var templateResources []*TemplateResource
 	var propertyResolvers []PropertyResolver
+	var configMigrations []ConfigMigration
+	var typeFactories []TypeFactory
+	var methods []*injection
 	var primaryList []*bean
 	var secondaryList []*bean
 
@@ -213,28 +438,89 @@ func createContainer(parent *container, options ContainerOptions) (c *container,
 		activeProfiles = getActiveProfiles(options.Properties)
 	}
 
-	active := make(map[string]struct{}, len(activeProfiles))
-	for _, profile := range activeProfiles {
-		profile = strings.TrimSpace(profile)
-		if profile != "" {
-			active[profile] = struct{}{}
-		}
+	profiles := newActiveProfiles(activeProfiles)
+	active := profiles.set
+
+	labels := containerLabels(parent, options)
+
+	history := options.History
+	if history == nil {
+		history = NewHistory(DefaultHistorySize)
 	}
+	history.Record(HistoryEvent{Message: "container creation started", Labels: labels})
+	defer func() {
+		if err != nil {
+			history.Record(HistoryEvent{Message: "container creation failed", Err: err, Labels: labels})
+		} else {
+			history.Record(HistoryEvent{Message: "container created", Labels: labels})
+		}
+	}()
 
 	hasLogger := options.Logger != nil
-	if options.Logger == nil {
+	if _, ok := options.Logger.(nullLogger); ok || options.Logger == nil {
+		hasLogger = false
 		options.Logger = nullLogger{}
 	}
 
+	valueDefaults := ValueDefaults{}
+	if options.ValueDefaults != nil {
+		valueDefaults = *options.ValueDefaults
+	} else if parent != nil {
+		valueDefaults = parent.valueDefaults
+	}
+	postConstructTimeout, destroyTimeout := options.PostConstructTimeout, options.DestroyTimeout
+	if parent != nil {
+		if postConstructTimeout == 0 {
+			postConstructTimeout = parent.postConstructTimeout
+		}
+		if destroyTimeout == 0 {
+			destroyTimeout = parent.destroyTimeout
+		}
+	}
+	logLevel, logSampling := options.LogLevel, options.LogSampling
+	if parent != nil {
+		if logLevel == LogDefault {
+			logLevel = parent.logLevel
+		}
+		if logSampling == 0 {
+			logSampling = parent.logSampling
+		}
+	}
+	if logLevel == LogDefault {
+		logLevel = LogDebug
+	}
+
 	c = &container{
-		parent:          parent,
-		core:            core,
-		localNames:      localNames,
-		ifaceCache:      ctorInterfaceCache(),
-		resourceSources: ctorResourceCache(),
-		properties:      options.Properties,
-		loggerEnabled:   hasLogger,
-		logger:          options.Logger,
+		parent:               parent,
+		core:                 core,
+		localNames:           localNames,
+		ifaceCache:           ctorInterfaceCache(),
+		resourceSources:      ctorResourceCache(),
+		properties:           options.Properties,
+		loggerEnabled:        hasLogger,
+		logger:               options.Logger,
+		logLevel:             logLevel,
+		logSampling:          logSampling,
+		history:              history,
+		valueDefaults:        valueDefaults,
+		template:             template,
+		injectionReport:      options.InjectionReport,
+		postConstructTimeout: postConstructTimeout,
+		destroyTimeout:       destroyTimeout,
+		templateProperties:   templateProperties,
+		labels:               labels,
+		profiles:             profiles,
+		chaos:                containerChaos(parent, options),
+		hooks:                containerHooks(parent, options),
+		startupCancel:        startupCancel,
+	}
+	c.resourceSources.logf = c.logf
+	if c.chaos != nil {
+		c.logf(LogInfo, "Chaos mode with seed %d\n", c.chaos.seed)
+	}
+
+	if options.PropertyAuditor != nil {
+		c.properties.SetAuditor(options.PropertyAuditor, options.PropertyAuditSampling)
 	}
 
 	// add container bean to core
@@ -259,59 +545,215 @@ func createContainer(parent *container, options ContainerOptions) (c *container,
 	}
 	core[propertiesBean.beanDef.classPtr] = []*bean{propertiesBean}
 
+	// add profiles bean to core
+	profilesBean := &bean{
+		obj:      profiles,
+		valuePtr: reflect.ValueOf(profiles),
+		beanDef: &beanDef{
+			classPtr: reflect.TypeOf(profiles),
+		},
+		lifecycle: BeanInitialized,
+	}
+	core[profilesBean.beanDef.classPtr] = []*bean{profilesBean}
+
+	type deferredBean struct {
+		pos string
+		obj any
+	}
+	var deferred []deferredBean
+	evaluatingDeferred := false
+
+	overrides, err := collectOverrides(active, options.Beans)
+	if err != nil {
+		return nil, err
+	}
+	wiring, err := collectWiring(active, options.Beans)
+	if err != nil {
+		return nil, err
+	}
+	if wiring != nil {
+		c.wiringSelect = wiring.selects
+	}
+
 	// scan
-	err = forEach(active, "", options.Beans, func(pos string, obj any) (err error) {
+	var scanObject func(pos string, obj any) error
+	scanObject = func(pos string, obj any) (err error) {
+
+		if o, ok := unwrapBeanObj(obj).(*beanOverride); ok {
+			c.logf(LogInfo, "Override %v with %T\n", o.original, unwrapBeanObj(o.replacement))
+			obj = o.replacement
+		} else if findOverride(overrides, obj) {
+			c.logf(LogInfo, "Skip overridden bean %T on position '%s'\n", unwrapBeanObj(obj), pos)
+			return nil
+		}
+		if reason, skip := wiring.skip(active, obj); skip {
+			c.logf(LogInfo, "Skip bean %T on position '%s' by wiring: %s\n", unwrapBeanObj(obj), pos, reason)
+			return nil
+		}
+
+		collection, isCollectionFactory := unwrapBeanObj(obj).(CollectionFactoryBean)
+		if !evaluatingDeferred {
+			_, isPropertyConditional := unwrapBeanObj(obj).(PropertyConditionalBean)
+			if isPropertyConditional || isCollectionFactory || len(beanConditions(obj)) > 0 {
+				// evaluate after property sources are loaded
+				deferred = append(deferred, deferredBean{pos: pos, obj: obj})
+				return nil
+			}
+		}
+		if isCollectionFactory {
+			objects, err := c.collectionObjects(collection)
+			if err != nil {
+				return fmt.Errorf("collection factory bean '%T' on position '%s' failed: %w", collection, pos, err)
+			}
+			c.logf(LogInfo, "CollectionFactoryBean %T produce %d objects\n", collection, len(objects))
+			if err := forEach(active, pos, objects, scanObject); err != nil {
+				return err
+			}
+		}
 
+		obj, beanOptions := unwrapBean(obj)
 		var resolver bool
 
 		switch instance := obj.(type) {
 		case ChildContainer:
-			c.logger.Printf("ChildContainer %s\n", instance.ChildName())
+			c.logf(LogInfo, "ChildContainer %s\n", instance.ChildName())
 			c.children = append(c.children, instance)
 			// register interrest by making a placeholder
 			if _, ok := interfaces[ChildContainerClass]; !ok {
 				interfaces[ChildContainerClass] = []*injection{}
 			}
 		//case ResourceSource:
-		//	c.logger.Printf("ResourceSource %s, assets %+v\n", instance.Name, instance.AssetNames)
+		//	c.logf(LogInfo, "ResourceSource %s, assets %+v\n", instance.Name, instance.AssetNames)
 		//	ptr := &instance
 		//	if err := c.resourceSources.addResourceSource(ptr); err != nil {
 		//		return err
 		//	}
 		//	obj = ptr
 		case *ResourceSource:
-			c.logger.Printf("ResourceSource %s, assets %+v\n", instance.Name, instance.AssetNames)
+			c.logf(LogInfo, "ResourceSource %s, assets %+v\n", instance.Name, instance.AssetNames)
 			if err := c.resourceSources.addResourceSource(instance); err != nil {
 				return err
 			}
+		case *RemoteResourceSource:
+			c.logf(LogInfo, "RemoteResourceSource %s %s, assets %+v\n", instance.Name, instance.URL, instance.AssetNames)
+			source, err := instance.resourceSource()
+			if err != nil {
+				return err
+			}
+			if err := c.resourceSources.addResourceSource(source); err != nil {
+				return err
+			}
+		case *TemplateResource:
+			c.logf(LogInfo, "TemplateResource %s:%s, template %s\n", instance.Source, instance.Name, instance.Template)
+			templateResources = append(templateResources, instance)
 		//case PropertySource:
-		//	c.logger.Printf("PropertySource %s %d\n", instance.File, len(instance.Map))
+		//	c.logf(LogInfo, "PropertySource %s %d\n", instance.File, len(instance.Map))
 		//	ptr := &instance
 		//	propertySources = append(propertySources, ptr)
 		//	obj = ptr
 		case *PropertySource:
-			c.logger.Printf("PropertySource %s %d\n", instance.File, len(instance.Map))
+			c.logf(LogInfo, "PropertySource %s %d\n", instance.File, len(instance.Map))
 			propertySources = append(propertySources, instance)
 		case FilePropertySource:
 			fileName := string(instance)
-			c.logger.Printf("FilePropertySource %s\n", fileName)
+			c.logf(LogInfo, "FilePropertySource %s\n", fileName)
 			// does not do to the container, since it is not a pointer or interface, instead the &PropertySource object would be created
 			ps := &PropertySource{File: fileName}
 			propertySources = append(propertySources, ps)
 			obj = ps
 		case MapPropertySource:
-			c.logger.Printf("MapPropertySource %d\n", len(instance))
+			c.logf(LogInfo, "MapPropertySource %d\n", len(instance))
 			// does not do to the container, since it is not a pointer or interface, instead the &PropertySource object would be created
 			ps := &PropertySource{Map: instance}
 			propertySources = append(propertySources, ps)
 			obj = ps
+		case *ParallelInit:
+			c.logf(LogInfo, "ParallelInit %d\n", instance.Workers)
+			c.parallelInit = instance
+			return nil
+		case *Strict:
+			c.logf(LogInfo, "Strict %v\n", instance.Allow)
+			c.strict = instance
+			return nil
+		case Strict:
+			c.logf(LogInfo, "Strict %v\n", instance.Allow)
+			c.strict = &instance
+			return nil
+		case *Exports:
+			c.logf(LogInfo, "Exports %v\n", instance.Types)
+			if c.exports == nil {
+				c.exports = &Exports{}
+			}
+			c.exports.Types = append(c.exports.Types, instance.Types...)
+			return nil
+		case *Imports:
+			c.logf(LogInfo, "Imports %v\n", instance.Types)
+			if c.imports == nil {
+				c.imports = &Imports{}
+			}
+			c.imports.Types = append(c.imports.Types, instance.Types...)
+			return nil
+		case *ParallelDestroy:
+			c.logf(LogInfo, "ParallelDestroy %d %v\n", instance.Workers, instance.Timeout)
+			c.parallelDestroy = instance
+			return nil
+		case Labels:
+			// collected before the scan
+			c.logf(LogInfo, "Labels %s\n", instance)
+			return nil
+		case *Wiring:
+			// collected before the scan
+			c.logf(LogInfo, "Wiring disable=%v enable=%v select=%v profiles=%v\n", instance.Disable, instance.Enable, instance.Select, instance.Profiles)
+			return nil
+		case Interceptor:
+			c.logf(LogInfo, "Interceptor\n")
+			return c.addInterceptor(&instance)
+		case *Interceptor:
+			c.logf(LogInfo, "Interceptor\n")
+			return c.addInterceptor(instance)
+		case *cloneOverrides:
+			c.logf(LogInfo, "CloneWith overrides %d\n", len(instance.store))
+			// resolved before all other resolvers, it is not registered as a bean
+			propertyResolvers = append(propertyResolvers, instance)
+			return nil
+		case PropertyMap:
+			c.logf(LogInfo, "PropertyMap %d\n", len(instance))
+			// the map is merged into the container properties through a resolver, it is not registered as a bean
+			propertyResolvers = append(propertyResolvers, newPropertyMapResolver(instance))
+			return nil
 		case PropertyResolver:
-			c.logger.Printf("PropertyResolver Priority %d\n", instance.Priority())
+			c.logf(LogInfo, "PropertyResolver Priority %d\n", instance.Priority())
 			propertyResolvers = append(propertyResolvers, instance)
 			resolver = true
+		case ConfigMigration:
+			c.logf(LogInfo, "ConfigMigration from %s to %s\n", instance.FromVersion(), instance.ToVersion())
+			configMigrations = append(configMigrations, instance)
+		case Module:
+			providers, err := moduleProviders(instance)
+			if err != nil {
+				return err
+			}
+			c.logf(LogInfo, "Module %T with %d providers\n", instance, len(providers))
+			for _, provider := range providers {
+				if err := scanObject(pos, provider); err != nil {
+					return err
+				}
+			}
+		case TypeFactory:
+			c.logf(LogInfo, "TypeFactory %s\n", instance.BeanType())
+			typeFactories = append(typeFactories, instance)
 		default:
 		}
 
+		/**
+		Constructor function produces the bean from injected parameters
+		*/
+		if reflect.TypeOf(obj).Kind() == reflect.Func {
+			if obj, err = newCtorFactoryBean(obj); err != nil {
+				return err
+			}
+		}
+
 		classPtr := reflect.TypeOf(obj)
 
 		defer func() {
@@ -329,6 +771,14 @@ func createContainer(parent *container, options ContainerOptions) (c *container,
 			if err != nil {
 				return err
 			}
+			if pf, ok := obj.(parameterizedFactory); ok {
+				if err := pf.bindParameters(objBean); err != nil {
+					return err
+				}
+			}
+			for _, option := range beanOptions {
+				option(objBean)
+			}
 
 			var elemClassPtr reflect.Type
 			factoryBean, isFactoryBean := obj.(FactoryBean)
@@ -338,7 +788,7 @@ func createContainer(parent *container, options ContainerOptions) (c *container,
 			} else if isFactoryBean {
 				elemClassPtr = factoryBean.ObjectType()
 			}
-			if c.loggerEnabled {
+			if c.logWiring() {
 				if isFactoryBean || isContextFactoryBean {
 					var info string
 					if (isContextFactoryBean && contextFactoryBean.Singleton()) || (!isContextFactoryBean && factoryBean.Singleton()) {
@@ -381,7 +831,7 @@ func createContainer(parent *container, options ContainerOptions) (c *container,
 			if len(objBean.beanDef.fields) > 0 {
 				value := objBean.valuePtr.Elem()
 				for _, injectDef := range objBean.beanDef.fields {
-					if c.loggerEnabled {
+					if c.logWiring() {
 						var attr []string
 						if injectDef.lazy {
 							attr = append(attr, "lazy")
@@ -406,7 +856,15 @@ func createContainer(parent *container, options ContainerOptions) (c *container,
 						c.logger.Printf("	Field %s%v %s\n", prefix, injectDef.fieldType, attrs)
 					}
 
-					if injectDef.scope != ScopeSingleton {
+					if injectDef.getter {
+						// resolved on every call, not a dependency
+						if err := c.bindGetter(value, injectDef, objBean); err != nil {
+							return err
+						}
+					} else if injectDef.funcField {
+						// resolved after the scan, when all beans are known
+						methods = append(methods, &injection{objBean, value, injectDef, c})
+					} else if injectDef.scope != ScopeSingleton {
 						// Scoped injection: resolve by the return type of the provider function, not the function type itself
 						lookupType := injectDef.scopeReturnType
 						switch lookupType.Kind() {
@@ -453,7 +911,35 @@ func createContainer(parent *container, options ContainerOptions) (c *container,
 					},
 					lifecycle: BeanAllocated,
 				}
+				// aliases of the factory name the produced bean, primary, ordered and concrete only factories produce such beans
+				elemBean.aliases, objBean.aliases = objBean.aliases, nil
+				elemBean.primary = objBean.primary
+				elemBean.ordered, elemBean.order = objBean.ordered, objBean.order
+				elemBean.concreteOnly = objBean.concreteOnly
 				f.instances = []*bean{elemBean}
+				if injecting, ok := obj.(InjectingFactoryBean); ok && injecting.InjectResult() {
+					if elemClassPtr.Kind() != reflect.Ptr || elemClassPtr.Elem().Kind() != reflect.Struct {
+						return fmt.Errorf("factory bean '%v' on position '%s' injecting the result must produce pointer to struct, but object type is '%v'", classPtr, pos, elemClassPtr)
+					}
+					resultDef, err := cachedBeanDef(elemClassPtr)
+					if err != nil {
+						return err
+					}
+					// dependencies of the result become dependencies of the factory, fields of the placeholder are never used
+					placeholder := reflect.New(elemClassPtr.Elem()).Elem()
+					for _, injectDef := range resultDef.fields {
+						if injectDef.getter || injectDef.lazy || injectDef.scope != ScopeSingleton {
+							continue
+						}
+						switch injectDef.fieldType.Kind() {
+						case reflect.Ptr:
+							pointers[injectDef.fieldType] = append(pointers[injectDef.fieldType], &injection{objBean, placeholder, injectDef, c})
+						case reflect.Interface:
+							interfaces[injectDef.fieldType] = append(interfaces[injectDef.fieldType], &injection{objBean, placeholder, injectDef, c})
+						}
+					}
+					f.injectResult = c.injectResult
+				}
 				// we can have singleton or multiple beans in container produced by this factory, let's allocate reference for injections even if those beans are still not exist
 				registerBean(core, localNames, elemClassPtr, elemBean)
 				secondaryList = append(secondaryList, elemBean)
@@ -478,79 +964,176 @@ func createContainer(parent *container, options ContainerOptions) (c *container,
 		}
 
 		return nil
-	})
+	}
 
-	if err != nil {
+	if err = forEach(active, "", options.Beans, scanObject); err != nil {
 		return nil, err
 	}
+	// templates are resolved after the scan, so they may refer to resource sources declared later
+	for _, tr := range templateResources {
+		if err := c.addTemplateResource(tr); err != nil {
+			return nil, err
+		}
+	}
+
+	/**
+	Load properties from property sources
+	*/
+	if len(propertySources) > 0 {
+		if err := c.loadProperties(propertySources); err != nil {
+			return nil, err
+		}
+		propertySources = nil
+	}
+	/**
+	Migrate once, before conditions are evaluated, property sources of conditional beans are expected in the current version
+	*/
+	if err := c.migrateConfig(configMigrations); err != nil {
+		return nil, err
+	}
+	c.publishProfiles()
+	c.applyChaosProperties()
+
+	/**
+	Register property resolvers from container
+	*/
+	for _, r := range propertyResolvers {
+		c.properties.Register(r)
+	}
+	propertyResolvers = nil
+
+	/**
+	Register property conditional beans and objects of collection factories
+	*/
+	evaluatingDeferred = true
+	for _, d := range deferred {
+		inner := unwrapBeanObj(d.obj)
+		if !c.shouldRegisterDeferred(d.obj) {
+			c.logf(LogInfo, "Skip conditional bean %T\n", inner)
+			continue
+		}
+		if err := scanObject(d.pos, d.obj); err != nil {
+			return nil, fmt.Errorf("object '%T' error: %w", inner, err)
+		}
+	}
+	if len(propertySources) > 0 {
+		// property sources of conditional beans
+		if err := c.loadProperties(propertySources); err != nil {
+			return nil, err
+		}
+	}
+	for _, r := range propertyResolvers {
+		c.properties.Register(r)
+	}
+
+	/**
+	Register beans declared in properties
+	*/
+	if len(typeFactories) > 0 {
+		declared, err := c.declaredBeans(typeFactories)
+		if err != nil {
+			return nil, err
+		}
+		if err := forEach(active, BeansProperty, declared, scanObject); err != nil {
+			return nil, err
+		}
+	}
+	for _, o := range overrides {
+		if o.matched == 0 {
+			c.logf(LogInfo, "Override %v matched no bean of the container\n", o.original)
+		}
+	}
+	for _, name := range wiring.unmatched() {
+		c.logf(LogInfo, "Wiring bean '%s' matched no bean of the container\n", name)
+	}
+
+	// required fields without candidates are reported together
+	var missing []*missingDependencyError
+	collect := func(err error) bool {
+		var m *missingDependencyError
+		if errors.As(err, &m) {
+			missing = append(missing, m)
+			return true
+		}
+		return false
+	}
 
 	// direct match
 	for requiredType, injects := range pointers {
 
-		c.logger.Println("Object", requiredType, len(injects))
+		if c.logWiring() {
+			c.logger.Println("Object", requiredType, len(injects))
+		}
 
 		direct := c.findObjectRecursive(requiredType)
 		if len(direct) > 0 {
 
-			c.logger.Printf("Inject '%v' by pointer '%+v' in to %+v\n", requiredType, direct, injects)
+			if c.logWiring() {
+				c.logger.Printf("Inject '%v' by pointer '%+v' in to %+v\n", requiredType, direct, injects)
+			}
 
 			for _, inject := range injects {
-				if err := inject.inject(direct); err != nil {
+				if err := inject.inject(direct); err != nil && !collect(err) {
 					return nil, fmt.Errorf("required type '%s' injection error: %w", requiredType, err)
 				}
 			}
 
 		} else {
 
-			c.logger.Printf("Bean '%v' not found in container\n", requiredType)
+			if c.logWiring() {
+				c.logger.Printf("Bean '%v' not found in container\n", requiredType)
+			}
 
-			var required []*injection
 			for _, inject := range injects {
 				if inject.injectionDef.optional {
-					c.logger.Printf("Skip optional inject '%v' in to '%v'\n", requiredType, inject)
+					if c.logWiring() {
+						c.logger.Printf("Skip optional inject '%v' in to '%v'\n", requiredType, inject)
+					}
 				} else {
-					required = append(required, inject)
+					missing = append(missing, inject.injectionDef.missing(nil))
 				}
 			}
 
-			if len(required) > 0 {
-				return nil, fmt.Errorf("can not find candidates for '%v' reference bean required by '%+v'", requiredType, required)
-			}
-
 		}
 	}
 
 	// interface match
 	for ifaceType, injects := range interfaces {
 
-		c.logger.Println("Interface", ifaceType, len(injects))
+		if c.logWiring() {
+			c.logger.Println("Interface", ifaceType, len(injects))
+		}
 
 		candidates := c.searchAndCacheInterfaceCandidatesRecursive(ifaceType)
 		if len(candidates) == 0 {
 
-			c.logger.Printf("No found bean candidates for interface '%v' in container\n", ifaceType)
+			if c.logWiring() {
+				c.logger.Printf("No found bean candidates for interface '%v' in container\n", ifaceType)
+			}
 
-			var required []*injection
+			nearMisses := c.nearMisses(ifaceType)
 			for _, inject := range injects {
 				if inject.injectionDef.optional {
-					c.logger.Printf("Skip optional inject of interface '%v' in to '%v'\n", ifaceType, inject)
+					if c.logWiring() {
+						c.logger.Printf("Skip optional inject of interface '%v' in to '%v'\n", ifaceType, inject)
+					}
 				} else {
-					required = append(required, inject)
+					m := inject.injectionDef.missing(nil)
+					m.NearMisses = nearMisses
+					missing = append(missing, m)
 				}
 			}
 
-			if len(required) > 0 {
-				return nil, fmt.Errorf("can not find candidates for '%v' interface required by '%+v'", ifaceType, required)
-			}
-
 			continue
 		}
 
 		for _, inject := range injects {
 
-			c.logger.Printf("Inject '%v' by implementation '%+v' in to %+v\n", ifaceType, candidates, inject)
+			if c.logWiring() {
+				c.logger.Printf("Inject '%v' by implementation '%+v' in to %+v\n", ifaceType, candidates, inject)
+			}
 
-			if err := inject.inject(candidates); err != nil {
+			if err := inject.inject(candidates); err != nil && !collect(err) {
 				return nil, fmt.Errorf("interface '%s' injection error: %w", ifaceType, err)
 			}
 
@@ -558,20 +1141,35 @@ func createContainer(parent *container, options ContainerOptions) (c *container,
 
 	}
 
-	/**
-	Load properties from property sources
-	*/
-	if len(propertySources) > 0 {
-		if err := c.loadProperties(propertySources); err != nil {
-			return nil, err
+	// func fields bound to methods of beans
+	for _, inject := range methods {
+		if c.logWiring() {
+			c.logger.Printf("Inject method in to %+v\n", inject)
+		}
+		if err := inject.injectMethod(); err != nil && !collect(err) {
+			return nil, fmt.Errorf("method injection error: %w", err)
 		}
 	}
 
+	if err := missingDependencies(missing); err != nil {
+		return nil, err
+	}
+
+	if err := c.checkStrict(); err != nil {
+		return nil, err
+	}
+
 	/**
-	Register property resolvers from container
+	Provide metrics recorders to metered beans
 	*/
-	for _, r := range propertyResolvers {
-		c.properties.Register(r)
+	c.applyMetrics()
+
+	/**
+	Run preflight checks
+	*/
+	if err := c.runPreflightChecks(options.Context); err != nil {
+		c.closeWithTimeout(DefaultCloseTimeout)
+		return nil, err
 	}
 
 	/**
@@ -582,6 +1180,15 @@ func createContainer(parent *container, options ContainerOptions) (c *container,
 		return nil, err
 	}
 
+	/**
+	Put intercepted and sandboxed beans behind proxies
+	*/
+	c.applyInterceptors()
+	if err := c.applySandbox(); err != nil {
+		c.closeWithTimeout(DefaultCloseTimeout)
+		return nil, err
+	}
+
 	/**
 	Apply post-processors
 	*/
@@ -593,13 +1200,63 @@ func createContainer(parent *container, options ContainerOptions) (c *container,
 	/**
 	PostConstruct beans
 	*/
-	if err := c.postConstruct(options.Context, primaryList, secondaryList); err != nil {
+	if c.chaos != nil {
+		// dependencies are still constructed first
+		c.chaos.shuffle(secondaryList)
+	}
+	if err := c.postConstruct(constructCtx, primaryList, secondaryList); err != nil {
 		c.closeWithTimeout(DefaultCloseTimeout)
 		return nil, err
-	} else {
-		return c, nil
 	}
 
+	/**
+	Subscribe property change listeners
+	*/
+	c.subscribePropertyListeners()
+	c.subscribeEventListeners(primaryList, secondaryList)
+	if c.injectionReport {
+		c.recordInjections()
+	}
+	c.logSamplingSummary()
+
+	/**
+	Start long-running components
+	*/
+	if err := c.startStarters(); err != nil {
+		c.closeWithTimeout(DefaultCloseTimeout)
+		return nil, err
+	}
+	if options.PropertyFreeze != FreezeNone {
+		if p, ok := c.properties.(*properties); ok {
+			p.Lock()
+			p.frozenLog = func(key string) {
+				c.logf(LogError, "Change of property '%s' rejected: %v\n", key, ErrPropertiesFrozen)
+			}
+			p.Unlock()
+		}
+		c.properties.Freeze(options.PropertyFreeze)
+	}
+	if options.Seal {
+		c.Seal()
+	}
+	c.notifyLifecycle(ContainerRefreshed, nil)
+	c.PublishEvent(ContainerStartedEvent{Container: c})
+	return c, nil
+
+}
+
+func (t *container) subscribePropertyListeners() {
+	for _, list := range t.core {
+		for _, b := range list {
+			if b.beenFactory != nil || b.obj == nil {
+				continue
+			}
+			if listener, ok := b.obj.(PropertyChangeListener); ok {
+				t.propertyListeners = append(t.propertyListeners, listener)
+				t.properties.AddChangeListener(listener)
+			}
+		}
+	}
 }
 
 func (t *container) closeWithTimeout(timeout time.Duration) {
@@ -611,26 +1268,28 @@ func (t *container) closeWithTimeout(timeout time.Duration) {
 	select {
 	case e := <-ch:
 		if e != nil {
-			t.logger.Printf("Close container error, %v\n", e)
+			t.logf(LogError, "Close container error, %v\n", e)
 		}
 	case <-time.After(timeout):
-		t.logger.Printf("Close container timeout error.\n")
+		t.logf(LogError, "Close container timeout error.\n")
 	}
 }
 
-func (t *container) loadPropertiesFromFile(filePath string, file io.Reader) error {
+func (t *container) decodePropertiesFile(filePath string, file io.Reader, stats *propertyLoadStats) error {
 
 	if strings.HasSuffix(filePath, ".yaml") || strings.HasSuffix(filePath, ".yml") {
 
+		stats.format = "yaml"
 		holder := make(map[string]any)
 		if err := yaml.NewDecoder(file).Decode(holder); err != nil {
 			return fmt.Errorf("failed to load properties from yaml file '%s': %w", filePath, err)
 		}
-		t.properties.LoadMap(holder)
+		stats.flatten(t.properties, holder, filePath)
 		return nil
 
 	} else if strings.HasSuffix(filePath, ".json") {
 
+		stats.format = "json"
 		data, err := io.ReadAll(file)
 		if err != nil {
 			return fmt.Errorf("failed to read json file '%s': %w", filePath, err)
@@ -639,23 +1298,45 @@ func (t *container) loadPropertiesFromFile(filePath string, file io.Reader) erro
 		if err := json.Unmarshal(data, &holder); err != nil {
 			return fmt.Errorf("failed to parse json file '%s': %w", filePath, err)
 		}
-		t.properties.LoadMap(holder)
+		stats.flatten(t.properties, holder, filePath)
 		return nil
 
 	} else if strings.HasSuffix(filePath, ".toml") {
 
+		stats.format = "toml"
 		holder := make(map[string]any)
 		if _, err := toml.NewDecoder(file).Decode(&holder); err != nil {
 			return fmt.Errorf("failed to load properties from toml file '%s': %w", filePath, err)
 		}
-		t.properties.LoadMap(holder)
+		stats.flatten(t.properties, holder, filePath)
 		return nil
 
 	} else if strings.HasSuffix(filePath, ".properties") {
-		if err := t.properties.Load(file); err != nil {
+		stats.format = "properties"
+		var err error
+		if loader, ok := t.properties.(originLoader); ok {
+			err = loader.loadFrom(file, filePath)
+		} else {
+			err = t.properties.Load(file)
+		}
+		if err != nil {
 			return fmt.Errorf("failed to load properties from properties file '%s': %w", filePath, err)
 		}
 		return nil
+	} else if strings.HasSuffix(filePath, ".env") {
+
+		stats.format = "env"
+		parsed, err := parseEnv(file)
+		if err != nil {
+			return fmt.Errorf("failed to load properties from dotenv file '%s': %w", filePath, err)
+		}
+		holder := make(map[string]any, len(parsed))
+		for k, v := range parsed {
+			holder[dotEnvPropertyKey(k)] = v
+		}
+		stats.flatten(t.properties, holder, filePath)
+		return nil
+
 	} else {
 		return fmt.Errorf("unsupported properties file '%s'", filePath)
 	}
@@ -666,49 +1347,81 @@ func (t *container) loadProperties(propertySources []*PropertySource) error {
 	for _, source := range propertySources {
 
 		if source.File != "" {
+			if err := t.loadPropertySourceFile(source.File); err != nil {
+				return err
+			}
+		}
+
+		// later paths override keys of earlier ones
+		for _, path := range source.Paths {
+			if err := t.loadPropertySourceFile(path); err != nil {
+				return err
+			}
+		}
 
-			if strings.HasPrefix(source.File, "file:") {
+		if source.Map != nil {
+			t.properties.LoadMap(source.Map)
+		}
 
-				filePath := source.File[len("file:"):]
-				file, err := os.Open(filePath)
-				if err != nil {
-					return fmt.Errorf("i/o error with placeholder properties file '%s': %w", filePath, err)
-				}
-				err = t.loadPropertiesFromFile(filePath, file)
-				file.Close()
-				if err != nil {
-					return fmt.Errorf("load error of placeholder properties file '%s': %w", filePath, err)
-				}
+	}
 
-			} else if resource, ok := t.Resource(source.File); ok {
+	return nil
+}
 
-				file, err := resource.Open()
-				if err != nil {
-					return fmt.Errorf("i/o error with placeholder properties resource '%s': %w", source, err)
-				}
-				err = t.loadPropertiesFromFile(source.File, file)
-				file.Close()
-				if err != nil {
-					return fmt.Errorf("load error of placeholder properties resource '%s': %w", source.File, err)
-				}
+func (t *container) loadPropertySourceFile(path string) error {
 
-			} else {
-				return fmt.Errorf("placeholder properties resource '%s' was not found", source.File)
+	optional := strings.HasPrefix(path, OptionalPathPrefix)
+	path = strings.TrimPrefix(path, OptionalPathPrefix)
+
+	if strings.HasPrefix(path, "file:") {
+
+		if !hasFileProperties {
+			return requireFeature(FeatureFileProperties)
+		}
+		filePath := path[len("file:"):]
+		file, err := os.Open(filePath)
+		if err != nil {
+			if optional && os.IsNotExist(err) {
+				t.logf(LogInfo, "Optional properties file '%s' was not found\n", filePath)
+				return nil
 			}
+			return fmt.Errorf("i/o error with placeholder properties file '%s': %w", filePath, err)
+		}
+		err = t.loadPropertiesFromFile(filePath, file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("load error of placeholder properties file '%s': %w", filePath, err)
 		}
 
-		if source.Map != nil {
-			t.properties.LoadMap(source.Map)
+	} else if resource, ok := t.Resource(path); ok {
+
+		file, err := resource.Open()
+		if err != nil {
+			return fmt.Errorf("i/o error with placeholder properties resource '%s': %w", path, err)
+		}
+		err = t.loadPropertiesFromFile(path, file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("load error of placeholder properties resource '%s': %w", path, err)
 		}
 
+	} else if optional {
+		t.logf(LogInfo, "Optional properties resource '%s' was not found\n", path)
+	} else {
+		return fmt.Errorf("placeholder properties resource '%s' was not found", path)
 	}
-
 	return nil
 }
 
 func registerBean(core map[reflect.Type][]*bean, localNames map[string][]*bean, classPtr reflect.Type, b *bean) {
+	b.seq = atomic.AddUint64(&beanSeq, 1)
 	core[classPtr] = append(core[classPtr], b)
 	localNames[b.name] = append(localNames[b.name], b)
+	for _, alias := range b.aliases {
+		if alias != b.name {
+			localNames[alias] = append(localNames[alias], b)
+		}
+	}
 }
 
 func forEach(active map[string]struct{}, initialPos string, scan []any, cb func(i string, obj any) error) error {
@@ -827,23 +1540,29 @@ func forEachRecursive(active map[string]struct{}, initialPos string, scan []any,
 			continue
 		}
 
-		item = normalizeScanItem(item)
-		if item == nil {
+		inner := normalizeScanItem(unwrapBeanObj(item))
+		if inner == nil {
 			continue
 		}
 
-		if profileBean, ok := item.(ProfileBean); ok {
+		if profileBean, ok := inner.(ProfileBean); ok {
 			if !isProfileActive(active, profileBean.BeanProfile()) {
 				continue
 			}
 		}
 
-		if conditionalBean, ok := item.(ConditionalBean); ok {
+		if conditionalBean, ok := inner.(ConditionalBean); ok {
 			if !conditionalBean.ShouldRegisterBean() {
 				continue
 			}
 		}
 
+		if w, ok := item.(*beanWrapper); ok {
+			item = &beanWrapper{obj: inner, options: w.options, conditions: w.conditions}
+		} else {
+			item = inner
+		}
+
 		var pos string
 		if len(initialPos) > 0 {
 			pos = fmt.Sprintf("%s.%d", initialPos, j)
@@ -852,6 +1571,14 @@ func forEachRecursive(active map[string]struct{}, initialPos string, scan []any,
 		}
 
 		switch obj := item.(type) {
+		case *PluginLoader:
+			beans, err := obj.load()
+			if err != nil {
+				return fmt.Errorf("plugin loader on position '%s': %w", pos, err)
+			}
+			if err := forEachRecursive(active, pos, beans, cb, visited); err != nil {
+				return err
+			}
 		case Scanner:
 			if err := forEachRecursive(active, pos, obj.ScannerBeans(), cb, visited); err != nil {
 				return err
@@ -919,25 +1646,61 @@ func (t *container) Bean(typ reflect.Type, level int) []Bean {
 	candidates := t.getBean(typ)
 	if len(candidates) > 0 {
 		list := orderBeans(levelBeans(candidates, level))
+		t.recordLookup(typ, list)
 		for _, b := range list {
-			beanList = append(beanList, b)
+			beanList = append(beanList, t.prototypeInstance(b))
 		}
+	} else {
+		t.recordLookup(typ, nil)
 	}
 	return beanList
 }
 
+func (t *container) Single(typ reflect.Type) (Bean, error) {
+	list := t.Bean(typ, DefaultSearchLevel)
+	switch len(list) {
+	case 1:
+		return list[0], nil
+	case 0:
+		return nil, fmt.Errorf("bean '%v' not found in the container and its parents", typ)
+	default:
+		candidates := make([]string, len(list))
+		for i, b := range list {
+			candidates[i] = fmt.Sprintf("'%s' (%v)", b.Name(), b.Class())
+		}
+		return nil, fmt.Errorf("bean '%v' is ambiguous, %d candidates: %s", typ, len(list), strings.Join(candidates, ", "))
+	}
+}
+
 func (t *container) Lookup(name string, level int) []Bean {
 	var beanList []Bean
 	candidates := t.searchByNameRecursive(name)
 	if len(candidates) > 0 {
 		list := orderBeans(levelBeans(candidates, level))
+		t.recordLookup(nil, list)
 		for _, b := range list {
-			beanList = append(beanList, b)
+			beanList = append(beanList, t.prototypeInstance(b))
 		}
 	}
 	return beanList
 }
 
+/**
+Returns the new instance for every lookup of the bean produced by non-singleton factory
+*/
+
+func (t *container) prototypeInstance(b *bean) *bean {
+	if b.beenFactory == nil || b.obj == nil || b.beenFactory.singleton() {
+		return b
+	}
+	instance, _, err := b.beenFactory.ctor(context.Background())
+	if err != nil {
+		t.logf(LogError, "Factory bean '%v' failed to produce new instance: %v\n", b.beenFactory.factoryClassPtr, err)
+		return b
+	}
+	return instance
+}
+
 func (t *container) Inject(obj any) error {
 	if obj == nil {
 		return errors.New("null obj is are not allowed")
@@ -953,19 +1716,40 @@ func (t *container) Inject(obj any) error {
 	valuePtr := reflect.ValueOf(obj)
 	value := valuePtr.Elem()
 	for _, inject := range bd.fields {
+		if inject.getter {
+			if err := t.bindGetter(value, inject, nil); err != nil {
+				return err
+			}
+			continue
+		}
+		if inject.funcField {
+			if err := t.injectMethodRuntime(value, inject); err != nil {
+				return err
+			}
+			continue
+		}
 		impl := t.getBean(inject.fieldType)
 		if len(impl) == 0 {
 			if inject.optional {
 				continue
 			}
+			if nearMisses := t.nearMisses(inject.fieldType); len(nearMisses) > 0 {
+				return fmt.Errorf("implementation not found for field '%s' with type '%v', near misses: %s", inject.fieldName, inject.fieldType, strings.Join(nearMisses, "; "))
+			}
 			return fmt.Errorf("implementation not found for field '%s' with type '%v'", inject.fieldName, inject.fieldType)
 		}
-		if err := inject.inject(&value, impl); err != nil {
+		if err := inject.inject(context.Background(), t, &value, impl); err != nil {
 			return err
 		}
+		t.recordInjection(inject, inject.field(value), impl)
 	}
 	for _, inject := range bd.properties {
-		if err := inject.inject(&value, t.properties); err != nil {
+		if err := inject.inject(&value, t.properties, &t.valueDefaults); err != nil {
+			return err
+		}
+	}
+	for _, def := range bd.resources {
+		if err := t.injectResource(&value, def); err != nil {
 			return err
 		}
 	}
@@ -1045,9 +1829,9 @@ func (t *container) constructBean(ctx context.Context, bean *bean, stack []*bean
 	}
 
 	_, isFactoryBean := bean.obj.(FactoryBean)
-	initializerWithContext, hasConstructorWithContext := bean.obj.(ContextInitializingBean)
-	initializer, hasConstructor := bean.obj.(InitializingBean)
-	if t.loggerEnabled {
+	_, hasConstructorWithContext := bean.obj.(ContextInitializingBean)
+	_, hasConstructor := bean.obj.(InitializingBean)
+	if t.logWiring() {
 		t.logger.Printf("%sConstruct Bean '%s' with type '%v', isFactoryBean=%v, hasFactory=%v, hasObject=%v, hasConstructor=%v\n", indent(len(stack)), bean.name, bean.beanDef.classPtr, isFactoryBean, bean.beenFactory != nil, bean.obj != nil, hasConstructor)
 	}
 
@@ -1055,11 +1839,11 @@ func (t *container) constructBean(ctx context.Context, bean *bean, stack []*bean
 		for i, b := range stack {
 			if b == bean {
 				// cycle dependency detected
-				return fmt.Errorf("detected cycle dependency %s", getStackInfo(append(stack[i:], bean), "->"))
+				return cycleError(append(stack[i:len(stack):len(stack)], bean))
 			}
 		}
 	}
-	bean.lifecycle = BeanConstructing
+	t.setLifecycle(bean, BeanConstructing)
 	bean.ctorMu.Lock()
 	defer func() {
 		bean.ctorMu.Unlock()
@@ -1069,7 +1853,7 @@ func (t *container) constructBean(ctx context.Context, bean *bean, stack []*bean
 		if err := t.constructBean(ctx, factoryDep.factory.bean, append(stack, bean)); err != nil {
 			return err
 		}
-		if t.loggerEnabled {
+		if t.logWiring() {
 			t.logger.Printf("%sFactoryDep (%v).Object()\n", indent(len(stack)+1), factoryDep.factory.factoryClassPtr)
 		}
 		bean, created, err := factoryDep.factory.ctor(ctx)
@@ -1077,7 +1861,8 @@ func (t *container) constructBean(ctx context.Context, bean *bean, stack []*bean
 			return fmt.Errorf("factory ctor '%v' failed: %w", factoryDep.factory.factoryClassPtr, err)
 		}
 		if created {
-			if t.loggerEnabled {
+			t.recordTransition(bean, BeanAllocated, bean.lifecycle)
+			if t.logWiring() {
 				t.logger.Printf("%sDep Created Bean %s with type '%v' singleton=%v\n", indent(len(stack)+1), bean.name, bean.beanDef.classPtr, factoryDep.factory.singleton())
 			}
 		}
@@ -1097,13 +1882,14 @@ func (t *container) constructBean(ctx context.Context, bean *bean, stack []*bean
 		if err := t.constructBean(ctx, bean.beenFactory.bean, append(stack, bean)); err != nil {
 			return err
 		}
-		if t.loggerEnabled {
+		if t.logWiring() {
 			t.logger.Printf("%s(%v).Object()\n", indent(len(stack)), bean.beenFactory.factoryClassPtr)
 		}
 		_, _, err := bean.beenFactory.ctor(ctx) // always new
 		if err != nil {
 			return fmt.Errorf("factory ctor '%v' failed: %w", bean.beenFactory.factoryClassPtr, err)
 		}
+		t.recordTransition(bean, BeanConstructing, bean.lifecycle)
 		if bean.obj == nil {
 			return fmt.Errorf("bean '%v' was not created by factory ctor '%v'", bean, bean.beenFactory.factoryClassPtr)
 		}
@@ -1113,33 +1899,48 @@ func (t *container) constructBean(ctx context.Context, bean *bean, stack []*bean
 	// inject properties
 	if len(bean.beanDef.properties) > 0 {
 		value := bean.valuePtr.Elem()
+		properties := auditedProperties(t.properties, bean.name)
 		for _, propertyDef := range bean.beanDef.properties {
-			if t.loggerEnabled {
+			if t.logWiring() {
 				if propertyDef.defaultValue != "" {
 					t.logger.Printf("%sProperty '%s' default '%s'\n", indent(len(stack)+1), propertyDef.propertyName, propertyDef.defaultValue)
 				} else {
 					t.logger.Printf("%sProperty '%s'\n", indent(len(stack)+1), propertyDef.propertyName)
 				}
 			}
-			err = propertyDef.inject(&value, t.properties)
+			err = propertyDef.facet(bean.propertyPrefix, properties).inject(&value, properties, &t.valueDefaults)
 			if err != nil {
 				return fmt.Errorf("property '%s' injection in bean '%s' failed, %s: %w", propertyDef.propertyName, bean.name, getStackInfo(reverseStack(append(stack, bean)), " required by "), err)
 			}
 		}
 	}
 
+	// inject resources
+	if len(bean.beanDef.resources) > 0 {
+		value := bean.valuePtr.Elem()
+		for _, resourceDef := range bean.beanDef.resources {
+			if t.logWiring() {
+				t.logger.Printf("%sResource '%s'\n", indent(len(stack)+1), resourceDef.path)
+			}
+			if err := t.injectResource(&value, resourceDef); err != nil {
+				return fmt.Errorf("resource injection in bean '%s' failed, %s: %w", bean.name, getStackInfo(reverseStack(append(stack, bean)), " required by "), err)
+			}
+		}
+	}
+
+	if err := bean.applySetters(); err != nil {
+		return fmt.Errorf("%w, %s", err, getStackInfo(reverseStack(append(stack, bean)), " required by "))
+	}
+
 	if hasConstructorWithContext || hasConstructor {
-		if t.loggerEnabled {
+		if t.logWiring() {
 			t.logger.Printf("%sPostConstruct Bean '%s' with type '%v'\n", indent(len(stack)), bean.name, bean.beanDef.classPtr)
 		}
-		if hasConstructorWithContext {
-			if err := initializerWithContext.PostConstruct(ctx); err != nil {
-				return fmt.Errorf("post construct failed %s: %w", getStackInfo(reverseStack(append(stack, bean)), " required by "), err)
-			}
-		} else {
-			if err := initializer.PostConstruct(); err != nil {
-				return fmt.Errorf("post construct failed %s: %w", getStackInfo(reverseStack(append(stack, bean)), " required by "), err)
-			}
+		start := time.Now()
+		err := t.invokePostConstruct(ctx, bean)
+		t.timings.postConstruct(bean, time.Since(start))
+		if err != nil {
+			return fmt.Errorf("post construct failed %s: %w", getStackInfo(reverseStack(append(stack, bean)), " required by "), err)
 		}
 	}
 
@@ -1148,11 +1949,13 @@ func (t *container) constructBean(ctx context.Context, bean *bean, stack []*bean
 		t.addDisposable(bean)
 	}
 
-	bean.lifecycle = BeanInitialized
+	t.setLifecycle(bean, BeanInitialized)
 	return nil
 }
 
 func (t *container) addDisposable(bean *bean) {
+	t.disposablesMu.Lock()
+	defer t.disposablesMu.Unlock()
 	if _, ok := bean.obj.(ContextDisposableBean); ok {
 		t.disposables = append(t.disposables, bean)
 	} else if _, ok := bean.obj.(DisposableBean); ok {
@@ -1160,6 +1963,108 @@ func (t *container) addDisposable(bean *bean) {
 	}
 }
 
+/**
+Orders disposables in reverse topological order of the injection graph,
+so the bean is destroyed only after all beans that injected it, directly or through other beans.
+Unrelated beans keep the reverse initialization order.
+*/
+
+func (t *container) destroyOrder() []*bean {
+	dependents := make(map[*bean][]*bean)
+	t.forEachDependency(func(from, to *bean) {
+		if from != to {
+			dependents[to] = append(dependents[to], from)
+		}
+	})
+
+	index := make(map[*bean]int, len(t.disposables))
+	for i, b := range t.disposables {
+		index[b] = i
+	}
+
+	// count of not destroyed dependents, beans without Destroy are only passed through
+	pending := make(map[*bean]int)
+	var ready []*bean
+	seen := make(map[*bean]bool)
+	var add func(b *bean)
+	add = func(b *bean) {
+		if seen[b] {
+			return
+		}
+		seen[b] = true
+		for _, dep := range dependents[b] {
+			add(dep)
+		}
+		pending[b] = len(dependents[b])
+		if pending[b] == 0 {
+			ready = append(ready, b)
+		}
+	}
+	for j := len(t.disposables) - 1; j >= 0; j-- {
+		add(t.disposables[j])
+	}
+
+	// the edge 'from -> to' means that 'to' waits for 'from'
+	dependencies := make(map[*bean][]*bean)
+	for to, list := range dependents {
+		for _, from := range list {
+			dependencies[from] = append(dependencies[from], to)
+		}
+	}
+
+	var order []*bean
+	for len(ready) > 0 {
+		next := 0
+		for i := 1; i < len(ready); i++ {
+			if destroyBefore(ready[i], ready[next], index) {
+				next = i
+			}
+		}
+		b := ready[next]
+		ready = append(ready[:next], ready[next+1:]...)
+		if _, ok := index[b]; ok {
+			order = append(order, b)
+		}
+		for _, dep := range dependencies[b] {
+			if pending[dep]--; pending[dep] == 0 {
+				ready = append(ready, dep)
+			}
+		}
+	}
+
+	// beans in dependency cycles are destroyed in reverse initialization order
+	for j := len(t.disposables) - 1; j >= 0; j-- {
+		if b := t.disposables[j]; pending[b] > 0 {
+			order = append(order, b)
+		}
+	}
+	return order
+}
+
+/**
+Returns true if the bean a should be destroyed before b when both have no remaining dependents:
+beans without Destroy first, then by DestroyOrder, then in reverse initialization order
+*/
+
+func destroyBefore(a, b *bean, index map[*bean]int) bool {
+	ai, aDisposable := index[a]
+	bi, bDisposable := index[b]
+	if aDisposable != bDisposable {
+		return !aDisposable
+	}
+	if ao, bo := destroyOrderOf(a), destroyOrderOf(b); ao != bo {
+		return ao < bo
+	}
+	return ai > bi
+}
+
+func destroyOrderOf(b *bean) int {
+	if ordered, ok := b.obj.(DestroyOrderedBean); ok {
+		return ordered.DestroyOrder()
+	}
+	return 0
+}
+
 func (t *container) postConstruct(ctx context.Context, lists ...[]*bean) (err error) {
 
 	defer func() {
@@ -1168,6 +2073,13 @@ func (t *container) postConstruct(ctx context.Context, lists ...[]*bean) (err er
 		}
 	}()
 
+	if t.parallelInit != nil {
+		if levels, ok := initLevels(lists...); ok {
+			return t.constructLevels(ctx, levels, t.parallelInit.workers())
+		}
+		// cycles are reported by the sequential initialization
+	}
+
 	for _, list := range lists {
 		if err = t.constructBeanList(ctx, list, nil); err != nil {
 			return err
@@ -1193,6 +2105,35 @@ func (t *container) CloseWithContext(ctx context.Context) (err error) {
 
 	var listErr []error
 	t.closeOnce.Do(func() {
+		t.recordEvent("container closing", nil)
+		defer func() {
+			err := multipleErr(listErr)
+			t.recordEvent("container closed", err)
+			t.notifyLifecycle(ContainerClosed, err)
+		}()
+		t.notifyLifecycle(ContainerClosing, nil)
+
+		t.closeRegistry()
+		t.registryMu.Lock()
+		listeners := t.propertyListeners
+		t.propertyListeners = nil
+		t.registryMu.Unlock()
+		for _, listener := range listeners {
+			t.properties.RemoveChangeListener(listener)
+		}
+
+		t.PublishEvent(ContainerClosingEvent{Container: t})
+		t.eventsMu.Lock()
+		t.eventListeners = nil
+		t.eventsMu.Unlock()
+		t.asyncEvents.Wait()
+
+		listErr = append(listErr, t.stopStarters()...)
+
+		if t.parent != nil {
+			t.parent.untrackExtended(t)
+		}
+		listErr = append(listErr, t.closeExtended(ctx)...)
 
 		for _, child := range t.children {
 			if err := child.CloseWithContext(ctx); err != nil {
@@ -1200,11 +2141,15 @@ func (t *container) CloseWithContext(ctx context.Context) (err error) {
 			}
 		}
 
-		n := len(t.disposables)
-		for j := n - 1; j >= 0; j-- {
-			if err := t.destroyBean(ctx, t.disposables[j]); err != nil {
-				listErr = append(listErr, err)
-			}
+		listErr = append(listErr, t.runCloseHooks()...)
+
+		if t.parallelDestroy != nil {
+			listErr = append(listErr, t.destroyParallel(ctx, t.parallelDestroy)...)
+		} else {
+			listErr = append(listErr, t.destroySequential(ctx)...)
+		}
+		if t.startupCancel != nil {
+			t.startupCancel()
 		}
 	})
 
@@ -1223,20 +2168,10 @@ func (t *container) destroyBean(ctx context.Context, b *bean) (err error) {
 		return nil
 	}
 
-	b.lifecycle = BeanDestroying
-	t.logger.Printf("Destroying bean '%s' with type '%v'\n", b.name, b.beanDef.classPtr)
-	if dis, ok := b.obj.(ContextDisposableBean); ok {
-		if e := dis.Destroy(ctx); e != nil {
-			err = e
-		} else {
-			b.lifecycle = BeanDestroyed
-		}
-	} else if dis, ok := b.obj.(DisposableBean); ok {
-		if e := dis.Destroy(); e != nil {
-			err = e
-		} else {
-			b.lifecycle = BeanDestroyed
-		}
+	t.setLifecycle(b, BeanDestroying)
+	t.logf(LogInfo, "Destroying bean '%s' with type '%v'\n", b.name, b.beanDef.classPtr)
+	if err = t.invokeDestroy(ctx, b); err == nil {
+		t.setLifecycle(b, BeanDestroyed)
 	}
 	return
 }
@@ -1258,44 +2193,39 @@ func (t *container) ReloadWithContext(ctx context.Context, b Bean) error {
 		return fmt.Errorf("bean '%s' was created by factory bean '%v' and can not be reloaded", bb.name, bb.beenFactory.factoryClassPtr)
 	}
 
-	// destroy
-	bb.lifecycle = BeanDestroying
-	if dis, ok := bb.obj.(ContextDisposableBean); ok {
-		if err := dis.Destroy(ctx); err != nil {
-			return err
-		}
-	} else if dis, ok := bb.obj.(DisposableBean); ok {
-		if err := dis.Destroy(); err != nil {
-			return err
-		}
+	if err := t.reloadDestroy(ctx, bb); err != nil {
+		return err
 	}
+	return t.reloadConstruct(ctx, bb)
+}
 
+func (t *container) reloadDestroy(ctx context.Context, bb *bean) error {
+	t.setLifecycle(bb, BeanDestroying)
+	return t.invokeDestroy(ctx, bb)
+}
+
+func (t *container) reloadConstruct(ctx context.Context, bb *bean) error {
 	// re-resolve static value: properties (skip dynamic — they already read live values)
-	bb.lifecycle = BeanConstructing
+	t.setLifecycle(bb, BeanConstructing)
 	if len(bb.beanDef.properties) > 0 {
 		value := bb.valuePtr.Elem()
+		properties := auditedProperties(t.properties, bb.name)
 		for _, propDef := range bb.beanDef.properties {
 			if propDef.dynamic {
 				continue
 			}
-			if err := propDef.inject(&value, t.properties); err != nil {
+			if err := propDef.facet(bb.propertyPrefix, properties).inject(&value, properties, &t.valueDefaults); err != nil {
 				return fmt.Errorf("reload property '%s' in bean '%s' failed: %w", propDef.propertyName, bb.name, err)
 			}
 		}
 	}
 
 	// post-construct
-	if init, ok := bb.obj.(ContextInitializingBean); ok {
-		if err := init.PostConstruct(ctx); err != nil {
-			return err
-		}
-	} else if init, ok := bb.obj.(InitializingBean); ok {
-		if err := init.PostConstruct(); err != nil {
-			return err
-		}
+	if err := t.invokePostConstruct(ctx, bb); err != nil {
+		return err
 	}
 
-	bb.lifecycle = BeanInitialized
+	t.setLifecycle(bb, BeanInitialized)
 	return nil
 }
 
@@ -1326,6 +2256,9 @@ func (t *container) Resource(path string) (Resource, bool) {
 		}
 		current = current.parent
 	}
+	if name == "" || strings.HasSuffix(name, "/") {
+		return t.resourceDir(source, name)
+	}
 	return nil, false
 }
 
@@ -1334,6 +2267,9 @@ func (t *container) Properties() Properties {
 }
 
 func (t *container) String() string {
+	if len(t.labels) > 0 {
+		return fmt.Sprintf("Container [hasParent=%v, types=%d, destructors=%d, labels=%s]", t.parent != nil, len(t.core), len(t.disposables), t.labels)
+	}
 	return fmt.Sprintf("Container [hasParent=%v, types=%d, destructors=%d]", t.parent != nil, len(t.core), len(t.disposables))
 }
 
@@ -1368,7 +2304,12 @@ func (t *childContext) Object() (ctx Container, err error) {
 
 func (t *childContext) ObjectWithContext(ctx context.Context) (ctn Container, err error) {
 	t.extendOnes.Do(func() {
-		t.ctx, t.err = t.Parent.ExtendWithContext(ctx, t.scan...)
+		// declared children are part of the graph, so they are created even in the sealed parent
+		if parent, ok := t.Parent.(*container); ok {
+			t.ctx, t.err = parent.extend(WithContext(ctx), WithBeans(t.scan...))
+		} else {
+			t.ctx, t.err = t.Parent.ExtendWithContext(ctx, t.scan...)
+		}
 	})
 	return t.ctx, t.err
 }
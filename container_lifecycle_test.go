@@ -272,3 +272,125 @@ func (t *errorDestroyContextBean) Destroy(ctx context.Context) error {
 
 // contextKey avoids collisions with other packages
 type contextKey string
+
+type destroyTrace struct {
+	names []string
+}
+
+type destroyPool struct {
+	Trace *destroyTrace `inject:""`
+}
+
+func (t *destroyPool) Destroy() error {
+	t.Trace.names = append(t.Trace.names, "pool")
+	return nil
+}
+
+type destroyRepo struct {
+	Pool *destroyPool `inject:""`
+}
+
+type destroyServer struct {
+	Trace *destroyTrace `inject:""`
+	Repo  *destroyRepo  `inject:""`
+}
+
+func (t *destroyServer) Destroy() error {
+	t.Trace.names = append(t.Trace.names, "server")
+	return nil
+}
+
+func TestClose_DependencyOrder(t *testing.T) {
+	trace := &destroyTrace{}
+
+	ctn, err := glue.New(trace, &destroyServer{}, &destroyRepo{}, &destroyPool{})
+	require.NoError(t, err)
+
+	require.NoError(t, ctn.Close())
+	require.Equal(t, []string{"server", "pool"}, trace.names)
+}
+
+type destroyOrdered struct {
+	Trace *destroyTrace `inject:""`
+	name  string
+	order int
+}
+
+func (t *destroyOrdered) BeanName() string {
+	return t.name
+}
+
+func (t *destroyOrdered) DestroyOrder() int {
+	return t.order
+}
+
+func (t *destroyOrdered) Destroy() error {
+	t.Trace.names = append(t.Trace.names, t.name)
+	return nil
+}
+
+type destroyQueue struct {
+	Trace   *destroyTrace   `inject:""`
+	Metrics *destroyOrdered `inject:"bean=metrics"`
+}
+
+func (t *destroyQueue) DestroyOrder() int {
+	return 20
+}
+
+func (t *destroyQueue) Destroy() error {
+	t.Trace.names = append(t.Trace.names, "queue")
+	return nil
+}
+
+func TestClose_DestroyOrder(t *testing.T) {
+	for _, parallel := range []bool{false, true} {
+		trace := &destroyTrace{}
+
+		scan := []any{
+			trace,
+			&destroyOrdered{name: "metrics", order: 10},
+			&destroyOrdered{name: "cache"},
+			&destroyOrdered{name: "queue-flush", order: -10},
+			// injects metrics with lower order, the injection graph wins
+			&destroyQueue{},
+		}
+		if parallel {
+			scan = append(scan, glue.ParallelDestroy{Workers: 1})
+		}
+		ctn, err := glue.New(scan...)
+		require.NoError(t, err)
+
+		require.NoError(t, ctn.Close())
+		require.Equal(t, []string{"queue-flush", "cache", "queue", "metrics"}, trace.names, "parallel=%v", parallel)
+	}
+}
+
+type dialingBean struct {
+	hasDeadline bool
+	cancelled   chan struct{}
+}
+
+func (t *dialingBean) PostConstruct(ctx context.Context) error {
+	_, t.hasDeadline = ctx.Deadline()
+	<-ctx.Done()
+	close(t.cancelled)
+	return ctx.Err()
+}
+
+func TestPostConstruct_StartupTimeoutDeadline(t *testing.T) {
+	dialing := &dialingBean{cancelled: make(chan struct{})}
+
+	_, err := glue.NewWithOptions(
+		glue.WithStartupTimeout(20*time.Millisecond),
+		glue.WithBeans(dialing),
+	)
+	require.Error(t, err)
+
+	select {
+	case <-dialing.cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("PostConstruct context was not cancelled")
+	}
+	require.True(t, dialing.hasDeadline)
+}
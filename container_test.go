@@ -47,7 +47,7 @@ func TestCreateEmpty(t *testing.T) {
 	require.NotNil(t, ctx)
 	defer ctx.Close()
 
-	require.Equal(t, 2, len(ctx.Core()))
+	require.Equal(t, 3, len(ctx.Core()))
 
 	c := ctx.Bean(glue.ContainerClass, glue.DefaultSearchLevel)
 	require.Equal(t, 1, len(c))
@@ -226,7 +226,7 @@ func TestCreate(t *testing.T) {
 	require.NotNil(t, ctx)
 	defer ctx.Close()
 
-	require.Equal(t, 8, len(ctx.Core()))
+	require.Equal(t, 9, len(ctx.Core()))
 
 	list := ctx.Lookup("storage", glue.DefaultSearchLevel)
 	require.Equal(t, 1, len(list))
@@ -276,7 +276,7 @@ func TestCreateArray(t *testing.T) {
 	require.NotNil(t, ctx)
 	defer ctx.Close()
 
-	require.Equal(t, 7, len(ctx.Core()))
+	require.Equal(t, 8, len(ctx.Core()))
 
 }
 
@@ -307,7 +307,7 @@ func TestCreateScanner(t *testing.T) {
 	require.NotNil(t, ctx)
 	defer ctx.Close()
 
-	require.Equal(t, 7, len(ctx.Core()))
+	require.Equal(t, 8, len(ctx.Core()))
 
 }
 
@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+/**
+Constructor function registered as the bean, e.g. func(repo *UserRepo, props glue.Properties) (*UserService, error).
+Parameters are injected in to the generated struct, the result is the singleton bean produced by the factory.
+First parameter can be context.Context of the container construction.
+*/
+
+type ctorFactoryBean struct {
+	name        string
+	fn          reflect.Value
+	args        reflect.Value // pointer to struct with injected parameters
+	skip        int           // leading fields of args not passed to the function
+	withContext bool
+	returnsErr  bool
+	objectType  reflect.Type
+}
+
+func newCtorFactoryBean(fn any) (*ctorFactoryBean, error) {
+	return newCtorFactory(fn, nil)
+}
+
+/**
+Leading fields are injected in to args before parameters, but not passed to the function
+*/
+
+func newCtorFactory(fn any, leading []reflect.StructField) (*ctorFactoryBean, error) {
+	if !hasConstructors {
+		return nil, requireFeature(FeatureConstructors)
+	}
+
+	fnValue := reflect.ValueOf(fn)
+	fnType := fnValue.Type()
+
+	if fnValue.IsNil() {
+		return nil, fmt.Errorf("constructor function '%v' is nil", fnType)
+	}
+	if fnType.IsVariadic() {
+		return nil, fmt.Errorf("constructor function '%v' can not be variadic", fnType)
+	}
+
+	switch fnType.NumOut() {
+	case 1:
+	case 2:
+		if fnType.Out(1) != errorType {
+			return nil, fmt.Errorf("constructor function '%v' second result must be error", fnType)
+		}
+	default:
+		return nil, fmt.Errorf("constructor function '%v' must return the bean and optional error", fnType)
+	}
+
+	objectType := fnType.Out(0)
+	if objectType.Kind() != reflect.Ptr && objectType.Kind() != reflect.Interface {
+		return nil, fmt.Errorf("constructor function '%v' must return pointer or interface, but returns '%v'", fnType, objectType)
+	}
+
+	withContext := fnType.NumIn() > 0 && fnType.In(0) == contextType
+	fields := append([]reflect.StructField(nil), leading...)
+	for i := 0; i < fnType.NumIn(); i++ {
+		if i == 0 && withContext {
+			continue
+		}
+		argType := fnType.In(i)
+		switch argType.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		default:
+			return nil, fmt.Errorf("constructor function '%v' parameter %d of type '%v' must be pointer, interface, slice or map", fnType, i, argType)
+		}
+		fields = append(fields, reflect.StructField{
+			Name: fmt.Sprintf("Arg%d", i),
+			Type: argType,
+			Tag:  `inject:""`,
+		})
+	}
+
+	return &ctorFactoryBean{
+		fn:          fnValue,
+		args:        reflect.New(reflect.StructOf(fields)),
+		skip:        len(leading),
+		withContext: withContext,
+		returnsErr:  fnType.NumOut() == 2,
+		objectType:  objectType,
+	}, nil
+}
+
+func (t *ctorFactoryBean) Object(ctx context.Context) (any, error) {
+	var in []reflect.Value
+	if t.withContext {
+		in = append(in, reflect.ValueOf(&ctx).Elem())
+	}
+	args := t.args.Elem()
+	for i := t.skip; i < args.NumField(); i++ {
+		in = append(in, args.Field(i))
+	}
+	out := t.fn.Call(in)
+	if t.returnsErr && !out[1].IsNil() {
+		return nil, out[1].Interface().(error)
+	}
+	if out[0].IsNil() {
+		return nil, fmt.Errorf("constructor function '%v' returned nil", t.fn.Type())
+	}
+	return out[0].Interface(), nil
+}
+
+func (t *ctorFactoryBean) ObjectType() reflect.Type {
+	return t.objectType
+}
+
+func (t *ctorFactoryBean) ObjectName() string {
+	return ""
+}
+
+func (t *ctorFactoryBean) Singleton() bool {
+	return true
+}
+
+func (t *ctorFactoryBean) String() string {
+	if t.name != "" {
+		return t.name
+	}
+	return fmt.Sprintf("constructor %v", t.fn.Type())
+}
+
+/**
+Factory beans that receive injections in to the separate value instead of the factory itself
+*/
+
+type parameterizedFactory interface {
+	bindParameters(b *bean) error
+}
+
+func (t *ctorFactoryBean) bindParameters(b *bean) error {
+	bd, err := cachedBeanDef(t.args.Type())
+	if err != nil {
+		return err
+	}
+	b.name = t.String()
+	b.beanDef = bd
+	b.valuePtr = t.args
+	return nil
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type ctorRepo struct {
+	closed bool
+}
+
+func (t *ctorRepo) Destroy() error {
+	t.closed = true
+	return nil
+}
+
+type ctorService struct {
+	repo *ctorRepo
+	url  string
+}
+
+type CtorGreeter interface {
+	Greet() string
+}
+
+type ctorGreeter struct {
+	svc *ctorService
+}
+
+func (t *ctorGreeter) Greet() string {
+	return "hello " + t.svc.url
+}
+
+type ctorConsumer struct {
+	Service *ctorService `inject:""`
+	Greeter CtorGreeter  `inject:""`
+}
+
+func TestConstructorFunctionBeans(t *testing.T) {
+
+	skipUnsupported(t, glue.FeatureConstructors)
+
+	repo := &ctorRepo{}
+	consumer := &ctorConsumer{}
+
+	ctn, err := glue.New(
+		repo,
+		consumer,
+		func(repo *ctorRepo, props glue.Properties) (*ctorService, error) {
+			return &ctorService{repo: repo, url: props.GetString("service.url", "")}, nil
+		},
+		func(ctx context.Context, svc *ctorService) CtorGreeter {
+			require.NotNil(t, ctx)
+			return &ctorGreeter{svc: svc}
+		},
+		glue.MapPropertySource{"service.url": "localhost"},
+	)
+	require.NoError(t, err)
+
+	require.NotNil(t, consumer.Service)
+	require.Same(t, repo, consumer.Service.repo)
+	require.Equal(t, "hello localhost", consumer.Greeter.Greet())
+
+	svc, err := glue.GetBean[*ctorService](ctn)
+	require.NoError(t, err)
+	require.Same(t, consumer.Service, svc)
+
+	require.NoError(t, ctn.Close())
+	require.True(t, repo.closed)
+}
+
+func TestConstructorFunctionErrors(t *testing.T) {
+
+	skipUnsupported(t, glue.FeatureConstructors)
+
+	_, err := glue.New(func() (*ctorService, error) {
+		return nil, errors.New("no database")
+	}, &ctorConsumer{}, func() CtorGreeter { return &ctorGreeter{} })
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no database")
+
+	_, err = glue.New(func(n int) *ctorService {
+		return nil
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must be pointer, interface, slice or map")
+
+	_, err = glue.New(func() string {
+		return ""
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must return pointer or interface")
+
+	_, err = glue.New(func(repo *ctorRepo) *ctorService {
+		return &ctorService{repo: repo}
+	}, &ctorConsumer{}, func() CtorGreeter { return &ctorGreeter{} })
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "*glue_test.ctorRepo")
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrDependencyCycle is wrapped by errors of non-lazy dependency cycles.
+var ErrDependencyCycle = errors.New("detected cycle dependency")
+
+/**
+Returns the error with the chain of the cycle, the field of every edge with the package of its bean
+and the field suggested to be lazy, cycle starts and ends with the same bean
+*/
+
+func cycleError(cycle []*bean) error {
+	var out strings.Builder
+	out.WriteString(getStackInfo(cycle, " -> "))
+
+	var suggest *injectedBean
+	var suggestFrom *bean
+	for i := 0; i+1 < len(cycle); i++ {
+		from, to := cycle[i], cycle[i+1]
+		in := findInjected(from, to)
+		out.WriteString("\n    ")
+		out.WriteString(from.beanDef.classPtr.String())
+		if in != nil {
+			out.WriteString(".")
+			out.WriteString(in.def.fieldName)
+		}
+		if pkg := from.beanDef.classPtr.Elem().PkgPath(); pkg != "" {
+			out.WriteString(" (" + pkg + ")")
+		}
+		out.WriteString(" -> ")
+		out.WriteString(to.beanDef.classPtr.String())
+		if in != nil && in.to.beenFactory == nil {
+			// the last eligible edge usually closes the cycle back to the bean requested first
+			suggest, suggestFrom = in, from
+		}
+	}
+
+	if suggest != nil {
+		out.WriteString(fmt.Sprintf("\n    mark field '%s' of '%v' with `inject:\"lazy\"` to break the cycle", suggest.def.fieldName, suggestFrom.beanDef.classPtr))
+	}
+	return fmt.Errorf("%w %s", ErrDependencyCycle, out.String())
+}
+
+/**
+Returns the injection of the bean or its factory in to the field of from
+*/
+
+func findInjected(from, to *bean) *injectedBean {
+	for i := range from.injectedBeans {
+		in := &from.injectedBeans[i]
+		if in.to == to || in.to.beenFactory != nil && in.to.beenFactory.bean == to {
+			return in
+		}
+	}
+	return nil
+}
@@ -6,6 +6,8 @@
 package glue_test
 
 import (
+	"errors"
+
 	"github.com/stretchr/testify/require"
 	"go.arpabet.com/glue"
 	"testing"
@@ -56,3 +58,27 @@ func TestSelfDepCycle(t *testing.T) {
 	require.True(t, self == self.Self)
 
 }
+
+type aCycleBean struct {
+	BBean *bCycleBean `inject:""`
+}
+
+type bCycleBean struct {
+	ABean *aCycleBean `inject:""`
+}
+
+func TestCycleDiagnostics(t *testing.T) {
+
+	_, err := glue.New(
+		&aCycleBean{},
+		&bCycleBean{},
+	)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, glue.ErrDependencyCycle))
+
+	msg := err.Error()
+	require.Contains(t, msg, "*glue_test.aCycleBean -> *glue_test.bCycleBean -> *glue_test.aCycleBean")
+	require.Contains(t, msg, "*glue_test.aCycleBean.BBean (go.arpabet.com/glue_test) -> *glue_test.bCycleBean")
+	require.Contains(t, msg, "*glue_test.bCycleBean.ABean (go.arpabet.com/glue_test) -> *glue_test.aCycleBean")
+	require.Contains(t, msg, "mark field 'ABean' of '*glue_test.bCycleBean' with `inject:\"lazy\"`")
+}
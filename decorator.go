@@ -42,7 +42,7 @@ func (t *container) applyDecorators() error {
 			continue
 		}
 
-		t.logger.Printf("Decorator %T for %v\n", d, targetType)
+		t.logf(LogInfo, "Decorator %T for %v\n", d, targetType)
 
 		for _, beans := range t.core {
 			for _, b := range beans {
@@ -74,7 +74,7 @@ func (t *container) applyDecorators() error {
 					return fmt.Errorf("decorated value from %T does not implement %v", d, targetType)
 				}
 
-				t.logger.Printf("  Decorated bean '%s' (%v -> %v)\n", b.name, beanType, decoratedType)
+				t.logf(LogInfo, "  Decorated bean '%s' (%v -> %v)\n", b.name, beanType, decoratedType)
 				b.obj = decorated
 				b.valuePtr = reflect.ValueOf(decorated)
 
@@ -111,14 +111,15 @@ func (t *container) updateInjectedFields(ifaceType reflect.Type, oldObj, newObj
 					continue
 				}
 
-				field := structVal.Field(f.fieldNum)
-				if !field.IsValid() || field.IsNil() {
+				field := b.injectedField(structVal, f)
+				if !field.IsValid() || !field.CanSet() || field.IsNil() {
 					continue
 				}
 
 				// compare the underlying pointer
 				if field.Elem().Pointer() == oldVal.Pointer() {
 					field.Set(reflect.ValueOf(newObj))
+					b.updateSetterField(f.fieldNum)
 				}
 			}
 		}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type describedStorage struct {
+}
+
+func (t *describedStorage) BeanDescription() string {
+	return "stores records"
+}
+
+type describedClient struct {
+	Storage *describedStorage `inject:""`
+}
+
+func TestDescribedBean(t *testing.T) {
+
+	ctn, err := glue.New(
+		&describedStorage{},
+		glue.Described(&describedClient{}, "calls remote API"),
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	list := ctn.Bean(glue.DescribedBeanClass, glue.DefaultSearchLevel)
+	require.Equal(t, 1, len(list))
+	require.Equal(t, "stores records", list[0].Description())
+
+	desc := ctn.Describe()
+	require.True(t, strings.Contains(desc, "*glue_test.describedStorage *glue_test.describedStorage [BeanInitialized] - stores records\n"), desc)
+	require.True(t, strings.Contains(desc, "- calls remote API\n"), desc)
+
+	graph := ctn.Graph()
+	require.True(t, strings.Contains(graph, `"*glue_test.describedStorage" [tooltip="stores records"];`), graph)
+	require.True(t, strings.Contains(graph, `"*glue_test.describedClient" [tooltip="calls remote API"];`), graph)
+	require.True(t, strings.Contains(graph, `"*glue_test.describedClient" -> "*glue_test.describedStorage";`), graph)
+}
+
+func TestDescribedOverridesInterface(t *testing.T) {
+
+	ctn, err := glue.New(
+		glue.Described(&describedStorage{}, "overridden"),
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.True(t, strings.Contains(ctn.Describe(), "- overridden\n"))
+	require.False(t, strings.Contains(ctn.Describe(), "stores records"))
+}
+
+func TestDescribedSkipsInactiveProfile(t *testing.T) {
+
+	ctn, err := glue.NewWithProfiles([]string{"dev"},
+		glue.Described(&profiledBean{profile: "prod"}, "prod only"),
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.False(t, strings.Contains(ctn.Describe(), "prod only"))
+}
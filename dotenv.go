@@ -8,6 +8,7 @@ package glue
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"sync"
@@ -71,7 +72,7 @@ func (r *DotEnvPropertyResolver) Keys() []string {
 	}
 	var keys []string
 	for k := range r.store {
-		propKey := strings.ToLower(strings.ReplaceAll(k, "_", "."))
+		propKey := dotEnvPropertyKey(k)
 		if r.MatchKey != nil && !r.MatchKey(propKey, k) {
 			continue
 		}
@@ -110,14 +111,21 @@ func (r *DotEnvPropertyResolver) toEnvKey(key string) string {
 // parseEnvFile parses a .env file into a map.
 // Supports KEY=VALUE, # comments, optional "export" prefix, and quoted values.
 func parseEnvFile(path string) (map[string]string, error) {
+	if !hasFileProperties {
+		return nil, requireFeature(FeatureFileProperties)
+	}
 	f, err := os.Open(path)
 	if err != nil {
 		return nil, fmt.Errorf("open config %s: %w", path, err)
 	}
 	defer f.Close()
+	return parseEnv(f)
+}
 
+// parseEnv parses dotenv content from the reader into a map.
+func parseEnv(reader io.Reader) (map[string]string, error) {
 	result := make(map[string]string)
-	scanner := bufio.NewScanner(f)
+	scanner := bufio.NewScanner(reader)
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
 
@@ -146,3 +154,9 @@ func parseEnvFile(path string) (map[string]string, error) {
 	}
 	return result, scanner.Err()
 }
+
+// dotEnvPropertyKey converts an env var name to a property-style key:
+// "APP_DB_HOST" -> "app.db.host".
+func dotEnvPropertyKey(envKey string) string {
+	return strings.ToLower(strings.ReplaceAll(envKey, "_", "."))
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type dotEnvSourceConfig struct {
+	Host string `value:"app.db.host"`
+	Port int    `value:"app.port"`
+	Name string `value:"app.name"`
+}
+
+func TestDotEnvPropertySource_File(t *testing.T) {
+	skipUnsupported(t, glue.FeatureFileProperties)
+
+	path := writeDotEnv(t, "# comment\nexport APP_DB_HOST=dotenv-host\nAPP_PORT=3000\nAPP_NAME=\"quoted name\"\n")
+
+	cfg := &dotEnvSourceConfig{}
+	ctn, err := glue.New(
+		glue.FilePropertySource("file:"+path),
+		cfg,
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, "dotenv-host", cfg.Host)
+	require.Equal(t, 3000, cfg.Port)
+	require.Equal(t, "quoted name", cfg.Name)
+	require.Equal(t, "dotenv-host", ctn.Properties().GetString("app.db.host", ""))
+}
+
+func TestDotEnvPropertySource_MissingFile(t *testing.T) {
+	_, err := glue.New(
+		glue.FilePropertySource("file:/nonexistent/.env"),
+	)
+	require.Error(t, err)
+}
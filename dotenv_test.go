@@ -25,6 +25,8 @@ func writeDotEnv(t *testing.T, content string) string {
 }
 
 func TestDotEnvPropertyResolver_BasicLookup(t *testing.T) {
+	skipUnsupported(t, glue.FeatureFileProperties)
+
 	path := writeDotEnv(t, "APP_DB_HOST=dotenv-host\nAPP_PORT=3000\n")
 
 	r := &glue.DotEnvPropertyResolver{Path: path}
@@ -38,6 +40,8 @@ func TestDotEnvPropertyResolver_BasicLookup(t *testing.T) {
 }
 
 func TestDotEnvPropertyResolver_DashMapping(t *testing.T) {
+	skipUnsupported(t, glue.FeatureFileProperties)
+
 	path := writeDotEnv(t, "APP_READ_TIMEOUT=30s\n")
 
 	r := &glue.DotEnvPropertyResolver{Path: path}
@@ -55,6 +59,8 @@ func TestDotEnvPropertyResolver_Missing(t *testing.T) {
 }
 
 func TestDotEnvPropertyResolver_CustomKeyMapper(t *testing.T) {
+	skipUnsupported(t, glue.FeatureFileProperties)
+
 	path := writeDotEnv(t, "custom-key=custom-value\n")
 
 	r := &glue.DotEnvPropertyResolver{
@@ -69,6 +75,8 @@ func TestDotEnvPropertyResolver_CustomKeyMapper(t *testing.T) {
 }
 
 func TestDotEnvPropertyResolver_MatchKey(t *testing.T) {
+	skipUnsupported(t, glue.FeatureFileProperties)
+
 	path := writeDotEnv(t, "APP_PORT=9090\n")
 
 	r := &glue.DotEnvPropertyResolver{
@@ -95,6 +103,8 @@ func TestDotEnvPropertyResolver_CustomPriority(t *testing.T) {
 }
 
 func TestDotEnvPropertyResolver_QuotedValues(t *testing.T) {
+	skipUnsupported(t, glue.FeatureFileProperties)
+
 	path := writeDotEnv(t, `DB_HOST="quoted-host"
 DB_PASS='single-quoted'
 `)
@@ -110,6 +120,8 @@ DB_PASS='single-quoted'
 }
 
 func TestDotEnvPropertyResolver_CommentsAndBlanks(t *testing.T) {
+	skipUnsupported(t, glue.FeatureFileProperties)
+
 	path := writeDotEnv(t, `# this is a comment
 APP_PORT=3000
 
@@ -128,6 +140,8 @@ APP_HOST=localhost
 }
 
 func TestDotEnvPropertyResolver_ExportPrefix(t *testing.T) {
+	skipUnsupported(t, glue.FeatureFileProperties)
+
 	path := writeDotEnv(t, "export APP_PORT=3000\n")
 
 	r := &glue.DotEnvPropertyResolver{Path: path}
@@ -137,6 +151,8 @@ func TestDotEnvPropertyResolver_ExportPrefix(t *testing.T) {
 }
 
 func TestDotEnvPropertyResolver_ColonSeparator(t *testing.T) {
+	skipUnsupported(t, glue.FeatureFileProperties)
+
 	path := writeDotEnv(t, "APP_PORT:3000\n")
 
 	r := &glue.DotEnvPropertyResolver{Path: path}
@@ -152,6 +168,8 @@ func TestDotEnvPropertyResolver_MissingFile(t *testing.T) {
 }
 
 func TestDotEnvPropertyResolver_Keys(t *testing.T) {
+	skipUnsupported(t, glue.FeatureFileProperties)
+
 	path := writeDotEnv(t, "APP_PORT=3000\nDB_HOST=localhost\n")
 
 	r := &glue.DotEnvPropertyResolver{Path: path}
@@ -171,6 +189,8 @@ func TestDotEnvPropertyResolver_KeysWithKeyMapper(t *testing.T) {
 }
 
 func TestDotEnvPropertyResolver_OverridesEnvResolver(t *testing.T) {
+	skipUnsupported(t, glue.FeatureFileProperties)
+
 	os.Setenv("APP_PORT", "from-env")
 	defer os.Unsetenv("APP_PORT")
 
@@ -44,27 +44,37 @@ func newDynContext(t *testing.T, props map[string]any) (glue.Container, *dynServ
 // --- tests ---
 
 func TestDynamicProperty_StaticStillWorks(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	_, svc := newDynContext(t, map[string]any{"app.port": "9090"})
 	require.Equal(t, 9090, svc.Port)
 }
 
 func TestDynamicProperty_FuncT_Default(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	_, svc := newDynContext(t, nil)
 	require.Equal(t, "localhost", svc.GetHost())
 }
 
 func TestDynamicProperty_FuncT_FromProps(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	_, svc := newDynContext(t, map[string]any{"app.host": "example.com"})
 	require.Equal(t, "example.com", svc.GetHost())
 }
 
 func TestDynamicProperty_FuncT_LiveUpdate(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	ctx, svc := newDynContext(t, nil)
 	ctx.Properties().Set("app.host", "live.com")
 	require.Equal(t, "live.com", svc.GetHost())
 }
 
 func TestDynamicProperty_FuncError_Missing(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	_, svc := newDynContext(t, nil) // db.password not set, no default
 	_, err := svc.GetSecret()
 	require.Error(t, err)
@@ -72,6 +82,8 @@ func TestDynamicProperty_FuncError_Missing(t *testing.T) {
 }
 
 func TestDynamicProperty_FuncError_Present(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	_, svc := newDynContext(t, map[string]any{"db.password": "***"})
 	val, err := svc.GetSecret()
 	require.NoError(t, err)
@@ -79,6 +91,8 @@ func TestDynamicProperty_FuncError_Present(t *testing.T) {
 }
 
 func TestDynamicProperty_FuncContext_Default(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	_, svc := newDynContext(t, nil)
 	val, err := svc.GetConn(context.Background())
 	require.NoError(t, err)
@@ -86,6 +100,8 @@ func TestDynamicProperty_FuncContext_Default(t *testing.T) {
 }
 
 func TestDynamicProperty_FuncContext_FromProps(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	_, svc := newDynContext(t, map[string]any{"db.conn": "postgres://localhost/mydb"})
 	val, err := svc.GetConn(context.Background())
 	require.NoError(t, err)
@@ -129,6 +145,8 @@ func TestDynamicProperty_FuncT_RequiresDefault(t *testing.T) {
 }
 
 func TestDynamicProperty_FuncT_ErrorHandler(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	// func() int with a non-numeric value should call the error handler, not panic
 	type cfg struct {
 		GetPort func() int `value:"app.port,default=8080"`
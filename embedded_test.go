@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type embeddedClock struct{}
+
+type embeddedMetrics struct{}
+
+type embeddedAudit struct {
+	Metrics *embeddedMetrics `inject:""`
+}
+
+type baseComponent struct {
+	Clock *embeddedClock `inject:""`
+	Name  string         `value:"component.name,default=base"`
+
+	embeddedAudit
+}
+
+type ordersComponent struct {
+	baseComponent
+	Limit int `value:"orders.limit,default=10"`
+}
+
+type usersComponent struct {
+	baseComponent
+	Orders *ordersComponent `inject:""`
+}
+
+func TestEmbeddedStructInjection(t *testing.T) {
+
+	clock, metrics := &embeddedClock{}, &embeddedMetrics{}
+	orders, users := &ordersComponent{}, &usersComponent{}
+
+	ctn, err := glue.New(clock, metrics, orders, users, glue.MapPropertySource{"component.name": "shared"})
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	for _, base := range []baseComponent{orders.baseComponent, users.baseComponent} {
+		require.Same(t, clock, base.Clock)
+		require.Equal(t, "shared", base.Name)
+		require.Same(t, metrics, base.Metrics)
+	}
+	require.Equal(t, 10, orders.Limit)
+	require.Same(t, orders, users.Orders)
+
+	// runtime injection walks embedded structs too
+	other := &ordersComponent{}
+	require.NoError(t, ctn.Inject(other))
+	require.Same(t, clock, other.Clock)
+	require.Same(t, metrics, other.Metrics)
+}
+
+func TestEmbeddedStructMissingDependency(t *testing.T) {
+
+	_, err := glue.New(&ordersComponent{}, &embeddedMetrics{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Clock")
+}
+
+type namedBase struct {
+	glue.NamedBean
+	Clock *embeddedClock `inject:""`
+}
+
+type namedComponent struct {
+	namedBase
+}
+
+func TestEmbeddedStructStubs(t *testing.T) {
+
+	clock, component := &embeddedClock{}, &namedComponent{}
+	ctn, err := glue.New(clock, component)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	// the stub of the anonymous field of the embedded struct is set like on own fields
+	require.NotNil(t, component.NamedBean)
+	require.Equal(t, "*glue_test.namedComponent", component.BeanName())
+	require.Same(t, clock, component.Clock)
+	require.Len(t, ctn.Lookup("*glue_test.namedComponent", 0), 1)
+}
@@ -111,3 +111,35 @@ func (r *EnvPropertyResolver) Keys() []string {
 	}
 	return keys
 }
+
+const defaultPropertyMapPriority = 1000
+
+// propertyMapResolver is the enumerable resolver registered for glue.PropertyMap.
+type propertyMapResolver struct {
+	store map[string]string
+}
+
+func newPropertyMapResolver(m PropertyMap) *propertyMapResolver {
+	store := make(map[string]string, len(m))
+	for k, v := range m {
+		store[k] = v
+	}
+	return &propertyMapResolver{store: store}
+}
+
+func (r *propertyMapResolver) Priority() int {
+	return defaultPropertyMapPriority
+}
+
+func (r *propertyMapResolver) GetProperty(key string) (string, bool) {
+	value, ok := r.store[key]
+	return value, ok
+}
+
+func (r *propertyMapResolver) Keys() []string {
+	keys := make([]string, 0, len(r.store))
+	for k := range r.store {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"os"
+	"os/user"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+/**
+EnvironmentInfo is the bean with the OS user and directories of the process, so beans do not
+reimplement home and working directory lookups. Values that can not be detected are empty.
+
+Register it by glue.New(glue.NewEnvironmentInfo(), ...) and inject as *glue.EnvironmentInfo.
+*/
+
+type EnvironmentInfo struct {
+	User      string // login name of the current user
+	UID       string
+	Home      string
+	WorkDir   string
+	Hostname  string
+	TempDir   string
+	ConfigDir string // user configuration directory, e.g. ~/.config or %APPDATA%
+	CacheDir  string // user cache directory, e.g. ~/.cache or %LOCALAPPDATA%
+}
+
+func NewEnvironmentInfo() *EnvironmentInfo {
+	t := &EnvironmentInfo{TempDir: os.TempDir()}
+	if u, err := user.Current(); err == nil {
+		t.User, t.UID, t.Home = u.Username, u.Uid, u.HomeDir
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		t.Home = home
+	}
+	t.WorkDir, _ = os.Getwd()
+	t.Hostname, _ = os.Hostname()
+	t.ConfigDir, _ = os.UserConfigDir()
+	t.CacheDir, _ = os.UserCacheDir()
+	return t
+}
+
+/**
+ExpandPath expands the path the same way as the 'path=true' option of the 'value' tag.
+*/
+
+func (t *EnvironmentInfo) ExpandPath(path string) string {
+	return expandPath(path, t.Home)
+}
+
+var windowsEnvPattern = regexp.MustCompile(`%([A-Za-z_][A-Za-z0-9_()]*)%`)
+
+/**
+Expands leading '~', $VAR, ${VAR} and %VAR% by environment variables, unknown %VAR% stay as is
+*/
+
+func expandPath(path, home string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") || strings.HasPrefix(path, `~\`) {
+		if home == "" {
+			home, _ = os.UserHomeDir()
+		}
+		if home != "" {
+			path = filepath.Join(home, path[1:])
+		}
+	}
+	path = windowsEnvPattern.ReplaceAllStringFunc(path, func(m string) string {
+		if v, ok := os.LookupEnv(m[1 : len(m)-1]); ok {
+			return v
+		}
+		return m
+	})
+	return os.ExpandEnv(path)
+}
+
+/**
+Expands every element of slice values separated by the separator
+*/
+
+func expandPaths(value string, typ reflect.Type, separator string) string {
+	if typ.Kind() != reflect.Slice {
+		return expandPath(value, "")
+	}
+	if separator == "" {
+		separator = ";"
+	}
+	parts := trimSplit(value, separator)
+	for i, p := range parts {
+		parts[i] = expandPath(p, "")
+	}
+	return strings.Join(parts, separator)
+}
+
+func isPathType(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Func && typ.NumOut() > 0 {
+		typ = typ.Out(0)
+	}
+	if typ.Kind() == reflect.Slice {
+		typ = typ.Elem()
+	}
+	return typ.Kind() == reflect.String
+}
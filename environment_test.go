@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type pathHolder struct {
+	Env     *glue.EnvironmentInfo `inject:""`
+	Data    string                `value:"app.data,path=true"`
+	Raw     string                `value:"app.data"`
+	Plugins []string              `value:"app.plugins,path"`
+	Cache   func() string         `value:"app.cache,default=~/cache,path=true"`
+}
+
+type badPathHolder struct {
+	Port int `value:"app.port,path=true"`
+}
+
+func TestPathProperties(t *testing.T) {
+
+	skipUnsupported(t, glue.FeatureProviders)
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("APPDATA", filepath.Join(home, "AppData"))
+	t.Setenv("GLUE_PLUGINS", "/opt/plugins")
+
+	holder := &pathHolder{}
+	ctn, err := glue.New(
+		glue.NewEnvironmentInfo(),
+		holder,
+		glue.MapPropertySource{
+			"app.data":    "~/data",
+			"app.plugins": "%APPDATA%/plugins;$GLUE_PLUGINS;%UNKNOWN%/x",
+		},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, filepath.Join(home, "data"), holder.Data)
+	require.Equal(t, "~/data", holder.Raw)
+	require.Equal(t, []string{filepath.Join(home, "AppData") + "/plugins", "/opt/plugins", "%UNKNOWN%/x"}, holder.Plugins)
+	require.Equal(t, filepath.Join(home, "cache"), holder.Cache())
+
+	require.Equal(t, home, holder.Env.Home)
+	wd, _ := os.Getwd()
+	require.Equal(t, wd, holder.Env.WorkDir)
+	require.Equal(t, filepath.Join(home, "x"), holder.Env.ExpandPath("$HOME/x"))
+
+	_, err = glue.New(&badPathHolder{})
+	require.Error(t, err)
+}
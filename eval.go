@@ -0,0 +1,628 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+/*
+	Value expression syntax, used in value tags as `value:"#{ ... }"`:
+
+	placeholders  - ${key}, ${key:default}, resolved values are numbers, booleans or strings
+	literals      - 10, 2.5, 'text', "text" (placeholders are resolved inside), true, false
+	arithmetic    - + - * / %, '+' concatenates when one of operands is a string
+	comparison    - == != < <= > >=
+	logical       - && || !, the right operand is evaluated only if it decides the result
+	ternary       - cond ? a : b, only the selected branch is evaluated
+	functions     - env(name [, default]), now([layout]), len(s), upper(s), lower(s), trim(s),
+	                min(a, b), max(a, b), abs(x), floor(x), ceil(x), round(x)
+*/
+
+/*
+	Checks if value tag contains the expression and splits it from the options.
+*/
+
+func cutExpressionTag(tag string) (expr string, options string, ok bool, err error) {
+	tag = strings.TrimSpace(tag)
+	if !strings.HasPrefix(tag, "#{") {
+		return "", "", false, nil
+	}
+	depth := 0
+	var quote byte
+	for i := 2; i < len(tag); i++ {
+		c := tag[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '{':
+			depth++
+		case c == '}':
+			if depth == 0 {
+				options = strings.TrimSpace(tag[i+1:])
+				if options != "" && options[0] != ',' {
+					return "", "", true, fmt.Errorf("unexpected '%s' after value expression in '%s'", options, tag)
+				}
+				return strings.TrimSpace(tag[2:i]), options, true, nil
+			}
+			depth--
+		}
+	}
+	return "", "", true, fmt.Errorf("unterminated value expression in '%s'", tag)
+}
+
+/*
+	Evaluates the expression to the string, placeholders are resolved by properties.
+*/
+
+func evalExpression(text string, properties Properties) (string, error) {
+	p := &exprParser{input: text, properties: properties}
+	p.next()
+	v, err := p.parseTernary()
+	if err == nil {
+		err = p.err
+	}
+	if err != nil {
+		return "", fmt.Errorf("expression '%s': %w", text, err)
+	}
+	if p.tok.kind != tokEOF {
+		return "", fmt.Errorf("expression '%s': unexpected '%s' at position %d", text, p.tok.text, p.tok.pos)
+	}
+	return v.String(), nil
+}
+
+type exprValue struct {
+	str    string
+	num    float64
+	b      bool
+	isStr  bool
+	isBool bool
+}
+
+func (t exprValue) String() string {
+	switch {
+	case t.isStr:
+		return t.str
+	case t.isBool:
+		return strconv.FormatBool(t.b)
+	case t.num == math.Trunc(t.num) && math.Abs(t.num) < 1e18:
+		return strconv.FormatInt(int64(t.num), 10)
+	default:
+		return strconv.FormatFloat(t.num, 'f', -1, 64)
+	}
+}
+
+func (t exprValue) truth() bool {
+	switch {
+	case t.isBool:
+		return t.b
+	case t.isStr:
+		b, err := parseBool(t.str)
+		return err == nil && b
+	default:
+		return t.num != 0
+	}
+}
+
+func (t exprValue) number() (float64, error) {
+	switch {
+	case t.isStr:
+		f, err := strconv.ParseFloat(strings.TrimSpace(t.str), 64)
+		if err != nil {
+			return 0, fmt.Errorf("'%s' is not a number", t.str)
+		}
+		return f, nil
+	case t.isBool:
+		return 0, fmt.Errorf("boolean is not a number")
+	default:
+		return t.num, nil
+	}
+}
+
+func exprNum(f float64) exprValue {
+	return exprValue{num: f}
+}
+
+func exprStr(s string) exprValue {
+	return exprValue{str: s, isStr: true}
+}
+
+func exprBool(b bool) exprValue {
+	return exprValue{b: b, isBool: true}
+}
+
+type exprTokenKind int
+
+const (
+	tokEOF exprTokenKind = iota
+	tokNumber
+	tokString
+	tokIdent
+	tokPlaceholder
+	tokOp
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	text string
+	pos  int
+}
+
+type exprParser struct {
+	input      string
+	pos        int
+	tok        exprToken
+	err        error
+	properties Properties
+
+	// greater than zero while the side not selected by '?:', '&&' or '||' is parsed
+	skip int
+}
+
+var twoCharOps = []string{"==", "!=", "<=", ">=", "&&", "||"}
+
+func (t *exprParser) next() {
+	for t.pos < len(t.input) && unicode.IsSpace(rune(t.input[t.pos])) {
+		t.pos++
+	}
+	start := t.pos
+	if t.pos >= len(t.input) {
+		t.tok = exprToken{kind: tokEOF, text: "EOF", pos: start}
+		return
+	}
+	c := t.input[t.pos]
+	switch {
+	case strings.HasPrefix(t.input[t.pos:], "${"):
+		depth := 0
+		for t.pos += 2; t.pos < len(t.input); t.pos++ {
+			if t.input[t.pos] == '{' {
+				depth++
+			} else if t.input[t.pos] == '}' {
+				if depth == 0 {
+					break
+				}
+				depth--
+			}
+		}
+		if t.pos >= len(t.input) {
+			t.err = fmt.Errorf("unterminated placeholder at position %d", start)
+			t.tok = exprToken{kind: tokEOF, text: "EOF", pos: start}
+			return
+		}
+		t.pos++
+		t.tok = exprToken{kind: tokPlaceholder, text: t.input[start:t.pos], pos: start}
+	case c >= '0' && c <= '9' || c == '.':
+		for t.pos < len(t.input) && (t.input[t.pos] >= '0' && t.input[t.pos] <= '9' || t.input[t.pos] == '.') {
+			t.pos++
+		}
+		t.tok = exprToken{kind: tokNumber, text: t.input[start:t.pos], pos: start}
+	case c == '\'' || c == '"':
+		end := strings.IndexByte(t.input[t.pos+1:], c)
+		if end < 0 {
+			t.err = fmt.Errorf("unterminated string at position %d", start)
+			t.tok = exprToken{kind: tokEOF, text: "EOF", pos: start}
+			return
+		}
+		t.pos += end + 2
+		t.tok = exprToken{kind: tokString, text: t.input[start+1 : t.pos-1], pos: start}
+	case c == '_' || unicode.IsLetter(rune(c)):
+		for t.pos < len(t.input) && (t.input[t.pos] == '_' || unicode.IsLetter(rune(t.input[t.pos])) || unicode.IsDigit(rune(t.input[t.pos]))) {
+			t.pos++
+		}
+		t.tok = exprToken{kind: tokIdent, text: t.input[start:t.pos], pos: start}
+	default:
+		for _, op := range twoCharOps {
+			if strings.HasPrefix(t.input[t.pos:], op) {
+				t.pos += 2
+				t.tok = exprToken{kind: tokOp, text: op, pos: start}
+				return
+			}
+		}
+		t.pos++
+		t.tok = exprToken{kind: tokOp, text: string(c), pos: start}
+	}
+}
+
+func (t *exprParser) isOp(ops ...string) bool {
+	if t.tok.kind != tokOp {
+		return false
+	}
+	for _, op := range ops {
+		if t.tok.text == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *exprParser) expect(op string) error {
+	if !t.isOp(op) {
+		return fmt.Errorf("expected '%s' at position %d, got '%s'", op, t.tok.pos, t.tok.text)
+	}
+	t.next()
+	return nil
+}
+
+/*
+	Parses the operand, if skip is true the operand is only checked for syntax, it is not evaluated.
+*/
+
+func (t *exprParser) parseOperand(skip bool, parse func() (exprValue, error)) (exprValue, error) {
+	if skip {
+		t.skip++
+		defer func() { t.skip-- }()
+	}
+	return parse()
+}
+
+/*
+	Drops the value and the evaluation error of the operand that is not evaluated.
+*/
+
+func (t *exprParser) eval(v exprValue, err error) (exprValue, error) {
+	if t.skip > 0 {
+		return exprValue{}, nil
+	}
+	return v, err
+}
+
+func (t *exprParser) parseTernary() (exprValue, error) {
+	cond, err := t.parseOr()
+	if err != nil || !t.isOp("?") {
+		return cond, err
+	}
+	t.next()
+	selected := cond.truth()
+	a, err := t.parseOperand(!selected, t.parseTernary)
+	if err != nil {
+		return a, err
+	}
+	if err := t.expect(":"); err != nil {
+		return a, err
+	}
+	b, err := t.parseOperand(selected, t.parseTernary)
+	if err != nil {
+		return b, err
+	}
+	if selected {
+		return a, nil
+	}
+	return b, nil
+}
+
+func (t *exprParser) parseOr() (exprValue, error) {
+	left, err := t.parseAnd()
+	for err == nil && t.isOp("||") {
+		t.next()
+		var right exprValue
+		if right, err = t.parseOperand(left.truth(), t.parseAnd); err == nil {
+			left = exprBool(left.truth() || right.truth())
+		}
+	}
+	return left, err
+}
+
+func (t *exprParser) parseAnd() (exprValue, error) {
+	left, err := t.parseEquality()
+	for err == nil && t.isOp("&&") {
+		t.next()
+		var right exprValue
+		if right, err = t.parseOperand(!left.truth(), t.parseEquality); err == nil {
+			left = exprBool(left.truth() && right.truth())
+		}
+	}
+	return left, err
+}
+
+func (t *exprParser) parseEquality() (exprValue, error) {
+	left, err := t.parseComparison()
+	for err == nil && t.isOp("==", "!=") {
+		op := t.tok.text
+		t.next()
+		var right exprValue
+		if right, err = t.parseComparison(); err == nil {
+			eq := left.String() == right.String()
+			if !left.isStr && !left.isBool && !right.isStr && !right.isBool {
+				eq = left.num == right.num
+			}
+			left = exprBool(eq == (op == "=="))
+		}
+	}
+	return left, err
+}
+
+func (t *exprParser) parseComparison() (exprValue, error) {
+	left, err := t.parseAdditive()
+	for err == nil && t.isOp("<", "<=", ">", ">=") {
+		op := t.tok.text
+		t.next()
+		var right exprValue
+		if right, err = t.parseAdditive(); err != nil {
+			break
+		}
+		if t.skip > 0 {
+			left = exprValue{}
+			continue
+		}
+		var cmp int
+		if left.isStr && right.isStr {
+			cmp = strings.Compare(left.str, right.str)
+		} else {
+			var a, b float64
+			if a, err = left.number(); err != nil {
+				break
+			}
+			if b, err = right.number(); err != nil {
+				break
+			}
+			switch {
+			case a < b:
+				cmp = -1
+			case a > b:
+				cmp = 1
+			}
+		}
+		switch op {
+		case "<":
+			left = exprBool(cmp < 0)
+		case "<=":
+			left = exprBool(cmp <= 0)
+		case ">":
+			left = exprBool(cmp > 0)
+		default:
+			left = exprBool(cmp >= 0)
+		}
+	}
+	return left, err
+}
+
+func (t *exprParser) parseAdditive() (exprValue, error) {
+	left, err := t.parseMultiplicative()
+	for err == nil && t.isOp("+", "-") {
+		op := t.tok.text
+		t.next()
+		var right exprValue
+		if right, err = t.parseMultiplicative(); err != nil {
+			break
+		}
+		if op == "+" && (left.isStr || right.isStr) {
+			left = exprStr(left.String() + right.String())
+			continue
+		}
+		left, err = t.eval(arithmetic(op, left, right))
+	}
+	return left, err
+}
+
+func (t *exprParser) parseMultiplicative() (exprValue, error) {
+	left, err := t.parseUnary()
+	for err == nil && t.isOp("*", "/", "%") {
+		op := t.tok.text
+		t.next()
+		var right exprValue
+		if right, err = t.parseUnary(); err == nil {
+			left, err = t.eval(arithmetic(op, left, right))
+		}
+	}
+	return left, err
+}
+
+func arithmetic(op string, left, right exprValue) (exprValue, error) {
+	a, err := left.number()
+	if err != nil {
+		return left, err
+	}
+	b, err := right.number()
+	if err != nil {
+		return right, err
+	}
+	switch op {
+	case "+":
+		return exprNum(a + b), nil
+	case "-":
+		return exprNum(a - b), nil
+	case "*":
+		return exprNum(a * b), nil
+	case "/":
+		if b == 0 {
+			return left, fmt.Errorf("division by zero")
+		}
+		return exprNum(a / b), nil
+	default:
+		if b == 0 {
+			return left, fmt.Errorf("division by zero")
+		}
+		return exprNum(math.Mod(a, b)), nil
+	}
+}
+
+func (t *exprParser) parseUnary() (exprValue, error) {
+	if t.isOp("-") {
+		t.next()
+		v, err := t.parseUnary()
+		if err != nil {
+			return v, err
+		}
+		f, err := v.number()
+		return t.eval(exprNum(-f), err)
+	}
+	if t.isOp("!") {
+		t.next()
+		v, err := t.parseUnary()
+		return exprBool(!v.truth()), err
+	}
+	return t.parsePrimary()
+}
+
+func (t *exprParser) parsePrimary() (exprValue, error) {
+	if t.err != nil {
+		return exprValue{}, t.err
+	}
+	tok := t.tok
+	switch tok.kind {
+	case tokNumber:
+		t.next()
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return exprValue{}, fmt.Errorf("invalid number '%s' at position %d", tok.text, tok.pos)
+		}
+		return exprNum(f), nil
+	case tokString:
+		t.next()
+		if t.skip > 0 {
+			return exprValue{}, nil
+		}
+		text, err := t.properties.ResolveText(tok.text)
+		return exprStr(text), err
+	case tokPlaceholder:
+		t.next()
+		if t.skip > 0 {
+			return exprValue{}, nil
+		}
+		text, err := t.properties.ResolveText(tok.text)
+		if err != nil {
+			return exprValue{}, err
+		}
+		if f, err := strconv.ParseFloat(strings.TrimSpace(text), 64); err == nil {
+			return exprNum(f), nil
+		}
+		if b, err := strconv.ParseBool(strings.TrimSpace(text)); err == nil {
+			return exprBool(b), nil
+		}
+		return exprStr(text), nil
+	case tokIdent:
+		t.next()
+		switch tok.text {
+		case "true":
+			return exprBool(true), nil
+		case "false":
+			return exprBool(false), nil
+		}
+		if !t.isOp("(") {
+			return exprValue{}, fmt.Errorf("unknown identifier '%s' at position %d", tok.text, tok.pos)
+		}
+		t.next()
+		var args []exprValue
+		for !t.isOp(")") {
+			if len(args) > 0 {
+				if err := t.expect(","); err != nil {
+					return exprValue{}, err
+				}
+			}
+			arg, err := t.parseTernary()
+			if err != nil {
+				return arg, err
+			}
+			args = append(args, arg)
+		}
+		t.next()
+		return t.eval(callFunction(tok.text, args))
+	case tokOp:
+		if tok.text == "(" {
+			t.next()
+			v, err := t.parseTernary()
+			if err != nil {
+				return v, err
+			}
+			return v, t.expect(")")
+		}
+	}
+	return exprValue{}, fmt.Errorf("unexpected '%s' at position %d", tok.text, tok.pos)
+}
+
+func callFunction(name string, args []exprValue) (exprValue, error) {
+	arity := func(min, max int) error {
+		if len(args) < min || len(args) > max {
+			return fmt.Errorf("function '%s' expects %d..%d arguments, got %d", name, min, max, len(args))
+		}
+		return nil
+	}
+	numeric := func(fn func(float64) float64) (exprValue, error) {
+		if err := arity(1, 1); err != nil {
+			return exprValue{}, err
+		}
+		f, err := args[0].number()
+		return exprNum(fn(f)), err
+	}
+	switch name {
+	case "env":
+		if err := arity(1, 2); err != nil {
+			return exprValue{}, err
+		}
+		if v, ok := os.LookupEnv(args[0].String()); ok {
+			return exprStr(v), nil
+		}
+		if len(args) == 2 {
+			return args[1], nil
+		}
+		return exprStr(""), nil
+	case "now":
+		if err := arity(0, 1); err != nil {
+			return exprValue{}, err
+		}
+		layout := time.RFC3339
+		if len(args) == 1 {
+			layout = args[0].String()
+		}
+		return exprStr(time.Now().Format(layout)), nil
+	case "len":
+		if err := arity(1, 1); err != nil {
+			return exprValue{}, err
+		}
+		return exprNum(float64(len([]rune(args[0].String())))), nil
+	case "upper":
+		if err := arity(1, 1); err != nil {
+			return exprValue{}, err
+		}
+		return exprStr(strings.ToUpper(args[0].String())), nil
+	case "lower":
+		if err := arity(1, 1); err != nil {
+			return exprValue{}, err
+		}
+		return exprStr(strings.ToLower(args[0].String())), nil
+	case "trim":
+		if err := arity(1, 1); err != nil {
+			return exprValue{}, err
+		}
+		return exprStr(strings.TrimSpace(args[0].String())), nil
+	case "min", "max":
+		if err := arity(2, 2); err != nil {
+			return exprValue{}, err
+		}
+		a, err := args[0].number()
+		if err != nil {
+			return exprValue{}, err
+		}
+		b, err := args[1].number()
+		if err != nil {
+			return exprValue{}, err
+		}
+		if name == "min" {
+			return exprNum(math.Min(a, b)), nil
+		}
+		return exprNum(math.Max(a, b)), nil
+	case "abs":
+		return numeric(math.Abs)
+	case "floor":
+		return numeric(math.Floor)
+	case "ceil":
+		return numeric(math.Ceil)
+	case "round":
+		return numeric(math.Round)
+	default:
+		return exprValue{}, fmt.Errorf("unknown function '%s'", name)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type evalService struct {
+	Workers   int           `value:"#{ ${pool.size} * 2 }"`
+	Half      float64       `value:"#{ ${pool.size} / 4 }"`
+	Mode      string        `value:"#{ ${pool.size} > 4 ? 'large' : 'small' }"`
+	Url       string        `value:"#{ 'http://' + ${host} + ':' + (${port:8000} + 80) }"`
+	Home      string        `value:"#{ upper(env('GLUE_EVAL_TEST', 'none')) }"`
+	Limit     int           `value:"#{ max(${pool.size}, 16) }"`
+	Enabled   bool          `value:"#{ ${pool.size} >= 8 && !${disabled:false} }"`
+	Timeout   time.Duration `value:"#{ ${pool.size} * 100 + 'ms' }"`
+	Fallback  int           `value:"#{ ${missing} + 1 },default=42"`
+	GetDouble func() int    `value:"#{ ${pool.size} * 2 }"`
+}
+
+func TestValueExpressionInjection(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
+	os.Setenv("GLUE_EVAL_TEST", "abc")
+	defer os.Unsetenv("GLUE_EVAL_TEST")
+
+	b := &evalService{}
+	ctn, err := glue.New(
+		b,
+		glue.MapPropertySource{
+			"pool.size": "8",
+			"host":      "example.com",
+		},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, 16, b.Workers)
+	require.Equal(t, 2.0, b.Half)
+	require.Equal(t, "large", b.Mode)
+	require.Equal(t, "http://example.com:8080", b.Url)
+	require.Equal(t, "ABC", b.Home)
+	require.Equal(t, 16, b.Limit)
+	require.True(t, b.Enabled)
+	require.Equal(t, 800*time.Millisecond, b.Timeout)
+	require.Equal(t, 42, b.Fallback)
+
+	ctn.Properties().Set("pool.size", "3")
+	require.Equal(t, 6, b.GetDouble())
+}
+
+type evalGuardedService struct {
+	Ratio    int    `value:"#{ ${n} == 0 ? 0 : 100 / ${n} }"`
+	Inverse  int    `value:"#{ ${n} != 0 ? 100 / ${n} : -1 }"`
+	Positive bool   `value:"#{ ${n} != 0 && 100 / ${n} > 1 }"`
+	Zero     bool   `value:"#{ ${n} == 0 || 100 / ${n} > 1 }"`
+	Name     string `value:"#{ ${n} == 0 ? 'none' : ${missing} }"`
+}
+
+func TestValueExpressionShortCircuit(t *testing.T) {
+
+	b := &evalGuardedService{}
+	ctn, err := glue.New(b, glue.MapPropertySource{"n": "0"})
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, 0, b.Ratio)
+	require.Equal(t, -1, b.Inverse)
+	require.False(t, b.Positive)
+	require.True(t, b.Zero)
+	require.Equal(t, "none", b.Name)
+}
+
+type evalSkippedSyntaxService struct {
+	Value int `value:"#{ true ? 1 : 2 + }"`
+}
+
+type evalSelectedService struct {
+	Value int `value:"#{ ${n} == 0 ? 100 / ${n} : 0 }"`
+}
+
+type evalBrokenService struct {
+	Value int `value:"#{ 1 + }"`
+}
+
+type evalMissingService struct {
+	Value int `value:"#{ ${missing} * 2 }"`
+}
+
+type evalUnterminatedService struct {
+	Value int `value:"#{ 1 + 2"`
+}
+
+func TestValueExpressionErrors(t *testing.T) {
+
+	_, err := glue.New(&evalBrokenService{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "expression")
+
+	_, err = glue.New(&evalMissingService{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "missing")
+
+	// the skipped side is still parsed
+	_, err = glue.New(&evalSkippedSyntaxService{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unexpected")
+
+	_, err = glue.New(&evalSelectedService{}, glue.MapPropertySource{"n": "0"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "division by zero")
+
+	_, err = glue.New(&evalUnterminatedService{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unterminated value expression")
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"runtime"
+)
+
+/**
+AsyncListener registers the EventListener called in background goroutine for every event.
+Container waits for in-flight deliveries on close before destroying beans.
+*/
+
+func AsyncListener(obj any) any {
+	return wrapBean(obj, func(b *bean) {
+		b.asyncEvents = true
+	})
+}
+
+/**
+Subscribes event and lifecycle listeners in the scan order, OrderedBean listeners go first
+*/
+
+func (t *container) subscribeEventListeners(lists ...[]*bean) {
+	var events, lifecycle []*bean
+	for _, beans := range lists {
+		for _, b := range beans {
+			if b.beenFactory != nil || b.obj == nil {
+				continue
+			}
+			if _, ok := b.obj.(EventListener); ok {
+				events = append(events, b)
+			}
+			if _, ok := b.obj.(LifecycleListener); ok {
+				lifecycle = append(lifecycle, b)
+			}
+		}
+	}
+	events, lifecycle = orderBeans(events), orderBeans(lifecycle)
+	if t.chaos != nil {
+		t.chaos.shuffleUnordered(events)
+		t.chaos.shuffleUnordered(lifecycle)
+	}
+	t.eventsMu.Lock()
+	t.eventListeners = events
+	t.lifecycleListeners = lifecycle
+	t.eventsMu.Unlock()
+}
+
+/**
+Delivers the event to listeners of this container and then to listeners of parents
+*/
+
+func (t *container) PublishEvent(event any) {
+	for ctx := t; ctx != nil; ctx = ctx.parent {
+		ctx.eventsMu.RLock()
+		listeners := ctx.eventListeners
+		ctx.eventsMu.RUnlock()
+		for _, b := range listeners {
+			listener := b.obj.(EventListener)
+			if b.asyncEvents {
+				ctx.asyncEvents.Add(1)
+				go func(c *container, b *bean) {
+					defer c.asyncEvents.Done()
+					c.deliverEvent(b, listener, event)
+				}(ctx, b)
+			} else {
+				ctx.deliverEvent(b, listener, event)
+			}
+		}
+	}
+}
+
+func (t *container) deliverEvent(b *bean, listener EventListener, event any) {
+	defer func() {
+		if r := recover(); r != nil {
+			stack := make([]byte, 4096)
+			stack = stack[:runtime.Stack(stack, false)]
+			t.logf(LogError, "Event listener '%s' recovered from panic on event %T: %v, stacktrace: %s\n", b.name, event, r, stack)
+			t.recordEvent(fmt.Sprintf("event listener '%s' panic", b.name), fmt.Errorf("%v", r))
+		}
+	}()
+	listener.OnEvent(event)
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type userCreated struct {
+	Name string
+}
+
+type userService struct {
+	Events glue.EventPublisher `inject:""`
+}
+
+func (t *userService) Create(name string) {
+	t.Events.PublishEvent(userCreated{Name: name})
+}
+
+type auditListener struct {
+	events []any
+}
+
+func (t *auditListener) OnEvent(event any) {
+	t.events = append(t.events, event)
+}
+
+type mailListener struct {
+	mu   sync.Mutex
+	sent []string
+	done chan struct{}
+}
+
+func (t *mailListener) OnEvent(event any) {
+	if e, ok := event.(userCreated); ok {
+		time.Sleep(5 * time.Millisecond)
+		t.mu.Lock()
+		t.sent = append(t.sent, e.Name)
+		t.mu.Unlock()
+	}
+}
+
+func TestEventBus(t *testing.T) {
+
+	service := &userService{}
+	audit := &auditListener{}
+	mail := &mailListener{}
+	var typed []string
+
+	ctn, err := glue.New(
+		service,
+		audit,
+		glue.AsyncListener(mail),
+		glue.OnEvent(func(e userCreated) {
+			typed = append(typed, e.Name)
+		}),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, len(audit.events))
+	started, ok := audit.events[0].(glue.ContainerStartedEvent)
+	require.True(t, ok)
+	require.Equal(t, ctn, started.Container)
+
+	service.Create("alice")
+	require.Equal(t, []string{"alice"}, typed)
+	require.Equal(t, userCreated{Name: "alice"}, audit.events[1])
+
+	require.NoError(t, ctn.Close())
+
+	_, ok = audit.events[2].(glue.ContainerClosingEvent)
+	require.True(t, ok)
+	mail.mu.Lock()
+	require.Equal(t, []string{"alice"}, mail.sent)
+	mail.mu.Unlock()
+
+	service.Create("bob")
+	require.Equal(t, []string{"alice"}, typed)
+}
+
+type panicListener struct{}
+
+func (t *panicListener) OnEvent(event any) {
+	panic("boom")
+}
+
+func TestEventBusChildToParent(t *testing.T) {
+
+	audit := &auditListener{}
+	parent, err := glue.New(audit)
+	require.NoError(t, err)
+	defer parent.Close()
+
+	service := &userService{}
+	child, err := parent.Extend(service, &panicListener{})
+	require.NoError(t, err)
+	defer child.Close()
+
+	service.Create("carol")
+	require.Contains(t, audit.events, userCreated{Name: "carol"})
+}
@@ -58,6 +58,8 @@ func TestPropertyExpressionsDetectCycles(t *testing.T) {
 }
 
 func TestPropertyExpressionsDriveStaticAndDynamicValueInjection(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	svc := &exprService{}
 
 	ctx, err := glue.New(
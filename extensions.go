@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultPluginSymbol is the symbol of the plugin file returning beans of the plugin.
+var DefaultPluginSymbol = "Beans"
+
+/**
+PluginLoader is the scan item merging beans of Go plugin files and registered extensions in to the container,
+so deployments extend the application without recompiling the host binary:
+
+	glue.New(
+		&glue.PluginLoader{Files: []string{"plugins/*.so"}, Extensions: []string{"*"}},
+		&app{},
+	)
+
+The plugin file exports the symbol 'func Beans() []any' or the variable 'var Beans []any'.
+Plugins are opened once, beans are scanned in the place of the loader as a nested list.
+*/
+
+type PluginLoader struct {
+
+	/*
+		Paths or glob patterns of plugin files, the pattern without matches loads nothing
+	*/
+	Files []string
+
+	/*
+		Names of extensions registered by RegisterExtension, "*" loads all of them in the name order
+	*/
+	Extensions []string
+
+	/*
+		Symbol of the plugin file, DefaultPluginSymbol if empty
+	*/
+	Symbol string
+
+	once  sync.Once
+	beans []any
+	err   error
+}
+
+func (t *PluginLoader) load() ([]any, error) {
+	t.once.Do(func() {
+		t.beans, t.err = t.loadBeans()
+	})
+	return t.beans, t.err
+}
+
+func (t *PluginLoader) loadBeans() ([]any, error) {
+	var beans []any
+	for _, name := range t.extensionNames() {
+		ctor, ok := findExtension(name)
+		if !ok {
+			return nil, fmt.Errorf("extension '%s' is not registered", name)
+		}
+		beans = append(beans, ctor()...)
+	}
+	if len(t.Files) == 0 {
+		return beans, nil
+	}
+	if !hasPlugins {
+		return nil, requireFeature(FeaturePlugins)
+	}
+	symbol := t.Symbol
+	if symbol == "" {
+		symbol = DefaultPluginSymbol
+	}
+	for _, pattern := range t.Files {
+		files := []string{pattern}
+		if strings.ContainsAny(pattern, "*?[") {
+			var err error
+			if files, err = filepath.Glob(pattern); err != nil {
+				return nil, fmt.Errorf("plugin pattern '%s': %w", pattern, err)
+			}
+		}
+		for _, file := range files {
+			list, err := openPlugin(file, symbol)
+			if err != nil {
+				return nil, err
+			}
+			beans = append(beans, list...)
+		}
+	}
+	return beans, nil
+}
+
+func (t *PluginLoader) extensionNames() []string {
+	for _, name := range t.Extensions {
+		if name == "*" {
+			return Extensions()
+		}
+	}
+	return t.Extensions
+}
+
+var extensions = struct {
+	sync.RWMutex
+	ctors map[string]func() []any
+}{ctors: make(map[string]func() []any)}
+
+/**
+RegisterExtension registers beans of the extension by name, usually in the init function of the package
+linked in to the binary, PluginLoader merges them in to the container. Panics on duplicate names.
+*/
+
+func RegisterExtension(name string, beans func() []any) {
+	if beans == nil {
+		panic("glue: RegisterExtension beans function is nil")
+	}
+	extensions.Lock()
+	defer extensions.Unlock()
+	if _, dup := extensions.ctors[name]; dup {
+		panic("glue: RegisterExtension called twice for extension " + name)
+	}
+	extensions.ctors[name] = beans
+}
+
+/**
+Extensions returns names of registered extensions in sorted order.
+*/
+
+func Extensions() []string {
+	extensions.RLock()
+	defer extensions.RUnlock()
+	list := make([]string, 0, len(extensions.ctors))
+	for name := range extensions.ctors {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func findExtension(name string) (func() []any, bool) {
+	extensions.RLock()
+	defer extensions.RUnlock()
+	ctor, ok := extensions.ctors[name]
+	return ctor, ok
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import "strings"
+
+/**
+Facet registers the object as the named instance of the family of beans of the same type,
+each facet is wired independently and reads 'value' properties under its own prefix:
+
+	glue.New(
+		glue.Facet(&Worker{}, "fast", "worker.fast"),
+		glue.Facet(&Worker{}, "slow", "worker.slow"),
+	)
+
+Field `value:"threads"` of the "fast" facet reads 'worker.fast.threads' and falls back to 'threads' if it is not defined,
+so shared defaults stay in one place. Expressions are not prefixed. Inject facets by inject:"bean=fast".
+*/
+
+func Facet(obj any, name, prefix string) any {
+	return wrapBean(obj, func(b *bean) {
+		b.name = name
+		b.qualifier = name
+		b.propertyPrefix = strings.TrimSuffix(prefix, ".")
+	})
+}
+
+/**
+Returns the definition reading the property under the prefix of the facet if it is defined there
+*/
+
+func (t *propInjectionDef) facet(prefix string, properties Properties) *propInjectionDef {
+	if prefix == "" || t.expression {
+		return t
+	}
+	name := prefix + "." + t.propertyName
+	if t.isMapPrefix {
+		if !hasKeyPrefix(properties, name+".") {
+			return t
+		}
+	} else if _, ok, err := properties.Resolve(name); err != nil || !ok {
+		return t
+	}
+	def := *t
+	def.propertyName = name
+	return &def
+}
+
+func hasKeyPrefix(properties Properties, prefix string) bool {
+	for _, key := range properties.Keys() {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	for _, r := range properties.PropertyResolvers() {
+		if enumerable, ok := r.(EnumerablePropertyResolver); ok {
+			for _, key := range enumerable.Keys() {
+				if strings.HasPrefix(key, prefix) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
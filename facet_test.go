@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type facetClock struct{}
+
+type facetWorker struct {
+	Clock   *facetClock       `inject:""`
+	Threads int               `value:"threads"`
+	Queue   string            `value:"queue,default=jobs"`
+	Tags    map[string]string `value:"prefix=tags"`
+}
+
+type facetPool struct {
+	Fast    *facetWorker   `inject:"bean=fast"`
+	Slow    *facetWorker   `inject:"bean=slow"`
+	Workers []*facetWorker `inject:""`
+}
+
+func TestFacet(t *testing.T) {
+
+	pool := &facetPool{}
+	ctn, err := glue.New(
+		&glue.PropertySource{Map: map[string]any{
+			"threads":               2,
+			"worker.fast.threads":   16,
+			"worker.fast.queue":     "priority",
+			"worker.slow.tags.tier": "batch",
+		}},
+		&facetClock{},
+		glue.Facet(&facetWorker{}, "fast", "worker.fast"),
+		glue.Facet(&facetWorker{}, "slow", "worker.slow."),
+		pool,
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Len(t, pool.Workers, 2)
+	require.NotSame(t, pool.Fast, pool.Slow)
+	require.NotNil(t, pool.Fast.Clock)
+	require.Same(t, pool.Fast.Clock, pool.Slow.Clock)
+
+	require.Equal(t, 16, pool.Fast.Threads)
+	require.Equal(t, "priority", pool.Fast.Queue)
+	require.Empty(t, pool.Fast.Tags)
+
+	require.Equal(t, 2, pool.Slow.Threads)
+	require.Equal(t, "jobs", pool.Slow.Queue)
+	require.Equal(t, map[string]string{"tier": "batch"}, pool.Slow.Tags)
+
+	require.Len(t, ctn.Lookup("fast", 0), 1)
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type brokerConn struct {
+	closed bool
+}
+
+func (t *brokerConn) Destroy() error {
+	t.closed = true
+	return nil
+}
+
+type brokerFactory struct {
+	cancelled chan error
+}
+
+func (t *brokerFactory) Object() (any, error) {
+	return t.ObjectCtx(context.Background())
+}
+
+func (t *brokerFactory) ObjectCtx(ctx context.Context) (any, error) {
+	select {
+	case <-ctx.Done():
+		t.cancelled <- ctx.Err()
+		return nil, ctx.Err()
+	case <-time.After(5 * time.Second):
+		return &brokerConn{}, nil
+	}
+}
+
+func (t *brokerFactory) ObjectType() reflect.Type { return reflect.TypeOf((*brokerConn)(nil)) }
+
+func (t *brokerFactory) ObjectName() string { return "" }
+
+func (t *brokerFactory) Singleton() bool { return true }
+
+type brokerClient struct {
+	Conn *brokerConn `inject:""`
+}
+
+type failingInitDep struct{}
+
+type failingInitBean struct {
+	Dep *failingInitDep `inject:""`
+}
+
+func (t *failingInitBean) PostConstruct() error {
+	time.Sleep(10 * time.Millisecond)
+	return errors.New("no config")
+}
+
+func TestFactoryCancelledOnStartupTimeout(t *testing.T) {
+
+	factory := &brokerFactory{cancelled: make(chan error, 1)}
+	_, err := glue.NewWithOptions(
+		glue.WithStartupTimeout(50*time.Millisecond),
+		glue.WithBeans(factory, &brokerClient{}),
+	)
+	require.True(t, errors.Is(err, glue.ErrStartupTimeout), err)
+
+	select {
+	case err := <-factory.cancelled:
+		require.Error(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("factory was not cancelled")
+	}
+}
+
+func TestFactoryCancelledOnFailure(t *testing.T) {
+
+	factory := &brokerFactory{cancelled: make(chan error, 1)}
+	start := time.Now()
+	_, err := glue.New(
+		&glue.ParallelInit{Workers: 4},
+		factory,
+		&brokerClient{},
+		&failingInitDep{},
+		&failingInitBean{},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no config")
+	require.Less(t, time.Since(start), time.Second)
+	select {
+	case err := <-factory.cancelled:
+		require.True(t, errors.Is(err, context.Canceled))
+	case <-time.After(time.Second):
+		t.Fatal("factory was not cancelled")
+	}
+}
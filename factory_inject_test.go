@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type wiredCodec struct {
+	constructed bool
+}
+
+func (t *wiredCodec) PostConstruct() error {
+	t.constructed = true
+	return nil
+}
+
+type wiredClient struct {
+	Codec   *wiredCodec   `inject:""`
+	Timeout time.Duration `value:"client.timeout,default=1s"`
+
+	endpoint    string
+	codecReady  bool
+	constructed bool
+}
+
+func (t *wiredClient) PostConstruct() error {
+	if t.Codec == nil {
+		return errors.New("codec is not injected")
+	}
+	t.codecReady = t.Codec.constructed
+	t.constructed = true
+	return nil
+}
+
+var wiredClientClass = reflect.TypeOf((*wiredClient)(nil))
+
+type wiredClientFactory struct {
+	inject bool
+	class  reflect.Type
+}
+
+func (t *wiredClientFactory) Object() (any, error) {
+	return &wiredClient{endpoint: "localhost:9000"}, nil
+}
+
+func (t *wiredClientFactory) ObjectType() reflect.Type {
+	return t.class
+}
+
+func (t *wiredClientFactory) ObjectName() string {
+	return ""
+}
+
+func (t *wiredClientFactory) Singleton() bool {
+	return true
+}
+
+func (t *wiredClientFactory) InjectResult() bool {
+	return t.inject
+}
+
+type wiredClientUser struct {
+	Client *wiredClient `inject:""`
+}
+
+func TestFactoryInjectResult(t *testing.T) {
+
+	user := &wiredClientUser{}
+	ctn, err := glue.New(
+		&wiredClientFactory{inject: true, class: wiredClientClass},
+		user,
+		glue.MapPropertySource{"client.timeout": "3s"},
+		&wiredCodec{},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	client := user.Client
+	require.NotNil(t, client)
+	require.Equal(t, "localhost:9000", client.endpoint)
+	require.NotNil(t, client.Codec)
+	require.Equal(t, 3*time.Second, client.Timeout)
+	require.True(t, client.constructed)
+	require.True(t, client.codecReady)
+}
+
+func TestFactoryInjectResultDisabled(t *testing.T) {
+
+	user := &wiredClientUser{}
+	ctn, err := glue.New(&wiredClientFactory{class: wiredClientClass}, user)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Nil(t, user.Client.Codec)
+	require.False(t, user.Client.constructed)
+}
+
+func TestFactoryInjectResultMissingDependency(t *testing.T) {
+
+	_, err := glue.New(&wiredClientFactory{inject: true, class: wiredClientClass}, &wiredClientUser{})
+	require.Error(t, err)
+}
+
+func TestFactoryInjectResultInterface(t *testing.T) {
+
+	_, err := glue.New(&wiredClientFactory{inject: true, class: glue.InitializingBeanClass})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must produce pointer to struct")
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrFeatureUnavailable is returned by features that are not compiled in the 'glue_restricted' build.
+var ErrFeatureUnavailable = errors.New("feature is not available in glue_restricted build")
+
+/**
+Feature is the part of the container that depends on the filesystem, background goroutines
+or reflection unsupported by WASM and TinyGo-like targets.
+*/
+
+type Feature string
+
+const (
+	FeatureFileProperties  Feature = "file-properties"  // 'file:' property sources, dotenv files and FileWritableCheck
+	FeaturePropertyRefresh Feature = "property-refresh" // PropertyRefresher polling of refreshable sources
+	FeatureConstructors    Feature = "constructors"     // constructor functions registered as beans, reflect.StructOf
+	FeatureProviders       Feature = "providers"        // dynamic properties and scoped providers, reflect.MakeFunc
+	FeatureSetters         Feature = "setters"          // injection of unexported fields by setter methods, reflect.MethodByName
+	FeatureSandbox         Feature = "sandbox"          // sandboxed beans, reflect.MethodByName
+	FeaturePlugins         Feature = "plugins"          // Go plugin files opened by PluginLoader
+	FeatureResourceWatch   Feature = "resource-watch"   // polling goroutines of Container.WatchResource
+)
+
+/**
+Availability of features in the current build, constants so the compiler drops the code of unavailable features.
+The 'glue_restricted' build keeps the filesystem and goroutines, but not file watching, plugins
+and reflection missing on WASM and TinyGo-like targets.
+*/
+
+const (
+	hasFileProperties  = true
+	hasPropertyRefresh = true
+	hasConstructors    = !restrictedBuild
+	hasProviders       = !restrictedBuild
+	hasSetters         = !restrictedBuild
+	hasSandbox         = !restrictedBuild
+	hasPlugins         = !restrictedBuild
+	hasResourceWatch   = !restrictedBuild
+)
+
+/**
+Feature matrix of the current build
+*/
+
+var featureMatrix = map[Feature]bool{
+	FeatureFileProperties:  hasFileProperties,
+	FeaturePropertyRefresh: hasPropertyRefresh,
+	FeatureConstructors:    hasConstructors,
+	FeatureProviders:       hasProviders,
+	FeatureSetters:         hasSetters,
+	FeatureSandbox:         hasSandbox,
+	FeaturePlugins:         hasPlugins,
+	FeatureResourceWatch:   hasResourceWatch,
+}
+
+/**
+FeatureSupported returns true if the feature is available in the current build.
+*/
+
+func FeatureSupported(feature Feature) bool {
+	return featureMatrix[feature]
+}
+
+/**
+Features returns the feature matrix of the current build.
+*/
+
+func Features() map[Feature]bool {
+	m := make(map[Feature]bool, len(featureMatrix))
+	for feature, supported := range featureMatrix {
+		m[feature] = supported
+	}
+	return m
+}
+
+/**
+Returns ErrFeatureUnavailable wrapped with the feature name if the feature is not available in the current build
+*/
+
+func requireFeature(feature Feature) error {
+	if FeatureSupported(feature) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrFeatureUnavailable, feature)
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type featureRepo struct{}
+
+type featureService struct {
+	Repo *featureRepo
+}
+
+func TestFeatureMatrix(t *testing.T) {
+
+	features := glue.Features()
+	require.Contains(t, features, glue.FeatureConstructors)
+
+	for feature, supported := range features {
+		require.Equal(t, supported, glue.FeatureSupported(feature))
+	}
+
+	ctor := func(repo *featureRepo) *featureService {
+		return &featureService{Repo: repo}
+	}
+
+	ctn, err := glue.New(&featureRepo{}, ctor)
+	if glue.FeatureSupported(glue.FeatureConstructors) {
+		require.NoError(t, err)
+		ctn.Close()
+	} else {
+		require.True(t, errors.Is(err, glue.ErrFeatureUnavailable), err)
+	}
+}
+
+type featureGetterService struct {
+	Repo func() (*featureRepo, bool) `inject:""`
+}
+
+func TestFeatureMatrixEntries(t *testing.T) {
+
+	// file property sources are available in every build
+	require.True(t, glue.FeatureSupported(glue.FeatureFileProperties))
+	file := filepath.Join(t.TempDir(), "app.properties")
+	require.NoError(t, os.WriteFile(file, []byte("app.name = file\n"), 0644))
+	ctn, err := glue.New(&glue.PropertySource{File: "file:" + file})
+	require.NoError(t, err)
+	require.Equal(t, "file", ctn.Properties().GetString("app.name", ""))
+	ctn.Close()
+
+	// func getters are built by reflect.MakeFunc
+	s := &featureGetterService{}
+	ctn, err = glue.New(&featureRepo{}, s)
+	if glue.FeatureSupported(glue.FeatureProviders) {
+		require.NoError(t, err)
+		_, ok := s.Repo()
+		require.True(t, ok)
+		ctn.Close()
+	} else {
+		require.True(t, errors.Is(err, glue.ErrFeatureUnavailable), err)
+	}
+}
+
+// skipUnsupported skips the test in builds without the feature, e.g. with the 'glue_restricted' tag
+func skipUnsupported(t *testing.T, features ...glue.Feature) {
+	t.Helper()
+	for _, feature := range features {
+		if !glue.FeatureSupported(feature) {
+			t.Skipf("feature '%s' is not available in this build", feature)
+		}
+	}
+}
@@ -0,0 +1,359 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package gluetest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+	"go.arpabet.com/glue"
+)
+
+// DefaultStartTimeout is the time to wait for the started service to accept connections.
+var DefaultStartTimeout = 60 * time.Second
+
+/**
+Runner starts and removes ephemeral containers, Dockertest is used by default.
+*/
+
+type Runner interface {
+
+	/*
+		Start runs the image in background with environment variables and returns the container id
+		with the host address mapped to the container port, e.g. '5432/tcp'.
+	*/
+	Start(ctx context.Context, image string, env []string, port string) (id string, addr string, err error)
+
+	/*
+		Exec runs the command inside of the container, used for readiness probes.
+	*/
+	Exec(ctx context.Context, id string, cmd ...string) error
+
+	/*
+		Remove stops and removes the container.
+	*/
+	Remove(ctx context.Context, id string) error
+}
+
+/**
+Service describes the ephemeral container and properties it exposes after the start.
+*/
+
+type Service struct {
+	Name  string   // bean name of the produced *Endpoint and the property prefix
+	Image string   // image with tag
+	Port  string   // container port, e.g. '5432/tcp'
+	Env   []string // environment variables 'KEY=value'
+	Ready []string // optional readiness command executed in the container until it succeeds
+
+	// Properties returns properties of the started service without the prefix
+	Properties func(host, port string) map[string]string
+}
+
+/**
+Endpoint is the address of the started service, produced by DockerService.
+*/
+
+type Endpoint struct {
+	Name       string
+	ID         string
+	Host       string
+	Port       string
+	Properties map[string]string
+}
+
+func (t *Endpoint) Addr() string {
+	return net.JoinHostPort(t.Host, t.Port)
+}
+
+/**
+DockerService is the FactoryBean that starts the ephemeral container on the first injection of *Endpoint,
+sets properties '<name>.<key>' in the container properties and removes the container on close.
+
+Beans reading these properties must inject the endpoint, so they are constructed after the start:
+
+	type repo struct {
+		DB  *gluetest.Endpoint `inject:"postgres"`
+		URL string             `value:"postgres.url"`
+	}
+*/
+
+type DockerService struct {
+	Properties glue.Properties `inject:""`
+
+	Runner  Runner
+	Timeout time.Duration
+
+	service  Service
+	runner   Runner
+	endpoint *Endpoint
+}
+
+func NewDockerService(service Service) *DockerService {
+	return &DockerService{service: service}
+}
+
+/**
+Postgres returns the DockerService of 'postgres:16-alpine' with properties
+'postgres.host', 'postgres.port', 'postgres.user', 'postgres.password', 'postgres.database' and 'postgres.url'.
+*/
+
+func Postgres() *DockerService {
+	const user, password, database = "test", "test", "test"
+	return NewDockerService(Service{
+		Name:  "postgres",
+		Image: "postgres:16-alpine",
+		Port:  "5432/tcp",
+		Env:   []string{"POSTGRES_USER=" + user, "POSTGRES_PASSWORD=" + password, "POSTGRES_DB=" + database},
+		Ready: []string{"pg_isready", "-U", user, "-d", database},
+		Properties: func(host, port string) map[string]string {
+			return map[string]string{
+				"host":     host,
+				"port":     port,
+				"user":     user,
+				"password": password,
+				"database": database,
+				"url":      fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", user, password, net.JoinHostPort(host, port), database),
+			}
+		},
+	})
+}
+
+/**
+Redis returns the DockerService of 'redis:7-alpine' with properties 'redis.host', 'redis.port', 'redis.addr' and 'redis.url'.
+*/
+
+func Redis() *DockerService {
+	return NewDockerService(Service{
+		Name:  "redis",
+		Image: "redis:7-alpine",
+		Port:  "6379/tcp",
+		Ready: []string{"redis-cli", "ping"},
+		Properties: func(host, port string) map[string]string {
+			addr := net.JoinHostPort(host, port)
+			return map[string]string{
+				"host": host,
+				"port": port,
+				"addr": addr,
+				"url":  "redis://" + addr,
+			}
+		},
+	})
+}
+
+func (t *DockerService) Object(ctx context.Context) (any, error) {
+	runner := t.Runner
+	if runner == nil {
+		runner = &Dockertest{}
+	}
+	t.runner = runner
+	timeout := t.Timeout
+	if timeout <= 0 {
+		timeout = DefaultStartTimeout
+	}
+
+	id, addr, err := runner.Start(ctx, t.service.Image, t.service.Env, t.service.Port)
+	if err != nil {
+		return nil, fmt.Errorf("start of '%s' container '%s' failed: %w", t.service.Name, t.service.Image, err)
+	}
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		runner.Remove(ctx, id)
+		return nil, fmt.Errorf("invalid address '%s' of '%s' container: %w", addr, t.service.Name, err)
+	}
+	endpoint := &Endpoint{Name: t.service.Name, ID: id, Host: host, Port: port}
+	t.endpoint = endpoint
+
+	if err := t.waitReady(ctx, runner, endpoint, timeout); err != nil {
+		runner.Remove(context.Background(), id)
+		t.endpoint = nil
+		return nil, err
+	}
+
+	if t.service.Properties != nil {
+		endpoint.Properties = t.service.Properties(host, port)
+		for key, value := range endpoint.Properties {
+			t.Properties.Set(t.service.Name+"."+key, value)
+		}
+	}
+	return endpoint, nil
+}
+
+func (t *DockerService) waitReady(ctx context.Context, runner Runner, endpoint *Endpoint, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	var err error
+	for {
+		if len(t.service.Ready) > 0 {
+			err = runner.Exec(ctx, endpoint.ID, t.service.Ready...)
+		} else {
+			var conn net.Conn
+			conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", endpoint.Addr())
+			if err == nil {
+				conn.Close()
+			}
+		}
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("'%s' container '%s' is not ready in %v: %w", t.service.Name, t.service.Image, timeout, err)
+		case <-time.After(200 * time.Millisecond):
+		}
+	}
+}
+
+func (t *DockerService) ObjectType() reflect.Type {
+	return reflect.TypeOf((*Endpoint)(nil))
+}
+
+func (t *DockerService) ObjectName() string {
+	return t.service.Name
+}
+
+func (t *DockerService) Singleton() bool {
+	return true
+}
+
+func (t *DockerService) Destroy() error {
+	if t.endpoint == nil {
+		return nil
+	}
+	err := t.runner.Remove(context.Background(), t.endpoint.ID)
+	t.endpoint = nil
+	return err
+}
+
+/**
+Dockertest runs containers through the docker API by github.com/ory/dockertest.
+*/
+
+type Dockertest struct {
+	Endpoint string // docker endpoint, default is taken from DOCKER_HOST
+
+	mu        sync.Mutex
+	pool      *dockertest.Pool
+	resources map[string]*dockertest.Resource
+}
+
+func (t *Dockertest) Start(ctx context.Context, image string, env []string, port string) (string, string, error) {
+	pool, err := t.getPool()
+	if err != nil {
+		return "", "", err
+	}
+	if err := ctx.Err(); err != nil {
+		return "", "", err
+	}
+	repository, tag := docker.ParseRepositoryTag(image)
+	resource, err := pool.RunWithOptions(&dockertest.RunOptions{
+		Repository:   repository,
+		Tag:          tag,
+		Env:          env,
+		ExposedPorts: []string{port},
+		PortBindings: map[docker.Port][]docker.PortBinding{
+			docker.Port(port): {{HostIP: "127.0.0.1"}},
+		},
+	}, func(config *docker.HostConfig) {
+		config.AutoRemove = true
+		config.RestartPolicy = docker.RestartPolicy{Name: "no"}
+	})
+	if err != nil {
+		return "", "", err
+	}
+	id := resource.Container.ID
+	addr := resource.GetHostPort(port)
+	if addr == "" {
+		pool.Purge(resource)
+		return "", "", fmt.Errorf("port '%s' of container '%s' is not mapped", port, id)
+	}
+	t.mu.Lock()
+	if t.resources == nil {
+		t.resources = make(map[string]*dockertest.Resource)
+	}
+	t.resources[id] = resource
+	t.mu.Unlock()
+	return id, addr, nil
+}
+
+func (t *Dockertest) Exec(ctx context.Context, id string, cmd ...string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	t.mu.Lock()
+	resource, ok := t.resources[id]
+	t.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("container '%s' is not started by this runner", id)
+	}
+	var stderr bytes.Buffer
+	code, err := resource.Exec(cmd, dockertest.ExecOptions{StdErr: &stderr})
+	if err != nil {
+		return err
+	}
+	if code != 0 {
+		return fmt.Errorf("%s exited with code %d: %s", strings.Join(cmd, " "), code, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+func (t *Dockertest) Remove(ctx context.Context, id string) error {
+	pool, err := t.getPool()
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	delete(t.resources, id)
+	t.mu.Unlock()
+	return pool.Client.RemoveContainer(docker.RemoveContainerOptions{ID: id, Force: true, RemoveVolumes: true, Context: ctx})
+}
+
+func (t *Dockertest) getPool() (*dockertest.Pool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.pool == nil {
+		pool, err := dockertest.NewPool(t.Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("docker pool: %w", err)
+		}
+		t.pool = pool
+	}
+	return t.pool, nil
+}
+
+/**
+TB is the part of testing.TB used by the package, so it does not depend on the testing package.
+*/
+
+type TB interface {
+	Helper()
+	Skipf(format string, args ...any)
+}
+
+/**
+RequireDocker skips the test if the docker daemon is not available.
+*/
+
+func RequireDocker(tb TB) {
+	tb.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	pool, err := (&Dockertest{}).getPool()
+	if err == nil {
+		err = pool.Client.PingWithContext(ctx)
+	}
+	if err != nil {
+		tb.Skipf("docker is not available: %v", err)
+	}
+}
@@ -6,7 +6,9 @@
 package glue
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"sort"
 	"strings"
 )
@@ -24,31 +26,13 @@ func (t *container) Graph() string {
 	seen := make(map[edge]bool)
 	var edges []edge
 
-	for _, beans := range t.core {
-		for _, b := range beans {
-			fromName := beanGraphName(b)
-
-			for _, dep := range b.dependencies {
-				toName := beanGraphName(dep)
-				e := edge{from: fromName, to: toName}
-				if !seen[e] {
-					seen[e] = true
-					edges = append(edges, e)
-				}
-			}
-
-			for _, fd := range b.factoryDependencies {
-				if fd.factory != nil && fd.factory.bean != nil {
-					toName := beanGraphName(fd.factory.bean)
-					e := edge{from: fromName, to: toName}
-					if !seen[e] {
-						seen[e] = true
-						edges = append(edges, e)
-					}
-				}
-			}
+	t.forEachDependency(func(from, to *bean) {
+		e := edge{from: beanGraphName(from), to: beanGraphName(to)}
+		if !seen[e] {
+			seen[e] = true
+			edges = append(edges, e)
 		}
-	}
+	})
 
 	sort.Slice(edges, func(i, j int) bool {
 		if edges[i].from != edges[j].from {
@@ -57,6 +41,22 @@ func (t *container) Graph() string {
 		return edges[i].to < edges[j].to
 	})
 
+	var described []*bean
+	for _, beans := range t.core {
+		for _, b := range beans {
+			if b.description != "" {
+				described = append(described, b)
+			}
+		}
+	}
+	sort.Slice(described, func(i, j int) bool {
+		return beanGraphName(described[i]) < beanGraphName(described[j])
+	})
+
+	for _, b := range described {
+		sb.WriteString(fmt.Sprintf("    %q [tooltip=%q];\n", beanGraphName(b), b.description))
+	}
+
 	for _, e := range edges {
 		sb.WriteString(fmt.Sprintf("    %q -> %q;\n", e.from, e.to))
 	}
@@ -65,9 +65,288 @@ func (t *container) Graph() string {
 	return sb.String()
 }
 
+func (t *container) Describe() string {
+	var list []*bean
+	for _, beans := range t.core {
+		for _, b := range beans {
+			if b.name != "" {
+				list = append(list, b)
+			}
+		}
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return beanGraphName(list[i]) < beanGraphName(list[j])
+	})
+	var sb strings.Builder
+	if len(t.labels) > 0 {
+		sb.WriteString(fmt.Sprintf("# %s\n", t.labels))
+	}
+	for _, b := range list {
+		sb.WriteString(fmt.Sprintf("%s %v [%s]", beanGraphName(b), b.beanDef.classPtr, b.lifecycle.String()))
+		if b.description != "" {
+			sb.WriteString(" - ")
+			sb.WriteString(b.description)
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+/**
+Visits dependencies and factory dependencies of beans in the current container
+*/
+
+func (t *container) forEachDependency(cb func(from, to *bean)) {
+	for _, beans := range t.core {
+		for _, b := range beans {
+			for _, dep := range b.dependencies {
+				cb(b, dep)
+			}
+			for _, fd := range b.factoryDependencies {
+				if fd.factory != nil && fd.factory.bean != nil {
+					cb(b, fd.factory.bean)
+				}
+			}
+		}
+	}
+}
+
 func beanGraphName(b *bean) string {
 	if b.qualifier != "" {
 		return b.qualifier
 	}
 	return b.name
 }
+
+// GraphSchema is the version of the JSON document produced by BeanGraph.JSON, changed only on incompatible changes.
+const GraphSchema = "glue.graph/v1"
+
+/**
+BeanGraph is the dependency graph of the container returned by Container.DependencyGraph,
+nodes are beans and edges are injection points.
+*/
+
+type BeanGraph struct {
+	Schema string          `json:"schema"`
+	Labels Labels          `json:"labels,omitempty"`
+	Nodes  []BeanNode      `json:"nodes"`
+	Edges  []InjectionEdge `json:"edges"`
+}
+
+/**
+BeanNode is the bean of the graph, Metadata has optional keys
+'qualifier', 'aliases', 'primary', 'order', 'concrete', 'factory' and 'sandbox'.
+*/
+
+type BeanNode struct {
+	Name        string            `json:"name"`
+	Type        string            `json:"type"`
+	Lifecycle   string            `json:"lifecycle"`
+	Description string            `json:"description,omitempty"`
+	External    bool              `json:"external,omitempty"` // bean of the parent container
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+/**
+EdgeKind classifies injection points: lazy and optional take precedence over collection.
+*/
+
+type EdgeKind string
+
+const (
+	EdgeInject     EdgeKind = "inject"
+	EdgeOptional   EdgeKind = "optional"
+	EdgeLazy       EdgeKind = "lazy"
+	EdgeCollection EdgeKind = "collection"
+)
+
+type InjectionEdge struct {
+	From     string   `json:"from" yaml:"from"`
+	To       string   `json:"to" yaml:"to"`
+	Field    string   `json:"field" yaml:"field"`
+	Kind     EdgeKind `json:"kind" yaml:"kind"`
+	Lazy     bool     `json:"lazy,omitempty" yaml:"lazy,omitempty"`
+	Optional bool     `json:"optional,omitempty" yaml:"optional,omitempty"`
+	Scope    string   `json:"scope,omitempty" yaml:"scope,omitempty"` // scope of the injected provider, empty for singletons
+}
+
+type injectedBean struct {
+	def *injectionDef
+	to  *bean
+}
+
+func (t *bean) recordInjected(def *injectionDef, to *bean) {
+	t.injectedBeans = append(t.injectedBeans, injectedBean{def: def, to: to})
+}
+
+func (t *container) DependencyGraph() *BeanGraph {
+	graph := &BeanGraph{Schema: GraphSchema, Labels: t.Labels()}
+	nodes := make(map[*bean]bool)
+
+	addNode := func(b *bean, external bool) {
+		if nodes[b] {
+			return
+		}
+		nodes[b] = true
+		node := BeanNode{
+			Name:        beanGraphName(b),
+			Lifecycle:   b.lifecycle.String(),
+			Description: b.description,
+			External:    external,
+			Metadata:    beanMetadata(b),
+		}
+		if b.beanDef != nil {
+			node.Type = b.beanDef.classPtr.String()
+		} else if b.obj != nil {
+			node.Type = reflect.TypeOf(b.obj).String()
+		}
+		graph.Nodes = append(graph.Nodes, node)
+	}
+
+	var local []*bean
+	for _, beans := range t.core {
+		for _, b := range beans {
+			if !nodes[b] {
+				addNode(b, false)
+				local = append(local, b)
+			}
+		}
+	}
+
+	for _, b := range local {
+		for _, in := range b.injectedBeans {
+			to := in.to
+			if to.beenFactory != nil && to.beenFactory.bean != nil {
+				to = to.beenFactory.bean
+			}
+			if !nodes[to] {
+				addNode(to, true)
+			}
+			edge := InjectionEdge{
+				From:     beanGraphName(b),
+				To:       beanGraphName(to),
+				Field:    in.def.fieldName,
+				Kind:     edgeKind(in.def),
+				Lazy:     in.def.lazy,
+				Optional: in.def.optional,
+			}
+			if in.def.scope != ScopeSingleton {
+				edge.Scope = in.def.scope.String()
+			}
+			graph.Edges = append(graph.Edges, edge)
+		}
+	}
+
+	sort.SliceStable(graph.Nodes, func(i, j int) bool {
+		a, b := graph.Nodes[i], graph.Nodes[j]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Type < b.Type
+	})
+	sort.SliceStable(graph.Edges, func(i, j int) bool {
+		a, b := graph.Edges[i], graph.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.Field != b.Field {
+			return a.Field < b.Field
+		}
+		return a.To < b.To
+	})
+	return graph
+}
+
+func edgeKind(def *injectionDef) EdgeKind {
+	switch {
+	case def.lazy:
+		return EdgeLazy
+	case def.optional:
+		return EdgeOptional
+	case def.isSlice || def.isMap:
+		return EdgeCollection
+	default:
+		return EdgeInject
+	}
+}
+
+func beanMetadata(b *bean) map[string]string {
+	m := make(map[string]string)
+	if b.qualifier != "" {
+		m["qualifier"] = b.qualifier
+	}
+	if len(b.aliases) > 0 {
+		m["aliases"] = strings.Join(b.aliases, ",")
+	}
+	if b.primary {
+		m["primary"] = "true"
+	}
+	if b.ordered {
+		m["order"] = fmt.Sprintf("%d", b.order)
+	}
+	if b.concreteOnly {
+		m["concrete"] = "true"
+	}
+	if b.beenFactory != nil {
+		m["factory"] = b.beenFactory.factoryClassPtr.String()
+	}
+	if b.sandbox != nil {
+		m["sandbox"] = "true"
+	}
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
+
+/**
+DOT renders the graph in Graphviz format, nodes are labeled with the lifecycle,
+edges with the field name, lazy edges are dashed and optional edges have the hollow arrow.
+*/
+
+func (t *BeanGraph) DOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph glue {\n")
+	sb.WriteString("    rankdir=LR;\n")
+	if len(t.Labels) > 0 {
+		sb.WriteString(fmt.Sprintf("    label=%q;\n", t.Labels.String()))
+	}
+	for _, n := range t.Nodes {
+		attrs := []string{fmt.Sprintf("label=%q", n.Name+"\n"+n.Lifecycle)}
+		if n.Description != "" {
+			attrs = append(attrs, fmt.Sprintf("tooltip=%q", n.Description))
+		}
+		if n.External {
+			attrs = append(attrs, "style=dashed")
+		}
+		sb.WriteString(fmt.Sprintf("    %q [%s];\n", n.Name, strings.Join(attrs, ", ")))
+	}
+	for _, e := range t.Edges {
+		label := e.Field
+		if e.Scope != "" {
+			label += " (" + e.Scope + ")"
+		}
+		attrs := []string{fmt.Sprintf("label=%q", label)}
+		if e.Lazy {
+			attrs = append(attrs, "style=dashed")
+		}
+		if e.Optional {
+			attrs = append(attrs, "arrowhead=onormal")
+		}
+		if e.Kind == EdgeCollection {
+			attrs = append(attrs, "arrowhead=crow")
+		}
+		sb.WriteString(fmt.Sprintf("    %q -> %q [%s];\n", e.From, e.To, strings.Join(attrs, ", ")))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+/**
+JSON renders the graph as indented JSON document of the GraphSchema version with 'nodes' and 'edges',
+nodes and edges are sorted, so documents of the same wiring are equal.
+*/
+
+func (t *BeanGraph) JSON() ([]byte, error) {
+	return json.MarshalIndent(t, "", "  ")
+}
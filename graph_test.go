@@ -6,6 +6,7 @@
 package glue_test
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
@@ -106,3 +107,90 @@ func TestGraph_MultipleDependencies(t *testing.T) {
 	// B depends on C
 	require.True(t, strings.Contains(dot, "\"*glue_test.graphServiceB\" -> \"*glue_test.graphServiceC\""))
 }
+
+type graphAnnotated struct {
+	B *graphServiceB `inject:"lazy"`
+	C *graphServiceC `inject:"optional"`
+	D *graphServiceA `inject:"optional"`
+}
+
+func TestDependencyGraph(t *testing.T) {
+	b := &graphServiceB{}
+	c := &graphServiceC{}
+
+	ctx, err := glue.New(&graphAnnotated{}, b, c)
+	require.NoError(t, err)
+	defer ctx.Close()
+
+	graph := ctx.DependencyGraph()
+
+	var edges []glue.InjectionEdge
+	for _, e := range graph.Edges {
+		if e.From == "*glue_test.graphAnnotated" {
+			edges = append(edges, e)
+		}
+	}
+	require.Equal(t, []glue.InjectionEdge{
+		{From: "*glue_test.graphAnnotated", To: "*glue_test.graphServiceB", Field: "B", Kind: glue.EdgeLazy, Lazy: true},
+		{From: "*glue_test.graphAnnotated", To: "*glue_test.graphServiceC", Field: "C", Kind: glue.EdgeOptional, Optional: true},
+	}, edges)
+
+	var node glue.BeanNode
+	for _, n := range graph.Nodes {
+		if n.Name == "*glue_test.graphServiceC" {
+			node = n
+		}
+	}
+	require.Equal(t, "*glue_test.graphServiceC", node.Type)
+	require.Equal(t, glue.BeanInitialized.String(), node.Lifecycle)
+
+	dot := graph.DOT()
+	require.Contains(t, dot, `"*glue_test.graphAnnotated" -> "*glue_test.graphServiceB" [label="B", style=dashed];`)
+	require.Contains(t, dot, `"*glue_test.graphAnnotated" -> "*glue_test.graphServiceC" [label="C", arrowhead=onormal];`)
+
+	data, err := graph.JSON()
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"lazy": true`)
+
+	var decoded glue.BeanGraph
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, graph.Edges, decoded.Edges)
+}
+
+type graphCollector struct {
+	All []*graphServiceC `inject:""`
+}
+
+func TestDependencyGraphSchema(t *testing.T) {
+
+	ctx, err := glue.New(&graphCollector{}, glue.Alias(glue.Primary(&graphServiceC{}), "clock"))
+	require.NoError(t, err)
+	defer ctx.Close()
+
+	graph := ctx.DependencyGraph()
+	require.Equal(t, glue.GraphSchema, graph.Schema)
+
+	var edge glue.InjectionEdge
+	for _, e := range graph.Edges {
+		if e.From == "*glue_test.graphCollector" {
+			edge = e
+		}
+	}
+	require.Equal(t, glue.EdgeCollection, edge.Kind)
+	require.Equal(t, "All", edge.Field)
+
+	for _, n := range graph.Nodes {
+		if n.Name == "*glue_test.graphServiceC" {
+			require.Equal(t, map[string]string{"aliases": "clock", "primary": "true"}, n.Metadata)
+		}
+	}
+
+	data, err := graph.JSON()
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"schema": "glue.graph/v1"`)
+	require.Contains(t, string(data), `"kind": "collection"`)
+
+	again, err := ctx.DependencyGraph().JSON()
+	require.NoError(t, err)
+	require.Equal(t, string(data), string(again))
+}
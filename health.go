@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultHealthTimeout bounds each health check, the check exceeding it is reported as down.
+var DefaultHealthTimeout = 5 * time.Second
+
+// HealthEnabledProperty turns on the HealthHandler bean.
+var HealthEnabledProperty = "health.enabled"
+
+type HealthStatus string
+
+const (
+	HealthUp   HealthStatus = "UP"
+	HealthDown HealthStatus = "DOWN"
+)
+
+/**
+BeanHealth is the result of the health check of the bean.
+*/
+
+type BeanHealth struct {
+	Name    string        `json:"name"`
+	Status  HealthStatus  `json:"status"`
+	Latency time.Duration `json:"latency"`
+	Error   string        `json:"error,omitempty"`
+}
+
+/**
+HealthReport aggregates health checks of beans sorted by name, the status is down if any check failed.
+*/
+
+type HealthReport struct {
+	Status HealthStatus `json:"status"`
+	Beans  []BeanHealth `json:"beans"`
+}
+
+func (t HealthReport) Healthy() bool {
+	return t.Status == HealthUp
+}
+
+func (t HealthReport) String() string {
+	s := string(t.Status)
+	for _, b := range t.Beans {
+		if b.Error != "" {
+			s += fmt.Sprintf("\n%s %s %v: %s", b.Name, b.Status, b.Latency, b.Error)
+		} else {
+			s += fmt.Sprintf("\n%s %s %v", b.Name, b.Status, b.Latency)
+		}
+	}
+	return s
+}
+
+func (t *container) Health() HealthReport {
+	return t.HealthWithContext(context.Background())
+}
+
+/**
+Runs checks of initialized HealthChecker beans of the container and its live extended containers concurrently
+*/
+
+func (t *container) HealthWithContext(ctx context.Context) HealthReport {
+	var checkers []*bean
+	visited := make(map[*bean]bool)
+	t.forEachLiveBean(func(b *bean) {
+		if _, ok := b.obj.(HealthChecker); ok && b.lifecycle == BeanInitialized && !visited[b] {
+			visited[b] = true
+			checkers = append(checkers, b)
+		}
+	})
+
+	report := HealthReport{Status: HealthUp, Beans: make([]BeanHealth, len(checkers))}
+	var wg sync.WaitGroup
+	for i, b := range checkers {
+		wg.Add(1)
+		go func(i int, b *bean) {
+			defer wg.Done()
+			report.Beans[i] = checkHealth(ctx, beanGraphName(b), b.obj.(HealthChecker))
+		}(i, b)
+	}
+	wg.Wait()
+
+	for _, b := range report.Beans {
+		if b.Status != HealthUp {
+			report.Status = HealthDown
+		}
+	}
+	sort.SliceStable(report.Beans, func(i, j int) bool {
+		return report.Beans[i].Name < report.Beans[j].Name
+	})
+	return report
+}
+
+func checkHealth(ctx context.Context, name string, checker HealthChecker) (health BeanHealth) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultHealthTimeout)
+	defer cancel()
+
+	health = BeanHealth{Name: name, Status: HealthUp}
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("health check recovered with error: %v", r)
+			}
+		}()
+		done <- checker.CheckHealth(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = fmt.Errorf("health check did not complete: %w", ctx.Err())
+	}
+	health.Latency = time.Since(start)
+	if err != nil {
+		health.Status = HealthDown
+		health.Error = err.Error()
+	}
+	return health
+}
+
+/**
+HealthHandler serves the health report of the container in JSON, 200 when all checks pass and 503 otherwise.
+It is registered only if property 'health.enabled' is true, mount it in the HTTP server by HandlerPattern().
+*/
+
+type HealthHandler struct {
+	Container Container `inject:""`
+	Path      string    `value:"health.path,default=/health"`
+}
+
+func NewHealthHandler() *HealthHandler {
+	return &HealthHandler{}
+}
+
+func (t *HealthHandler) ShouldRegisterWithProperties(properties Properties) bool {
+	return properties.GetBool(HealthEnabledProperty, false)
+}
+
+func (t *HealthHandler) HandlerPattern() string {
+	return t.Path
+}
+
+func (t *HealthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	report := t.Container.HealthWithContext(r.Context())
+	w.Header().Set("Content-Type", "application/json")
+	if !report.Healthy() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(report)
+}
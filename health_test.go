@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type healthyDB struct{}
+
+func (t *healthyDB) BeanName() string { return "db" }
+
+func (t *healthyDB) CheckHealth(ctx context.Context) error { return nil }
+
+type brokenBroker struct {
+	err error
+}
+
+func (t *brokenBroker) BeanName() string { return "broker" }
+
+func (t *brokenBroker) CheckHealth(ctx context.Context) error {
+	if t.err == nil {
+		panic("not connected")
+	}
+	return t.err
+}
+
+func TestHealth(t *testing.T) {
+
+	broker := &brokenBroker{err: errors.New("connection refused")}
+	ctn, err := glue.New(
+		glue.PropertySource{Map: map[string]any{"health.enabled": true}},
+		glue.NewHealthHandler(),
+		&healthyDB{},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	report := ctn.Health()
+	require.True(t, report.Healthy())
+	require.Len(t, report.Beans, 1)
+	require.Equal(t, "db", report.Beans[0].Name)
+
+	child, err := ctn.Extend(broker)
+	require.NoError(t, err)
+	defer child.Close()
+
+	report = ctn.Health()
+	require.False(t, report.Healthy())
+	require.Equal(t, []string{"broker", "db"}, []string{report.Beans[0].Name, report.Beans[1].Name})
+	require.Equal(t, glue.HealthDown, report.Beans[0].Status)
+	require.Equal(t, "connection refused", report.Beans[0].Error)
+
+	handler, err := glue.GetBean[*glue.HealthHandler](ctn)
+	require.NoError(t, err)
+	require.Equal(t, "/health", handler.HandlerPattern())
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	var body glue.HealthReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, glue.HealthDown, body.Status)
+
+	broker.err = nil
+	report = ctn.Health()
+	require.Contains(t, report.Beans[0].Error, "not connected")
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+/**
+Default number of events kept by the container history
+*/
+
+var DefaultHistorySize = 1024
+
+/**
+HistoryEvent is the lifecycle transition of the bean or the container event.
+Bean is empty for container events.
+*/
+
+type HistoryEvent struct {
+	Time    time.Time
+	Bean    string
+	BeanID  uint64 // unique in the process, distinguishes beans with the same name
+	From    BeanLifecycle
+	To      BeanLifecycle
+	Message string
+	Err     error
+	Labels  Labels // labels of the container, shared by events and must not be modified
+}
+
+func (t HistoryEvent) String() string {
+	var s string
+	if t.Bean != "" {
+		s = fmt.Sprintf("%s bean '%s' %s -> %s", t.Time.Format(time.RFC3339Nano), t.Bean, t.From, t.To)
+	} else {
+		s = fmt.Sprintf("%s %s", t.Time.Format(time.RFC3339Nano), t.Message)
+	}
+	if t.Err != nil {
+		s = fmt.Sprintf("%s: %v", s, t.Err)
+	}
+	if len(t.Labels) > 0 {
+		s = fmt.Sprintf("%s {%s}", s, t.Labels)
+	}
+	return s
+}
+
+/**
+History is the bounded ring of events, the oldest events are overwritten when it is full.
+Pass it with glue.WithHistory to keep events of the container that failed to start.
+*/
+
+type History struct {
+	mu     sync.Mutex
+	events []HistoryEvent
+	next   int
+	full   bool
+}
+
+func NewHistory(size int) *History {
+	if size <= 0 {
+		size = DefaultHistorySize
+	}
+	return &History{
+		events: make([]HistoryEvent, size),
+	}
+}
+
+func (t *History) Record(event HistoryEvent) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.events[t.next] = event
+	t.next++
+	if t.next == len(t.events) {
+		t.next = 0
+		t.full = true
+	}
+}
+
+/**
+Returns events from the oldest to the newest
+*/
+
+func (t *History) Events() []HistoryEvent {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.full {
+		return append([]HistoryEvent(nil), t.events[:t.next]...)
+	}
+	list := make([]HistoryEvent, 0, len(t.events))
+	list = append(list, t.events[t.next:]...)
+	return append(list, t.events[:t.next]...)
+}
+
+/**
+Replay calls the function for each event from the oldest to the newest
+*/
+
+func (t *History) Replay(cb func(HistoryEvent)) {
+	for _, event := range t.Events() {
+		cb(event)
+	}
+}
+
+func (t *container) recordEvent(message string, err error) {
+	t.history.Record(HistoryEvent{Message: message, Err: err, Labels: t.labels})
+}
+
+func (t *container) recordTransition(b *bean, from, to BeanLifecycle) {
+	if from != to {
+		t.history.Record(HistoryEvent{Bean: beanGraphName(b), BeanID: b.uniqueID(), From: from, To: to, Labels: t.labels})
+		t.timings.transition(b, to)
+		for _, hook := range t.hooks {
+			t.callHook(hook, b, from, to)
+		}
+		t.notifyTransition(b, from, to)
+	}
+}
+
+func (t *container) setLifecycle(b *bean, to BeanLifecycle) {
+	t.recordTransition(b, b.lifecycle, to)
+	b.lifecycle = to
+}
+
+func (t *container) History() []HistoryEvent {
+	return t.history.Events()
+}
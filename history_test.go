@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type historyBean struct {
+}
+
+func (t *historyBean) PostConstruct() error {
+	return nil
+}
+
+func (t *historyBean) Destroy() error {
+	return nil
+}
+
+type historyFailingBean struct {
+}
+
+func (t *historyFailingBean) PostConstruct() error {
+	return errors.New("boom")
+}
+
+func TestHistoryRecordsTransitions(t *testing.T) {
+
+	ctn, err := glue.New(&historyBean{})
+	require.NoError(t, err)
+
+	require.NoError(t, ctn.Close())
+
+	var transitions []string
+	var messages []string
+	for _, e := range ctn.History() {
+		require.False(t, e.Time.IsZero())
+		if e.Bean == "*glue_test.historyBean" {
+			transitions = append(transitions, e.To.String())
+		} else if e.Bean == "" {
+			messages = append(messages, e.Message)
+		}
+	}
+
+	require.Equal(t, []string{"BeanConstructing", "BeanInitialized", "BeanDestroying", "BeanDestroyed"}, transitions)
+	require.Equal(t, []string{"container creation started", "container created", "container closing", "container closed"}, messages)
+}
+
+func TestHistoryKeptForFailedStartup(t *testing.T) {
+
+	history := glue.NewHistory(0)
+	_, err := glue.NewWithOptions(glue.WithHistory(history), glue.WithBeans(&historyFailingBean{}))
+	require.Error(t, err)
+
+	events := history.Events()
+	require.NotEmpty(t, events)
+	last := events[len(events)-1]
+	require.Equal(t, "container creation failed", last.Message)
+	require.Error(t, last.Err)
+	require.Contains(t, last.String(), "boom")
+
+	var replayed int
+	history.Replay(func(glue.HistoryEvent) {
+		replayed++
+	})
+	require.Equal(t, len(events), replayed)
+}
+
+func TestHistoryRing(t *testing.T) {
+
+	history := glue.NewHistory(3)
+	for i := 0; i < 5; i++ {
+		history.Record(glue.HistoryEvent{Message: string(rune('a' + i))})
+	}
+
+	var list []string
+	for _, e := range history.Events() {
+		list = append(list, e.Message)
+	}
+	require.Equal(t, []string{"c", "d", "e"}, list)
+}
@@ -33,9 +33,13 @@ type injectionDef struct {
 	*/
 	class reflect.Type
 	/*
-		Field number of that struct
+		Field number of that struct, unique number after the struct fields for fields of embedded structs
 	*/
 	fieldNum int
+	/*
+		Index path of the field of the embedded struct, nil for fields of that struct
+	*/
+	index []int
 	/*
 		Field name where injection is going to be happen
 	*/
@@ -60,6 +64,10 @@ type injectionDef struct {
 		Optional injection
 	*/
 	optional bool
+	/*
+		Field is glue.Optional[T], func() (T, bool) or glue.Provider[T] resolved on call, fieldType is T
+	*/
+	getter bool
 	/*
 		Injection expects the specific bean to be injected
 	*/
@@ -90,6 +98,14 @@ type injectionDef struct {
 		scopeReturnType is the T in func() (T, error) or func(context.Context) (T, error).
 	*/
 	scopeReturnType reflect.Type
+	/*
+		funcField is true for func fields bound to the method of the bean
+	*/
+	funcField bool
+	/*
+		method is the name of the bound method, empty to match the only method of the bean with the field signature
+	*/
+	method string
 }
 
 type injection struct {
@@ -124,10 +140,15 @@ type propInjectionDef struct {
 	class reflect.Type
 
 	/*
-		Field number of that struct
+		Field number of that struct, unique number after the struct fields for fields of embedded structs
 	*/
 	fieldNum int
 
+	/*
+		Index path of the field of the embedded struct, nil for fields of that struct
+	*/
+	index []int
+
 	/*
 		Field name where injection is going to be happen
 	*/
@@ -163,6 +184,16 @@ type propInjectionDef struct {
 	*/
 	isMapPrefix bool
 
+	/*
+		path is true when '~' and environment variables in the value are expanded, value:"dir,path=true"
+	*/
+	path bool
+
+	/*
+		expression is true when the value tag is "#{...}", propertyName holds the expression body
+	*/
+	expression bool
+
 	/*
 		dynamic is true when the field type is a function — property is resolved lazily on each call
 	*/
@@ -225,33 +256,35 @@ func levelBeans(deep []beanlist, level int) []*bean {
 
 /*
 *
-Order beans, all or partially
+Order beans: ordered beans first by BeanOrder, then unordered ones,
+ties are broken by registration order and then by name, so beans of parents go before beans of children.
+The list is copied only if it is not sorted yet, since candidates are shared by lookups.
 */
 func orderBeans(candidates []*bean) []*bean {
-	var ordered []*bean
-	for _, candidate := range candidates {
-		if candidate.ordered {
-			ordered = append(ordered, candidate)
+	less := func(list []*bean) func(i, j int) bool {
+		return func(i, j int) bool {
+			return beanLess(list[i], list[j])
 		}
 	}
-	n := len(ordered)
-	if n > 0 {
-		sort.Slice(ordered, func(i, j int) bool {
-			return ordered[i].order < ordered[j].order
-		})
-		if n != len(candidates) {
-			var unordered []*bean
-			for _, candidate := range candidates {
-				if !candidate.ordered {
-					unordered = append(unordered, candidate)
-				}
-			}
-			return append(ordered, unordered...)
-		}
-		return ordered
-	} else {
+	if sort.SliceIsSorted(candidates, less(candidates)) {
 		return candidates
 	}
+	sorted := append([]*bean(nil), candidates...)
+	sort.SliceStable(sorted, less(sorted))
+	return sorted
+}
+
+func beanLess(a, b *bean) bool {
+	if a.ordered != b.ordered {
+		return a.ordered
+	}
+	if a.ordered && a.order != b.order {
+		return a.order < b.order
+	}
+	if a.seq != b.seq {
+		return a.seq < b.seq
+	}
+	return a.name < b.name
 }
 
 func selectSingleCandidate(fieldName string, class reflect.Type, list []*bean) (*bean, error) {
@@ -293,20 +326,21 @@ func (t *injection) inject(deep []beanlist) error {
 
 	list := orderBeans(levelBeans(deep, t.injectionDef.level))
 
-	field := t.value.Field(t.injectionDef.fieldNum)
+	field := t.injectionDef.field(t.value)
 	if !field.CanSet() {
-		return fmt.Errorf("field '%s' in class '%v' is not public", t.injectionDef.fieldName, t.injectionDef.class)
+		shadow, err := t.bean.setterField(t.value, t.injectionDef)
+		if err != nil {
+			return err
+		}
+		field = shadow
 	}
 
+	candidates := list
 	list = t.injectionDef.filterBeans(list)
 
 	if len(list) == 0 {
 		if !t.injectionDef.optional {
-			if t.injectionDef.qualifier != "" {
-				return fmt.Errorf("can not find candidates to inject the required field '%s' in class '%v' with qualifier '%s'", t.injectionDef.fieldName, t.injectionDef.class, t.injectionDef.qualifier)
-			} else {
-				return fmt.Errorf("can not find candidates to inject the required field '%s' in class '%v'", t.injectionDef.fieldName, t.injectionDef.class)
-			}
+			return t.injectionDef.missing(candidates)
 		}
 		return nil
 	}
@@ -317,10 +351,20 @@ func (t *injection) inject(deep []beanlist) error {
 		if err != nil {
 			return err
 		}
+		t.bean.recordInjected(t.injectionDef, impl)
+		if !hasProviders {
+			return requireFeature(FeatureProviders)
+		}
 		t.injectionDef.injectScopeProvider(field, impl, t.ctn)
 		return nil
 	}
 
+	if t.injectionDef.isSlice || t.injectionDef.isMap {
+		for _, impl := range list {
+			t.bean.recordInjected(t.injectionDef, impl)
+		}
+	}
+
 	if t.injectionDef.isSlice {
 
 		newSlice := field
@@ -396,6 +440,7 @@ func (t *injection) inject(deep []beanlist) error {
 	if err != nil {
 		return err
 	}
+	t.bean.recordInjected(t.injectionDef, impl)
 
 	if impl.beenFactory != nil {
 		if t.injectionDef.lazy {
@@ -430,18 +475,53 @@ func atomicSet(field reflect.Value, instance reflect.Value) {
 	atomic.StoreUintptr((*uintptr)(unsafe.Pointer(field.Addr().Pointer())), instance.Pointer())
 }
 
-// runtime injection
-func (t *injectionDef) inject(value *reflect.Value, deep []beanlist) error {
+/**
+Returns the field of the struct value, also of the embedded struct
+*/
+
+func (t *injectionDef) field(value reflect.Value) reflect.Value {
+	if t.index != nil {
+		return value.FieldByIndex(t.index)
+	}
+	return value.Field(t.fieldNum)
+}
+
+func (t *propInjectionDef) field(value reflect.Value) reflect.Value {
+	if t.index != nil {
+		return value.FieldByIndex(t.index)
+	}
+	return value.Field(t.fieldNum)
+}
+
+// runtime injection, beans produced by factories for map fields are constructed by ctn with ctx
+func (t *injectionDef) inject(ctx context.Context, ctn *container, value *reflect.Value, deep []beanlist) error {
 
 	list := orderBeans(levelBeans(deep, t.level))
 
-	field := value.Field(t.fieldNum)
+	field := t.field(*value)
 
 	if !field.CanSet() {
-		return fmt.Errorf("field '%s' in class '%v' is not public", t.fieldName, t.class)
+		if !hasSetters {
+			return requireFeature(FeatureSetters)
+		}
+		if !value.CanAddr() {
+			return notPublicErr(t.fieldName, t.class)
+		}
+		set, ok := findSetter(value.Addr(), t.fieldName, field.Type())
+		if !ok {
+			return notPublicErr(t.fieldName, t.class)
+		}
+		shadow := reflect.New(field.Type()).Elem()
+		if err := t.injectField(ctx, ctn, shadow, t.filterBeans(list)); err != nil {
+			return err
+		}
+		return set(shadow)
 	}
 
-	list = t.filterBeans(list)
+	return t.injectField(ctx, ctn, field, t.filterBeans(list))
+}
+
+func (t *injectionDef) injectField(ctx context.Context, ctn *container, field reflect.Value, list []*bean) error {
 
 	if len(list) == 0 {
 		if !t.optional {
@@ -474,13 +554,28 @@ func (t *injectionDef) inject(value *reflect.Value, deep []beanlist) error {
 
 		visited := make(map[string]bool)
 		for _, instance := range list {
-			if !instance.valuePtr.IsValid() {
-				if visited[instance.name] {
-					return fmt.Errorf("can not inject duplicates '%s' to the map field '%s' in class '%v'", instance.name, t.fieldName, t.class)
+			if instance.beenFactory != nil {
+				// prototype beans are created again on every injection
+				again := instance.lifecycle == BeanInitialized && !instance.beenFactory.singleton()
+				if err := ctn.constructBean(ctx, instance, nil); err != nil {
+					return fmt.Errorf("map field '%s' in class '%v' can not be injected because of factory bean %+v error: %w", t.fieldName, t.class, instance, err)
+				}
+				if again {
+					service, _, err := instance.beenFactory.ctor(ctx)
+					if err != nil {
+						return fmt.Errorf("map field '%s' in class '%v' can not be injected because of factory bean %+v error: %w", t.fieldName, t.class, instance, err)
+					}
+					instance = service
 				}
-				visited[instance.name] = true
-				field.SetMapIndex(reflect.ValueOf(instance.name), instance.valuePtr)
 			}
+			if !instance.valuePtr.IsValid() {
+				continue
+			}
+			if visited[instance.name] {
+				return fmt.Errorf("can not inject duplicates '%s' to the map field '%s' in class '%v'", instance.name, t.fieldName, t.class)
+			}
+			visited[instance.name] = true
+			field.SetMapIndex(reflect.ValueOf(instance.name), instance.valuePtr)
 		}
 
 		return nil
@@ -514,7 +609,7 @@ func (t *injectionDef) filterBeans(list []*bean) []*bean {
 	if t.qualifier != "" {
 		var candidates []*bean
 		for _, b := range list {
-			if t.qualifier == b.name {
+			if b.hasName(t.qualifier) {
 				candidates = append(candidates, b)
 			}
 		}
@@ -540,9 +635,9 @@ func (t *injectionDef) String() string {
 }
 
 // runtime injection
-func (t *propInjectionDef) inject(value *reflect.Value, properties Properties) error {
+func (t *propInjectionDef) inject(value *reflect.Value, properties Properties, defaults *ValueDefaults) error {
 
-	field := value.Field(t.fieldNum)
+	field := t.field(*value)
 
 	if !field.CanSet() {
 		return fmt.Errorf("field '%s' in class '%v' is not public", t.fieldName, t.class)
@@ -553,11 +648,22 @@ func (t *propInjectionDef) inject(value *reflect.Value, properties Properties) e
 	}
 
 	if t.dynamic {
-		return t.injectDynamic(field, properties)
+		return t.injectDynamic(field, properties, defaults)
 	}
 
 	var strValue string
-	if value, ok, err := properties.Resolve(t.propertyName); err != nil {
+	if t.expression {
+		value, err := evalExpression(t.propertyName, properties)
+		if err != nil {
+			if !t.hasDefaultValue {
+				return fmt.Errorf("property '%s' in class '%v' expression error: %w", t.fieldName, t.class, err)
+			}
+			if value, err = properties.ResolveText(t.defaultValue); err != nil {
+				return fmt.Errorf("property '%s' in class '%v' default resolution error, property resolvers %+v: %w", t.fieldName, t.class, properties.PropertyResolvers(), err)
+			}
+		}
+		strValue = value
+	} else if value, ok, err := properties.Resolve(t.propertyName); err != nil {
 		return fmt.Errorf("property '%s' in class '%v' resolution error, property resolvers %+v: %w", t.fieldName, t.class, properties.PropertyResolvers(), err)
 	} else if ok {
 		strValue = value
@@ -567,11 +673,17 @@ func (t *propInjectionDef) inject(value *reflect.Value, properties Properties) e
 			return fmt.Errorf("property '%s' in class '%v' default resolution error, property resolvers %+v: %w", t.fieldName, t.class, properties.PropertyResolvers(), err)
 		}
 		strValue = value
+	} else if defaults.AllowMissing {
+		return nil
 	} else {
 		return fmt.Errorf("property '%s' in class '%v' does not have the default value, and did not find in property resolvers %+v", t.fieldName, t.class, properties.PropertyResolvers())
 	}
 
-	v, err := convertProperty(strValue, t.fieldType, t.timeFormat)
+	if t.path {
+		strValue = expandPaths(strValue, t.fieldType, defaults.Separator)
+	}
+
+	v, err := convertProperty(strValue, t.fieldType, t.layout(defaults), defaults.Separator)
 	if err != nil {
 		return fmt.Errorf("property '%s' in class '%v' has convert error, property resolvers %+v: %w", t.fieldName, t.class, properties.PropertyResolvers(), err)
 	}
@@ -616,15 +728,27 @@ func (t *propInjectionDef) injectMapPrefix(field reflect.Value, properties Prope
 	return nil
 }
 
-func (t *propInjectionDef) injectDynamic(field reflect.Value, properties Properties) error {
+func (t *propInjectionDef) injectDynamic(field reflect.Value, properties Properties, defaults *ValueDefaults) error {
+	if !hasProviders {
+		return requireFeature(FeatureProviders)
+	}
 	propertyName := t.propertyName
 	defaultValue := t.defaultValue
 	hasDefaultValue := t.hasDefaultValue
-	timeFormat := t.timeFormat
+	timeFormat := t.layout(defaults)
+	separator := defaults.Separator
+	allowMissing := defaults.AllowMissing
 	returnType := t.funcReturnType
+	expression := t.expression
 
 	resolve := func() (string, bool, error) {
-		if val, ok, err := properties.Resolve(propertyName); err != nil {
+		if expression {
+			if val, err := evalExpression(propertyName, properties); err == nil {
+				return val, true, nil
+			} else if !hasDefaultValue {
+				return "", false, err
+			}
+		} else if val, ok, err := properties.Resolve(propertyName); err != nil {
 			return "", false, err
 		} else if ok {
 			return val, true, nil
@@ -640,7 +764,10 @@ func (t *propInjectionDef) injectDynamic(field reflect.Value, properties Propert
 	}
 
 	convert := func(s string) (reflect.Value, error) {
-		return convertProperty(s, returnType, timeFormat)
+		if t.path {
+			s = expandPaths(s, returnType, separator)
+		}
+		return convertProperty(s, returnType, timeFormat, separator)
 	}
 
 	zeroReturn := reflect.Zero(returnType)
@@ -657,6 +784,9 @@ func (t *propInjectionDef) injectDynamic(field reflect.Value, properties Propert
 				return []reflect.Value{zeroReturn, reflect.ValueOf(err)}
 			}
 			if !ok {
+				if allowMissing {
+					return []reflect.Value{zeroReturn, zeroError}
+				}
 				return []reflect.Value{zeroReturn, reflect.ValueOf(fmt.Errorf("property '%s' not found and has no default value", propertyName))}
 			}
 			val, err := convert(str)
@@ -674,6 +804,9 @@ func (t *propInjectionDef) injectDynamic(field reflect.Value, properties Propert
 				return []reflect.Value{zeroReturn, reflect.ValueOf(err)}
 			}
 			if !ok {
+				if allowMissing {
+					return []reflect.Value{zeroReturn, zeroError}
+				}
 				return []reflect.Value{zeroReturn, reflect.ValueOf(fmt.Errorf("property '%s' not found and has no default value", propertyName))}
 			}
 			val, err := convert(str)
@@ -757,11 +890,14 @@ func (t *injectionDef) injectScopeProvider(field reflect.Value, scopedBean *bean
 }
 
 func getOrCreateRequestScope(scope RequestScope, typ reflect.Type, create func() (any, error)) (any, error) {
-	requestScope, ok := scope.(*requestScope)
-	if !ok {
+	switch s := scope.(type) {
+	case *requestScope:
+		return s.getOrCreate(typ, create)
+	case *scopedContext:
+		return s.getOrCreate(typ, create)
+	default:
 		return nil, fmt.Errorf("unsupported RequestScope implementation %T", scope)
 	}
-	return requestScope.getOrCreate(typ, create)
 }
 
 // createScopedInstance creates a new instance of the bean's type.
@@ -804,16 +940,29 @@ func createScopedInstance(ctx context.Context, ctn *container, b *bean) (any, er
 	return obj, nil
 }
 
-func convertProperty(s string, t reflect.Type, timeFormat string) (val reflect.Value, err error) {
+/*
+Time layout of the field tag, otherwise the container default
+*/
+func (t *propInjectionDef) layout(defaults *ValueDefaults) string {
+	if t.timeFormat != "" {
+		return t.timeFormat
+	}
+	return defaults.TimeLayout
+}
+
+func convertProperty(s string, t reflect.Type, timeFormat string, separator string) (val reflect.Value, err error) {
 	var v any
 
 	switch {
 
 	case isArray(t):
-		parts := trimSplit(s, ";")
+		if separator == "" {
+			separator = ";"
+		}
+		parts := trimSplit(s, separator)
 		slice := reflect.MakeSlice(t, 0, len(parts))
 		for _, s := range parts {
-			val, err := convertProperty(s, t.Elem(), timeFormat)
+			val, err := convertProperty(s, t.Elem(), timeFormat, separator)
 			if err != nil {
 				return slice, err
 			}
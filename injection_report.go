@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+/**
+InjectionRecord is the value injected in to the field of the bean, see WithInjectionReport.
+*/
+
+type InjectionRecord struct {
+	Bean     string
+	Field    string
+	Property string // property name or expression, empty for injected beans
+	Value    string
+}
+
+func (t InjectionRecord) String() string {
+	if t.Property != "" {
+		return fmt.Sprintf("%s.%s = %s (%s)", t.Bean, t.Field, t.Value, t.Property)
+	}
+	return fmt.Sprintf("%s.%s = %s", t.Bean, t.Field, t.Value)
+}
+
+func (t *container) Injections() []InjectionRecord {
+	return append([]InjectionRecord(nil), t.injections...)
+}
+
+/**
+Records fields of managed beans after PostConstruct, products of factories are filled by factories and skipped
+*/
+
+func (t *container) recordInjections() {
+	names := make(map[any]string)
+	for c := t; c != nil; c = c.parent {
+		for _, list := range c.core {
+			for _, b := range list {
+				if b.obj != nil && reflect.TypeOf(b.obj).Comparable() {
+					if _, ok := names[b.obj]; !ok {
+						names[b.obj] = beanGraphName(b)
+					}
+				}
+			}
+		}
+	}
+
+	var beans []*bean
+	for _, list := range t.core {
+		for _, b := range list {
+			if b.beenFactory != nil || b.beanDef == nil || b.obj == t {
+				continue
+			}
+			if !b.valuePtr.IsValid() || b.valuePtr.Kind() != reflect.Ptr || b.valuePtr.Elem().Kind() != reflect.Struct {
+				continue
+			}
+			beans = append(beans, b)
+		}
+	}
+	sort.SliceStable(beans, func(i, j int) bool {
+		return beanGraphName(beans[i]) < beanGraphName(beans[j])
+	})
+
+	var records []InjectionRecord
+	for _, b := range beans {
+		value := b.valuePtr.Elem()
+		for _, def := range b.beanDef.fields {
+			records = append(records, InjectionRecord{
+				Bean:  beanGraphName(b),
+				Field: def.fieldName,
+				Value: describeInjected(b.injectedField(value, def), names),
+			})
+		}
+		for _, def := range b.beanDef.properties {
+			records = append(records, InjectionRecord{
+				Bean:     beanGraphName(b),
+				Field:    def.fieldName,
+				Property: def.propertyName,
+				Value:    t.describeProperty(def, def.field(value)),
+			})
+		}
+	}
+	t.injections = records
+}
+
+func describeInjected(field reflect.Value, names map[any]string) string {
+	switch field.Kind() {
+	case reflect.Func:
+		if field.IsNil() {
+			return "<nil>"
+		}
+		return "<provider>"
+	case reflect.Slice:
+		list := make([]string, field.Len())
+		for i := range list {
+			list[i] = describeInjected(field.Index(i), names)
+		}
+		return "[" + strings.Join(list, ", ") + "]"
+	case reflect.Map:
+		var list []string
+		iter := field.MapRange()
+		for iter.Next() {
+			list = append(list, fmt.Sprintf("%v: %s", iter.Key().Interface(), describeInjected(iter.Value(), names)))
+		}
+		sort.Strings(list)
+		return "{" + strings.Join(list, ", ") + "}"
+	case reflect.Ptr, reflect.Interface:
+		if field.IsNil() {
+			return "<nil>"
+		}
+		obj := field.Interface()
+		if reflect.TypeOf(obj).Comparable() {
+			if name, ok := names[obj]; ok {
+				return name
+			}
+		}
+		return fmt.Sprintf("%T", obj)
+	default:
+		return fmt.Sprintf("%v", field.Interface())
+	}
+}
+
+func (t *container) describeProperty(def *propInjectionDef, field reflect.Value) string {
+	switch {
+	case def.dynamic:
+		return "<dynamic>"
+	case def.isMapPrefix:
+		var list []string
+		iter := field.MapRange()
+		for iter.Next() {
+			key := iter.Key().String()
+			list = append(list, fmt.Sprintf("%s=%s", key, t.properties.MaskValue(def.propertyName+"."+key, iter.Value().String())))
+		}
+		sort.Strings(list)
+		return "{" + strings.Join(list, ", ") + "}"
+	default:
+		return t.properties.MaskValue(def.propertyName, fmt.Sprintf("%v", field.Interface()))
+	}
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type reportStore struct {
+	URL      string `value:"store.url"`
+	Password string `value:"store.password"`
+}
+
+type reportService struct {
+	Store   *reportStore `inject:""`
+	Retries int          `value:"service.retries,default=3"`
+}
+
+func TestInjectionReport(t *testing.T) {
+
+	props := glue.NewProperties()
+	props.SetMaskPatterns("*.password")
+
+	ctn, err := glue.NewWithOptions(
+		glue.WithProperties(props),
+		glue.WithInjectionReport(),
+		glue.WithBeans(&reportService{}, &reportStore{}, glue.MapPropertySource{
+			"store.url":      "postgres://db",
+			"store.password": "secret",
+		}),
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	var lines []string
+	for _, r := range ctn.Injections() {
+		lines = append(lines, r.String())
+	}
+	require.Equal(t, []string{
+		"*glue_test.reportService.Store = *glue_test.reportStore",
+		"*glue_test.reportService.Retries = 3 (service.retries)",
+		"*glue_test.reportStore.URL = postgres://db (store.url)",
+		"*glue_test.reportStore.Password = ****** (store.password)",
+	}, lines)
+
+	plain, err := glue.New(&reportStore{}, glue.MapPropertySource{"store.url": "a", "store.password": "b"})
+	require.NoError(t, err)
+	defer plain.Close()
+	require.Empty(t, plain.Injections())
+}
@@ -0,0 +1,217 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrResourceIntegrity is returned by Open of the resource with the SHA-256 checksum different from the manifest.
+var ErrResourceIntegrity = errors.New("resource checksum mismatch")
+
+/**
+IntegrityMode defines what happens when the checksum of the resource does not match, see ResourceSource.Checksums.
+*/
+
+type IntegrityMode int
+
+const (
+	// IntegrityStrict fails Open of the resource, and the container creation if it is opened on startup
+	IntegrityStrict IntegrityMode = iota
+
+	// IntegrityWarn logs the mismatch and opens the resource
+	IntegrityWarn
+)
+
+func (t IntegrityMode) String() string {
+	switch t {
+	case IntegrityStrict:
+		return "IntegrityStrict"
+	case IntegrityWarn:
+		return "IntegrityWarn"
+	default:
+		return "IntegrityUnknown"
+	}
+}
+
+/**
+Resource verified against the SHA-256 checksum on every Open, the content is read and hashed before it is returned,
+so changes of the underlying content after the first Open are verified too
+*/
+
+type verifiedResource struct {
+	Resource
+	path string
+	sum  string
+	mode IntegrityMode
+	logf func(level LogLevel, format string, v ...any)
+
+	// last reported mismatching checksum, IntegrityWarn logs every change of content once
+	mu     sync.Mutex
+	warned string
+}
+
+func (t *verifiedResource) Open() (http.File, error) {
+	file, err := t.Resource.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	name, modTime := path.Base(t.path), time.Time{}
+	if fi, err := file.Stat(); err == nil {
+		name, modTime = fi.Name(), fi.ModTime()
+	}
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("i/o error with resource '%s': %w", t.path, err)
+	}
+	if err := t.check(content); err != nil {
+		if t.mode == IntegrityStrict {
+			return nil, err
+		}
+		t.warn(err)
+	}
+	return &renderedFile{Reader: bytes.NewReader(content), name: name, size: int64(len(content)), modTime: modTime}, nil
+}
+
+/**
+Opens the resource once to verify it, used by VerifyOnStart
+*/
+
+func (t *verifiedResource) verify() error {
+	file, err := t.Open()
+	if err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+func (t *verifiedResource) check(content []byte) error {
+	sum := sha256.Sum256(content)
+	if actual := hex.EncodeToString(sum[:]); actual != t.sum {
+		return &integrityError{path: t.path, actual: actual, expected: t.sum}
+	}
+	return nil
+}
+
+func (t *verifiedResource) warn(err error) {
+	actual := err.(*integrityError).actual
+	t.mu.Lock()
+	report := t.warned != actual
+	t.warned = actual
+	t.mu.Unlock()
+	if report && t.logf != nil {
+		t.logf(LogError, "Resource '%s' integrity warning: %v\n", t.path, err)
+	}
+}
+
+type integrityError struct {
+	path     string
+	actual   string
+	expected string
+}
+
+func (t *integrityError) Error() string {
+	return fmt.Sprintf("%v: resource '%s' has sha256 %s, expected %s", ErrResourceIntegrity, t.path, t.actual, t.expected)
+}
+
+func (t *integrityError) Unwrap() error {
+	return ErrResourceIntegrity
+}
+
+/**
+Returns checksums of the source by resource name, merged from Checksums and the Manifest file of the source
+*/
+
+func resourceChecksums(source *ResourceSource, files http.FileSystem) (map[string]string, error) {
+	if source.Manifest == "" && len(source.Checksums) == 0 {
+		return nil, nil
+	}
+	checksums := make(map[string]string)
+	if source.Manifest != "" {
+		if files == nil {
+			return nil, fmt.Errorf("resource source '%s' has manifest '%s' without files", source.Name, source.Manifest)
+		}
+		file, err := files.Open(source.Manifest)
+		if err != nil {
+			return nil, fmt.Errorf("i/o error with manifest '%s' of resource source '%s': %w", source.Manifest, source.Name, err)
+		}
+		err = parseChecksumManifest(file, checksums)
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("manifest '%s' of resource source '%s': %w", source.Manifest, source.Name, err)
+		}
+	}
+	for name, sum := range source.Checksums {
+		checksums[name] = strings.ToLower(sum)
+	}
+	for name, sum := range checksums {
+		if b, err := hex.DecodeString(sum); err != nil || len(b) != sha256.Size {
+			return nil, fmt.Errorf("resource source '%s' has invalid sha256 checksum '%s' of '%s'", source.Name, sum, name)
+		}
+	}
+	return checksums, nil
+}
+
+/**
+Parses the manifest in the format of sha256sum, "<hex> <name>" or "<hex> *<name>" per line, lines starting with # are comments
+*/
+
+func parseChecksumManifest(r io.Reader, checksums map[string]string) error {
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return fmt.Errorf("line %d: expected '<sha256> <name>'", line)
+		}
+		name := strings.TrimPrefix(strings.TrimPrefix(fields[1], "*"), "./")
+		checksums[name] = strings.ToLower(fields[0])
+	}
+	return scanner.Err()
+}
+
+/**
+Verifies resources with checksums when the source is added, resources in the manifest must exist
+*/
+
+func verifyResources(source *ResourceSource, resources map[string]Resource, checksums map[string]string) error {
+	names := make([]string, 0, len(checksums))
+	for name := range checksums {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		res, ok := resources[name]
+		if !ok {
+			if source.Integrity == IntegrityStrict {
+				return fmt.Errorf("%w: resource '%s:%s' of the manifest was not found", ErrResourceIntegrity, source.Name, name)
+			}
+			continue
+		}
+		if err := res.(*verifiedResource).verify(); err != nil && source.Integrity == IntegrityStrict {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func integrityFS(appContent string) fstest.MapFS {
+	return fstest.MapFS{
+		"app.properties": {Data: []byte(appContent)},
+		"logo.txt":       {Data: []byte("logo")},
+		"SHA256SUMS": {Data: []byte(fmt.Sprintf("# release 1.0\n%s  app.properties\n%s *./logo.txt\n",
+			sha256Hex("app.name = signed\n"), sha256Hex("logo")))},
+	}
+}
+
+func TestResourceIntegrity(t *testing.T) {
+
+	ctn, err := glue.New(
+		&glue.ResourceSource{Name: "config", FS: integrityFS("app.name = signed\n"), Manifest: "SHA256SUMS"},
+		&glue.PropertySource{File: "config:app.properties"},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+	require.Equal(t, "signed", ctn.Properties().GetString("app.name", ""))
+
+	// the tampered file fails when opened on startup
+	_, err = glue.New(
+		&glue.ResourceSource{Name: "config", FS: integrityFS("app.name = tampered\n"), Manifest: "SHA256SUMS"},
+		&glue.PropertySource{File: "config:app.properties"},
+	)
+	require.ErrorIs(t, err, glue.ErrResourceIntegrity)
+
+	// or on the first Open after startup
+	ctn, err = glue.New(
+		&glue.ResourceSource{Name: "config", FS: integrityFS("app.name = tampered\n"), Manifest: "SHA256SUMS"},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+	res, ok := ctn.Resource("config:app.properties")
+	require.True(t, ok)
+	_, err = res.Open()
+	require.ErrorIs(t, err, glue.ErrResourceIntegrity)
+
+	res, ok = ctn.Resource("config:logo.txt")
+	require.True(t, ok)
+	file, err := res.Open()
+	require.NoError(t, err)
+	file.Close()
+
+	_, err = glue.New(
+		&glue.ResourceSource{Name: "config", FS: integrityFS("app.name = tampered\n"), Manifest: "SHA256SUMS", VerifyOnStart: true},
+	)
+	require.ErrorIs(t, err, glue.ErrResourceIntegrity)
+}
+
+func TestResourceIntegrityWarn(t *testing.T) {
+
+	ctn, err := glue.New(
+		&glue.ResourceSource{
+			Name:          "config",
+			FS:            integrityFS("app.name = tampered\n"),
+			Checksums:     map[string]string{"missing.txt": sha256Hex("missing")},
+			Manifest:      "SHA256SUMS",
+			Integrity:     glue.IntegrityWarn,
+			VerifyOnStart: true,
+		},
+		&glue.PropertySource{File: "config:app.properties"},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+	require.Equal(t, "tampered", ctn.Properties().GetString("app.name", ""))
+
+	_, err = glue.New(
+		&glue.ResourceSource{Name: "config", FS: integrityFS(""), Checksums: map[string]string{"logo.txt": "abc"}},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid sha256")
+}
+
+func TestResourceIntegrityChangedContent(t *testing.T) {
+
+	fsys := integrityFS("app.name = signed\n")
+	ctn, err := glue.New(
+		&glue.ResourceSource{Name: "config", FS: fsys, Manifest: "SHA256SUMS"},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+	require.Equal(t, "app.name = signed\n", readResource(t, ctn, "config:app.properties"))
+
+	// the content changed after the first Open is verified again
+	fsys["app.properties"].Data = []byte("app.name = tampered\n")
+	res, ok := ctn.Resource("config:app.properties")
+	require.True(t, ok)
+	_, err = res.Open()
+	require.ErrorIs(t, err, glue.ErrResourceIntegrity)
+}
+
+func TestRemoteResourceIntegrity(t *testing.T) {
+
+	var tampered int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/config/SHA256SUMS":
+			fmt.Fprintf(w, "%s  app.properties\n", sha256Hex("app.name = remote\n"))
+		case "/config/app.properties":
+			if atomic.LoadInt32(&tampered) == 1 {
+				w.Write([]byte("app.name = tampered\n"))
+				return
+			}
+			w.Write([]byte("app.name = remote\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	ctn, err := glue.New(
+		&glue.RemoteResourceSource{
+			Name:       "remote",
+			URL:        server.URL + "/config/",
+			AssetNames: []string{"app.properties"},
+			Manifest:   "SHA256SUMS",
+		},
+		&glue.PropertySource{File: "remote:app.properties"},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+	require.Equal(t, "remote", ctn.Properties().GetString("app.name", ""))
+
+	// revalidated content is verified on every Open
+	atomic.StoreInt32(&tampered, 1)
+	res, ok := ctn.Resource("remote:app.properties")
+	require.True(t, ok)
+	_, err = res.Open()
+	require.ErrorIs(t, err, glue.ErrResourceIntegrity)
+
+	_, err = glue.New(
+		&glue.RemoteResourceSource{
+			Name:       "remote",
+			URL:        server.URL + "/config/",
+			AssetNames: []string{"app.properties"},
+			Checksums:  map[string]string{"app.properties": sha256Hex("app.name = remote\n")},
+		},
+		&glue.PropertySource{File: "remote:app.properties"},
+	)
+	require.ErrorIs(t, err, glue.ErrResourceIntegrity)
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"reflect"
+)
+
+/**
+Interceptor wraps calls of beans through injected interfaces, e.g. for logging, retries or tracing.
+Register it in the scan list as glue.Interceptor{...} or &glue.Interceptor{...}.
+
+Go can not implement interfaces at runtime, so only interfaces with the registered proxy are intercepted,
+see RegisterProxy and '//glue:decorator' interfaces of gluegen.
+Interceptors are applied after decorators in registration order, the first one is the outermost.
+*/
+
+type Interceptor struct {
+
+	/**
+	Selects intercepted interfaces, nil matches every interface with the registered proxy
+	*/
+	Match func(ifaceType reflect.Type) bool
+
+	/**
+	Called instead of the method, next calls the inner interceptor or the bean
+	*/
+	Around MethodInterceptor
+}
+
+func (t *Interceptor) matches(ifaceType reflect.Type) bool {
+	return t.Match == nil || t.Match(ifaceType)
+}
+
+func (t *container) addInterceptor(interceptor *Interceptor) error {
+	if interceptor.Around == nil {
+		return fmt.Errorf("interceptor must have Around function")
+	}
+	t.interceptors = append(t.interceptors, interceptor)
+	return nil
+}
+
+/**
+Puts injected interfaces of matched beans behind proxies with the chain of interceptors
+*/
+
+func (t *container) applyInterceptors() {
+	if len(t.interceptors) == 0 {
+		return
+	}
+	t.logMissingProxies()
+	for _, beans := range t.core {
+		for _, b := range beans {
+			if b.obj == nil {
+				continue
+			}
+			for _, ifaceType := range proxyInterfaces(reflect.TypeOf(b.obj)) {
+				var chain []MethodInterceptor
+				for _, interceptor := range t.interceptors {
+					if interceptor.matches(ifaceType) {
+						chain = append(chain, interceptor.Around)
+					}
+				}
+				if len(chain) == 0 {
+					continue
+				}
+				factory, _ := lookupProxy(ifaceType)
+				current := b.proxied(ifaceType)
+				proxy := factory(current, chainInterceptors(chain))
+				t.logf(LogInfo, "Intercepted bean '%s' behind %v proxy\n", b.name, ifaceType)
+				t.replaceInjectedFields(ifaceType, current, proxy)
+				b.setProxy(ifaceType, proxy)
+			}
+		}
+	}
+}
+
+/**
+Interfaces injected in to beans and matched by interceptors, but not intercepted without the proxy
+*/
+
+func (t *container) logMissingProxies() {
+	if !t.logEnabled(LogInfo) {
+		return
+	}
+	seen := make(map[reflect.Type]bool)
+	for _, beans := range t.core {
+		for _, b := range beans {
+			if b.beanDef == nil {
+				continue
+			}
+			for _, f := range b.beanDef.fields {
+				if f.fieldType.Kind() != reflect.Interface || seen[f.fieldType] {
+					continue
+				}
+				seen[f.fieldType] = true
+				if _, ok := lookupProxy(f.fieldType); ok {
+					continue
+				}
+				for _, interceptor := range t.interceptors {
+					if interceptor.Match != nil && interceptor.matches(f.fieldType) {
+						t.logger.Printf("Interceptor matches %v without registered proxy\n", f.fieldType)
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+func chainInterceptors(chain []MethodInterceptor) MethodInterceptor {
+	if len(chain) == 1 {
+		return chain[0]
+	}
+	return func(method string, args []reflect.Value, next func([]reflect.Value) []reflect.Value) []reflect.Value {
+		call := next
+		for i := len(chain) - 1; i >= 0; i-- {
+			around, inner := chain[i], call
+			call = func(args []reflect.Value) []reflect.Value {
+				return around(method, args, inner)
+			}
+		}
+		return call(args)
+	}
+}
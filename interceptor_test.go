@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type Greeter interface {
+	Greet(name string) (string, error)
+}
+
+var GreeterClass = reflect.TypeOf((*Greeter)(nil)).Elem()
+
+type greeterProxy struct {
+	DoGreet func(name string) (string, error)
+}
+
+func (p *greeterProxy) Greet(name string) (string, error) {
+	return p.DoGreet(name)
+}
+
+func init() {
+	glue.RegisterProxy(GreeterClass, func(target any, interceptor glue.MethodInterceptor) any {
+		impl := target.(Greeter)
+		orig := reflect.ValueOf(impl.Greet)
+		proxy := &greeterProxy{}
+		reflect.ValueOf(&proxy.DoGreet).Elem().Set(reflect.MakeFunc(orig.Type(), func(args []reflect.Value) []reflect.Value {
+			return interceptor("Greet", args, orig.Call)
+		}))
+		return proxy
+	})
+}
+
+type flakyGreeter struct {
+	failures int
+}
+
+func (t *flakyGreeter) Greet(name string) (string, error) {
+	if t.failures > 0 {
+		t.failures--
+		return "", errors.New("unavailable")
+	}
+	return "hello " + name, nil
+}
+
+type greeterClient struct {
+	Greeter  Greeter   `inject:""`
+	Greeters []Greeter `inject:""`
+}
+
+func TestInterceptor(t *testing.T) {
+
+	var calls []string
+	tracing := glue.Interceptor{
+		Match: func(ifaceType reflect.Type) bool { return ifaceType == GreeterClass },
+		Around: func(method string, args []reflect.Value, next func([]reflect.Value) []reflect.Value) []reflect.Value {
+			calls = append(calls, "trace "+method+" "+args[0].String())
+			return next(args)
+		},
+	}
+	retry := &glue.Interceptor{
+		Around: func(method string, args []reflect.Value, next func([]reflect.Value) []reflect.Value) []reflect.Value {
+			for {
+				out := next(args)
+				if out[1].IsNil() {
+					return out
+				}
+				calls = append(calls, "retry "+method)
+			}
+		},
+	}
+
+	greeter := &flakyGreeter{failures: 2}
+	client := &greeterClient{}
+	ctn, err := glue.New(tracing, retry, greeter, client)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.NotSame(t, greeter, client.Greeter)
+	require.Equal(t, 1, len(client.Greeters))
+	require.Same(t, client.Greeter, client.Greeters[0])
+
+	out, err := client.Greeter.Greet("bob")
+	require.NoError(t, err)
+	require.Equal(t, "hello bob", out)
+	require.Equal(t, []string{"trace Greet bob", "retry Greet", "retry Greet"}, calls)
+
+	_, err = glue.New(glue.Interceptor{}, greeter)
+	require.Error(t, err)
+}
+
+func TestInterceptorNoMatch(t *testing.T) {
+
+	greeter := &flakyGreeter{}
+	client := &greeterClient{}
+	ctn, err := glue.New(glue.Interceptor{
+		Match: func(ifaceType reflect.Type) bool { return false },
+		Around: func(method string, args []reflect.Value, next func([]reflect.Value) []reflect.Value) []reflect.Value {
+			panic("must not be called")
+		},
+	}, greeter, client)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Same(t, greeter, client.Greeter)
+}
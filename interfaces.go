@@ -48,3 +48,9 @@ func (t *interfaceCache) store(ifaceType reflect.Type, list []*bean) {
 		}
 	}
 }
+
+func (t *interfaceCache) clear() {
+	t.Lock()
+	defer t.Unlock()
+	t.candidates = make(map[reflect.Type][]*bean)
+}
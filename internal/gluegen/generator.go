@@ -561,12 +561,16 @@ func render(spec packageSpec) ([]byte, error) {
 			buf.WriteString(fmt.Sprintf("\t\tfield.Set(wrapped)\n"))
 			buf.WriteString(fmt.Sprintf("\t}\n"))
 		}
-		buf.WriteString("}\n")
-	}
+		buf.WriteString("}\n\n")
 
-	// ensure glue import is used when only decorators exist
-	if len(spec.Types) == 0 && len(spec.Decorators) > 0 {
-		buf.WriteString("\nvar _ glue.Decorator = nil\n")
+		// runtime registration used by sandboxed beans and interceptors
+		buf.WriteString(fmt.Sprintf("func init() {\n"))
+		buf.WriteString(fmt.Sprintf("\tglue.RegisterProxy(reflect.TypeOf((*%s)(nil)).Elem(), func(target any, interceptor glue.MethodInterceptor) any {\n", dec.Name))
+		buf.WriteString(fmt.Sprintf("\t\tproxy := New%s(target.(%s))\n", proxyName, dec.Name))
+		buf.WriteString(fmt.Sprintf("\t\tWrap%s(proxy, interceptor)\n", proxyName))
+		buf.WriteString(fmt.Sprintf("\t\treturn proxy\n"))
+		buf.WriteString(fmt.Sprintf("\t})\n"))
+		buf.WriteString("}\n")
 	}
 
 	formatted, err := format.Source(buf.Bytes())
@@ -241,6 +241,7 @@ func TestGenerateDecoratorProxy(t *testing.T) {
 		"func (p *GreeterProxy) GreetAll(",
 		"func NewGreeterProxy(",
 		"func WrapGreeterProxy(",
+		"glue.RegisterProxy(reflect.TypeOf((*Greeter)(nil)).Elem(),",
 	} {
 		if !strings.Contains(text, want) {
 			t.Fatalf("generated file missing %q:\n%s", want, text)
@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+/**
+BeanOrigin tells how the bean got in to the container, see Container.Beans.
+*/
+
+type BeanOrigin string
+
+const (
+	OriginScan       BeanOrigin = "scan"       // bean of the scan list given to New, Extend or the child container
+	OriginFactory    BeanOrigin = "factory"    // object produced by FactoryBean
+	OriginRegistered BeanOrigin = "registered" // bean added by Container.Register
+	OriginChild      BeanOrigin = "child"      // bean of the live extended container
+)
+
+var originRank = map[BeanOrigin]int{OriginScan: 0, OriginFactory: 1, OriginRegistered: 2, OriginChild: 3}
+
+/**
+BeanInfo is the descriptor of the bean of the running container, Bean gives access to the object,
+Dependencies are names of beans it depends on and Injections are its injection points.
+*/
+
+type BeanInfo struct {
+	Name         string            `json:"name" yaml:"name"`
+	Type         string            `json:"type" yaml:"type"`
+	Lifecycle    BeanLifecycle     `json:"-" yaml:"-"`
+	State        string            `json:"lifecycle" yaml:"lifecycle"`
+	Origin       BeanOrigin        `json:"origin" yaml:"origin"`
+	Description  string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Metadata     map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	Dependencies []string          `json:"dependencies,omitempty" yaml:"dependencies,omitempty"`
+	Injections   []InjectionEdge   `json:"injections,omitempty" yaml:"injections,omitempty"`
+	Bean         Bean              `json:"-" yaml:"-"`
+}
+
+func (t BeanInfo) String() string {
+	var out strings.Builder
+	fmt.Fprintf(&out, "%s %s [%s] %s", t.Name, t.Type, t.State, t.Origin)
+	if len(t.Dependencies) > 0 {
+		fmt.Fprintf(&out, " -> %s", strings.Join(t.Dependencies, ", "))
+	}
+	return out.String()
+}
+
+func (t *container) Beans(states ...BeanLifecycle) []BeanInfo {
+	var list []BeanInfo
+	add := func(b *bean, origin BeanOrigin) {
+		if b.obj == t || b.name == "" {
+			return
+		}
+		lifecycle := b.Lifecycle()
+		if len(states) > 0 && !containsLifecycle(states, lifecycle) {
+			return
+		}
+		if origin != OriginChild && b.beenFactory != nil {
+			origin = OriginFactory
+		}
+		list = append(list, describeBean(b, lifecycle, origin))
+	}
+
+	for _, beans := range t.core {
+		for _, b := range beans {
+			add(b, OriginScan)
+		}
+	}
+	t.registryMu.RLock()
+	var registered []*bean
+	for _, beans := range t.registry.core {
+		registered = append(registered, beans...)
+	}
+	t.registryMu.RUnlock()
+	for _, b := range registered {
+		add(b, OriginRegistered)
+	}
+	t.extendedMu.Lock()
+	children := append([]*container(nil), t.extended...)
+	t.extendedMu.Unlock()
+	for _, child := range children {
+		child.forEachLiveBean(func(b *bean) {
+			add(b, OriginChild)
+		})
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if a.Origin != b.Origin {
+			return originRank[a.Origin] < originRank[b.Origin]
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Type < b.Type
+	})
+	return list
+}
+
+func describeBean(b *bean, lifecycle BeanLifecycle, origin BeanOrigin) BeanInfo {
+	info := BeanInfo{
+		Name:        beanGraphName(b),
+		Lifecycle:   lifecycle,
+		State:       lifecycle.String(),
+		Origin:      origin,
+		Description: b.description,
+		Metadata:    beanMetadata(b),
+		Bean:        b,
+	}
+	if b.beanDef != nil {
+		info.Type = b.beanDef.classPtr.String()
+	} else if b.obj != nil {
+		info.Type = reflect.TypeOf(b.obj).String()
+	}
+	seen := make(map[string]bool)
+	addDependency := func(dep *bean) {
+		if name := beanGraphName(dep); !seen[name] {
+			seen[name] = true
+			info.Dependencies = append(info.Dependencies, name)
+		}
+	}
+	for _, dep := range b.dependencies {
+		addDependency(dep)
+	}
+	for _, fd := range b.factoryDependencies {
+		if fd.factory != nil && fd.factory.bean != nil {
+			addDependency(fd.factory.bean)
+		}
+	}
+	sort.Strings(info.Dependencies)
+	for _, in := range b.injectedBeans {
+		to := in.to
+		if to.beenFactory != nil && to.beenFactory.bean != nil {
+			to = to.beenFactory.bean
+		}
+		edge := InjectionEdge{
+			From:     info.Name,
+			To:       beanGraphName(to),
+			Field:    in.def.fieldName,
+			Kind:     edgeKind(in.def),
+			Lazy:     in.def.lazy,
+			Optional: in.def.optional,
+		}
+		if in.def.scope != ScopeSingleton {
+			edge.Scope = in.def.scope.String()
+		}
+		info.Injections = append(info.Injections, edge)
+	}
+	sort.SliceStable(info.Injections, func(i, j int) bool {
+		a, b := info.Injections[i], info.Injections[j]
+		if a.Field != b.Field {
+			return a.Field < b.Field
+		}
+		return a.To < b.To
+	})
+	return info
+}
+
+func containsLifecycle(list []BeanLifecycle, lifecycle BeanLifecycle) bool {
+	for _, l := range list {
+		if l == lifecycle {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type introspectClock struct {
+	now int64
+}
+
+var introspectClockClass = reflect.TypeOf((*introspectClock)(nil))
+
+type introspectClockFactory struct{}
+
+func (t *introspectClockFactory) Object() (any, error) {
+	return &introspectClock{now: 42}, nil
+}
+
+func (t *introspectClockFactory) ObjectType() reflect.Type {
+	return introspectClockClass
+}
+
+func (t *introspectClockFactory) ObjectName() string {
+	return "clock"
+}
+
+func (t *introspectClockFactory) Singleton() bool {
+	return true
+}
+
+type introspectService struct {
+	Clock *introspectClock `inject:""`
+}
+
+type introspectAudit struct {
+	Service *introspectService `inject:""`
+}
+
+type introspectWorker struct {
+	Service *introspectService `inject:""`
+}
+
+func TestBeans(t *testing.T) {
+
+	ctn, err := glue.New(&introspectClockFactory{}, &introspectService{})
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	_, err = ctn.Register(&introspectAudit{})
+	require.NoError(t, err)
+
+	child, err := ctn.Extend(&introspectWorker{})
+	require.NoError(t, err)
+	defer child.Close()
+
+	origins := make(map[string]glue.BeanOrigin)
+	var service glue.BeanInfo
+	for _, info := range ctn.Beans() {
+		origins[info.Type] = info.Origin
+		if info.Type == "*glue_test.introspectService" {
+			service = info
+		}
+	}
+	require.Equal(t, glue.OriginScan, origins["*glue_test.introspectClockFactory"])
+	require.Equal(t, glue.OriginFactory, origins["*glue_test.introspectClock"])
+	require.Equal(t, glue.OriginRegistered, origins["*glue_test.introspectAudit"])
+	require.Equal(t, glue.OriginChild, origins["*glue_test.introspectWorker"])
+
+	require.Equal(t, glue.BeanInitialized, service.Lifecycle)
+	require.Equal(t, []string{"*glue_test.introspectClockFactory"}, service.Dependencies)
+	require.Len(t, service.Injections, 1)
+	require.Equal(t, "Clock", service.Injections[0].Field)
+	require.Equal(t, glue.EdgeInject, service.Injections[0].Kind)
+	require.Same(t, service.Bean.Object(), service.Bean.Object())
+
+	require.Empty(t, ctn.Beans(glue.BeanDestroyed))
+	require.NoError(t, child.Close())
+	for _, info := range ctn.Beans() {
+		require.NotEqual(t, glue.OriginChild, info.Origin)
+	}
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"sort"
+	"strings"
+)
+
+/**
+Labels identify the container in telemetry of multi-container processes, e.g. glue.Labels{"service": "billing", "region": "eu"}.
+Add them to the scan list or use WithLabels, children inherit labels of the parent and override them by their own.
+Labels are added to metrics of MeteredBean recorders, the dependency graph, Describe, History events and String of the container.
+*/
+
+type Labels map[string]string
+
+/**
+Returns labels as 'key=value' pairs sorted by key and separated by comma
+*/
+
+func (t Labels) String() string {
+	pairs := t.pairs()
+	list := make([]string, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		list = append(list, pairs[i]+"="+pairs[i+1])
+	}
+	return strings.Join(list, ",")
+}
+
+/**
+Returns key/value pairs sorted by key, in the format of MetricsRecorder labels
+*/
+
+func (t Labels) pairs() []string {
+	keys := make([]string, 0, len(t))
+	for k := range t {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys)*2)
+	for _, k := range keys {
+		pairs = append(pairs, k, t[k])
+	}
+	return pairs
+}
+
+func (t Labels) merge(other Labels) Labels {
+	if len(other) == 0 {
+		return t
+	}
+	m := make(Labels, len(t)+len(other))
+	for k, v := range t {
+		m[k] = v
+	}
+	for k, v := range other {
+		m[k] = v
+	}
+	return m
+}
+
+func (t Labels) copy() Labels {
+	if t == nil {
+		return nil
+	}
+	return Labels{}.merge(t)
+}
+
+/**
+Labels of the parent overridden by the options and then by Labels of the scan list in order
+*/
+
+func containerLabels(parent *container, options ContainerOptions) Labels {
+	var labels Labels
+	if parent != nil {
+		labels = parent.labels
+	}
+	labels = labels.merge(options.Labels)
+	for _, obj := range options.Beans {
+		if l, ok := obj.(Labels); ok {
+			labels = labels.merge(l)
+		}
+	}
+	return labels
+}
+
+func (t *container) Labels() Labels {
+	return t.labels.copy()
+}
+
+/**
+Adds labels of the container to every record, labels of the call go last and take precedence
+*/
+
+type labeledRecorder struct {
+	recorder MetricsRecorder
+	labels   []string
+}
+
+func (t *labeledRecorder) withLabels(labels []string) []string {
+	return append(append(make([]string, 0, len(t.labels)+len(labels)), t.labels...), labels...)
+}
+
+func (t *labeledRecorder) Add(name string, delta float64, labels ...string) {
+	t.recorder.Add(name, delta, t.withLabels(labels)...)
+}
+
+func (t *labeledRecorder) Set(name string, value float64, labels ...string) {
+	t.recorder.Set(name, value, t.withLabels(labels)...)
+}
+
+func (t *labeledRecorder) Observe(name string, value float64, labels ...string) {
+	t.recorder.Observe(name, value, t.withLabels(labels)...)
+}
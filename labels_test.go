@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+func TestContainerLabels(t *testing.T) {
+
+	registry := glue.NewMetricsRegistry()
+	history := glue.NewHistory(0)
+
+	parent, err := glue.NewWithOptions(
+		glue.WithLabels(glue.Labels{"service": "billing", "region": "us"}),
+		glue.WithBeans(registry),
+	)
+	require.NoError(t, err)
+	defer parent.Close()
+
+	child, err := parent.ExtendWithOptions(
+		glue.WithHistory(history),
+		glue.WithBeans(glue.Labels{"region": "eu"}, &meteredWorker{}),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, glue.Labels{"service": "billing", "region": "us"}, parent.Labels())
+	require.Equal(t, glue.Labels{"service": "billing", "region": "eu"}, child.Labels())
+	require.Equal(t, "region=eu,service=billing", child.Labels().String())
+	require.Contains(t, child.String(), "labels=region=eu,service=billing")
+
+	samples := registry.Snapshot()
+	require.NotEmpty(t, samples)
+	for _, s := range samples {
+		require.Equal(t, "eu", s.Labels["region"])
+		require.Equal(t, "billing", s.Labels["service"])
+		require.Equal(t, "worker", s.Labels[glue.BeanLabel])
+	}
+
+	data, err := child.DependencyGraph().JSON()
+	require.NoError(t, err)
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(data, &doc))
+	require.Equal(t, map[string]any{"service": "billing", "region": "eu"}, doc["labels"])
+	require.Contains(t, child.DependencyGraph().DOT(), `label="region=eu,service=billing";`)
+	require.True(t, strings.HasPrefix(child.Describe(), "# region=eu,service=billing\n"))
+
+	events := history.Events()
+	require.NotEmpty(t, events)
+	require.Equal(t, "eu", events[0].Labels["region"])
+	require.Contains(t, events[0].String(), "{region=eu,service=billing}")
+
+	// labels of the container can not be modified by the caller
+	child.Labels()["region"] = "us"
+	require.Equal(t, "eu", child.Labels()["region"])
+}
@@ -0,0 +1,263 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// ErrLifecycleTimeout is returned when PostConstruct or Destroy of the bean does not complete in time.
+var ErrLifecycleTimeout = errors.New("bean lifecycle timeout")
+
+func (t *container) notifyLifecycle(phase ContainerPhase, err error) {
+	t.eventsMu.RLock()
+	listeners := t.lifecycleListeners
+	t.eventsMu.RUnlock()
+	event := LifecycleEvent{Phase: phase, Container: t, Err: err}
+	for _, b := range listeners {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.logf(LogError, "Lifecycle listener '%s' recovered from panic on %v: %v\n", b.name, phase, r)
+				}
+			}()
+			b.obj.(LifecycleListener).OnLifecycleEvent(event)
+		}()
+	}
+}
+
+/**
+Delivers the transition to lifecycle listeners of this container and then to listeners of parents
+*/
+
+func (t *container) notifyTransition(b *bean, from, to BeanLifecycle) {
+	event := BeanTransitionEvent{Bean: b, From: from, To: to}
+	for ctx := t; ctx != nil; ctx = ctx.parent {
+		ctx.eventsMu.RLock()
+		listeners := ctx.lifecycleListeners
+		ctx.eventsMu.RUnlock()
+		for _, l := range listeners {
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						ctx.logf(LogError, "Lifecycle listener '%s' recovered from panic on bean '%s' %s -> %s: %v\n", l.name, b.name, from, to, r)
+					}
+				}()
+				l.obj.(LifecycleListener).OnBeanTransition(event)
+			}()
+		}
+	}
+}
+
+func (t *container) OnClose(fn func()) {
+	if fn == nil {
+		return
+	}
+	t.closeHooksMu.Lock()
+	defer t.closeHooksMu.Unlock()
+	t.closeHooks = append(t.closeHooks, fn)
+}
+
+/**
+Runs cleanup functions in reverse order, panics are returned as errors
+*/
+
+func (t *container) runCloseHooks() []error {
+	t.closeHooksMu.Lock()
+	hooks := t.closeHooks
+	t.closeHooks = nil
+	t.closeHooksMu.Unlock()
+
+	var listErr []error
+	for j := len(hooks) - 1; j >= 0; j-- {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					listErr = append(listErr, fmt.Errorf("close hook recovered with error: %v", r))
+				}
+			}()
+			hooks[j]()
+		}()
+	}
+	return listErr
+}
+
+func (t *container) invokePostConstruct(ctx context.Context, b *bean) error {
+	timeout := b.postConstructTimeout
+	if timeout == 0 {
+		timeout = t.postConstructTimeout
+	}
+	ctx = callerContext(ctx)
+	if init, ok := b.obj.(ContextInitializingBean); ok {
+		return callLifecycle(ctx, b, "PostConstruct", timeout, nil, init.PostConstruct)
+	} else if init, ok := b.obj.(InitializingBean); ok {
+		return callLifecycle(ctx, b, "PostConstruct", timeout, nil, func(context.Context) error {
+			return init.PostConstruct()
+		})
+	}
+	return nil
+}
+
+func (t *container) invokeDestroy(ctx context.Context, b *bean) error {
+	timeout := b.destroyTimeout
+	if timeout == 0 {
+		timeout = t.destroyTimeout
+	}
+	// stuck Destroy is abandoned, the stack shows where it hangs
+	stuck := func(stack []byte) {
+		t.logf(LogError, "Destroy of bean '%s' with type '%v' did not complete in %v, close proceeds, goroutine stack:\n%s\n", b.name, b.beanDef.classPtr, timeout, stack)
+	}
+	if dis, ok := b.obj.(ContextDisposableBean); ok {
+		return callLifecycle(ctx, b, "Destroy", timeout, stuck, dis.Destroy)
+	} else if dis, ok := b.obj.(DisposableBean); ok {
+		return callLifecycle(ctx, b, "Destroy", timeout, stuck, func(context.Context) error {
+			return dis.Destroy()
+		})
+	}
+	return nil
+}
+
+/**
+Calls the lifecycle method, panics are returned as errors with the bean name and the stack.
+With the timeout the method runs in background and receives the context with deadline,
+the late method is abandoned since Go can not interrupt it, stuck receives the stack of its goroutine.
+*/
+
+func callLifecycle(ctx context.Context, b *bean, method string, timeout time.Duration, stuck func(stack []byte), fn func(context.Context) error) (err error) {
+	call := func(ctx context.Context) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				stack := make([]byte, 4096)
+				stack = stack[:runtime.Stack(stack, false)]
+				err = fmt.Errorf("%s of bean '%s' with type '%v' recovered with error %v, stacktrace: %s", method, b.name, b.beanDef.classPtr, r, stack)
+			}
+		}()
+		return fn(ctx)
+	}
+
+	if timeout <= 0 {
+		return call(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var gid uint64
+	done := make(chan error, 1)
+	go func() {
+		atomic.StoreUint64(&gid, goroutineID())
+		done <- call(ctx)
+	}()
+
+	select {
+	case err = <-done:
+		return err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			if stuck != nil {
+				stuck(goroutineStack(atomic.LoadUint64(&gid)))
+			}
+			return fmt.Errorf("%w: %s of bean '%s' with type '%v' did not complete in %v", ErrLifecycleTimeout, method, b.name, b.beanDef.classPtr, timeout)
+		}
+		return ctx.Err()
+	}
+}
+
+/**
+Returns the id of the current goroutine from the header of its stack, e.g. 'goroutine 42 [running]:'
+*/
+
+func goroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	if i := bytes.IndexByte(buf, ' '); i > 0 {
+		id, _ := strconv.ParseUint(string(buf[:i]), 10, 64)
+		return id
+	}
+	return 0
+}
+
+/**
+Returns the stack of the goroutine by id, empty if it is already finished
+*/
+
+func goroutineStack(id uint64) []byte {
+	if id == 0 {
+		return nil
+	}
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) || len(buf) >= 16<<20 {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+	header := []byte("goroutine " + strconv.FormatUint(id, 10) + " [")
+	for _, stack := range bytes.Split(buf, []byte("\n\n")) {
+		if bytes.HasPrefix(stack, header) {
+			return stack
+		}
+	}
+	return nil
+}
+
+type callerContextKey struct{}
+
+/**
+The construction context is cancelled on failed creation and close, only FactoryBeanCtx receives it.
+ContextFactoryBean and PostConstruct(ctx) receive the context given by the caller.
+*/
+
+func constructionContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithCancel(context.WithValue(ctx, callerContextKey{}, ctx))
+}
+
+func callerContext(ctx context.Context) context.Context {
+	if caller, ok := ctx.Value(callerContextKey{}).(context.Context); ok {
+		return caller
+	}
+	return ctx
+}
+
+/**
+Destroys the object created by the factory after the construction context was cancelled
+*/
+
+func discardObject(obj any) {
+	switch dis := obj.(type) {
+	case ContextDisposableBean:
+		dis.Destroy(context.Background())
+	case DisposableBean:
+		dis.Destroy()
+	}
+}
+
+/**
+Injects fields of the object produced by InjectingFactoryBean and calls its PostConstruct
+*/
+
+func (t *container) injectResult(ctx context.Context, obj any) error {
+	if err := t.Inject(obj); err != nil {
+		return err
+	}
+	switch init := obj.(type) {
+	case ContextInitializingBean:
+		return init.PostConstruct(callerContext(ctx))
+	case InitializingBean:
+		return init.PostConstruct()
+	}
+	return nil
+}
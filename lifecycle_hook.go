@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+/**
+BeanTiming is the duration of phases of the bean, see Container.InitReport.
+Construct includes construction of dependencies, PostConstruct is the call of the bean itself.
+*/
+
+type BeanTiming struct {
+	Name          string
+	Construct     time.Duration
+	PostConstruct time.Duration
+	Destroy       time.Duration
+}
+
+func (t BeanTiming) String() string {
+	s := fmt.Sprintf("%s construct=%v postConstruct=%v", t.Name, t.Construct, t.PostConstruct)
+	if t.Destroy > 0 {
+		s = fmt.Sprintf("%s destroy=%v", s, t.Destroy)
+	}
+	return s
+}
+
+/**
+InitReport lists timing of beans of the container sorted by PostConstruct duration, the slowest first.
+*/
+
+type InitReport struct {
+	Beans []BeanTiming
+}
+
+/**
+Slowest returns up to n beans with the slowest PostConstruct.
+*/
+
+func (t InitReport) Slowest(n int) []BeanTiming {
+	if n > len(t.Beans) {
+		n = len(t.Beans)
+	}
+	return t.Beans[:n]
+}
+
+func (t InitReport) String() string {
+	var out strings.Builder
+	for _, b := range t.Beans {
+		out.WriteString(b.String())
+		out.WriteByte('\n')
+	}
+	return out.String()
+}
+
+type beanTimes struct {
+	constructStart time.Time
+	destroyStart   time.Time
+	timing         BeanTiming
+}
+
+/**
+Built-in timing collection of bean phases, safe for parallel initialization
+*/
+
+type timingCollector struct {
+	mu    sync.Mutex
+	beans map[*bean]*beanTimes
+	order []*bean
+}
+
+func (t *timingCollector) times(b *bean) *beanTimes {
+	if t.beans == nil {
+		t.beans = make(map[*bean]*beanTimes)
+	}
+	bt, ok := t.beans[b]
+	if !ok {
+		bt = &beanTimes{timing: BeanTiming{Name: beanGraphName(b)}}
+		t.beans[b] = bt
+		t.order = append(t.order, b)
+	}
+	return bt
+}
+
+func (t *timingCollector) transition(b *bean, to BeanLifecycle) {
+	now := time.Now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	switch to {
+	case BeanConstructing:
+		t.times(b).constructStart = now
+	case BeanInitialized:
+		if bt := t.times(b); !bt.constructStart.IsZero() {
+			bt.timing.Construct = now.Sub(bt.constructStart)
+		}
+	case BeanDestroying:
+		t.times(b).destroyStart = now
+	case BeanDestroyed:
+		if bt := t.times(b); !bt.destroyStart.IsZero() {
+			bt.timing.Destroy = now.Sub(bt.destroyStart)
+		}
+	}
+}
+
+func (t *timingCollector) postConstruct(b *bean, d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.times(b).timing.PostConstruct = d
+}
+
+func (t *timingCollector) report() InitReport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var report InitReport
+	for _, b := range t.order {
+		report.Beans = append(report.Beans, t.beans[b].timing)
+	}
+	sort.SliceStable(report.Beans, func(i, j int) bool {
+		return report.Beans[i].PostConstruct > report.Beans[j].PostConstruct
+	})
+	return report
+}
+
+func (t *container) InitReport() InitReport {
+	return t.timings.report()
+}
+
+func (t *container) callHook(hook LifecycleHook, b *bean, from, to BeanLifecycle) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.logf(LogError, "LifecycleHook '%T' of bean '%s' %s -> %s recovered with error: %v\n", hook, b.name, from, to, r)
+		}
+	}()
+	hook.OnTransition(b, from, to)
+}
+
+/**
+Hooks of the parent go first, so tooling registered on the root container observes children
+*/
+
+func containerHooks(parent *container, options ContainerOptions) []LifecycleHook {
+	var hooks []LifecycleHook
+	if parent != nil {
+		hooks = append(hooks, parent.hooks...)
+	}
+	return append(hooks, options.LifecycleHooks...)
+}
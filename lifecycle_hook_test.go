@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type transitionRecorder struct {
+	mu    sync.Mutex
+	trace []string
+}
+
+func (t *transitionRecorder) OnTransition(bean glue.Bean, from, to glue.BeanLifecycle) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.trace = append(t.trace, fmt.Sprintf("%s %s->%s", bean.Name(), from, to))
+}
+
+type panickingHook struct{}
+
+func (t panickingHook) OnTransition(bean glue.Bean, from, to glue.BeanLifecycle) {
+	panic("hook failure")
+}
+
+type timedSlowBean struct{}
+
+func (t *timedSlowBean) BeanName() string { return "slow" }
+
+func (t *timedSlowBean) PostConstruct() error {
+	time.Sleep(20 * time.Millisecond)
+	return nil
+}
+
+func (t *timedSlowBean) Destroy() error { return nil }
+
+type timedFastBean struct {
+	Slow *timedSlowBean `inject:""`
+}
+
+func (t *timedFastBean) BeanName() string { return "fast" }
+
+func (t *timedFastBean) PostConstruct() error { return nil }
+
+func TestLifecycleHook(t *testing.T) {
+
+	recorder := &transitionRecorder{}
+	ctn, err := glue.NewWithOptions(
+		glue.WithLifecycleHook(panickingHook{}, recorder),
+		glue.WithBeans(&timedFastBean{}, &timedSlowBean{}),
+	)
+	require.NoError(t, err)
+
+	child, err := ctn.Extend(&struct{}{})
+	require.NoError(t, err)
+	require.NoError(t, child.Close())
+
+	require.Subset(t, recorder.trace, []string{
+		"slow BeanCreated->BeanConstructing",
+		"slow BeanConstructing->BeanInitialized",
+		"fast BeanConstructing->BeanInitialized",
+	})
+
+	report := ctn.InitReport()
+	slowest := report.Slowest(1)
+	require.Len(t, slowest, 1)
+	require.Equal(t, "slow", slowest[0].Name)
+	require.GreaterOrEqual(t, slowest[0].PostConstruct, 20*time.Millisecond)
+	for _, b := range report.Beans {
+		if b.Name == "fast" {
+			require.GreaterOrEqual(t, b.Construct, 20*time.Millisecond)
+			require.Less(t, b.PostConstruct, 20*time.Millisecond)
+		}
+	}
+	require.Contains(t, report.String(), "slow construct=")
+
+	require.NoError(t, ctn.Close())
+	require.Contains(t, recorder.trace, "slow BeanDestroying->BeanDestroyed")
+}
@@ -0,0 +1,41 @@
+//go:build !glue_nolog
+
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+func TestDestroyTimeoutLogsStack(t *testing.T) {
+
+	hanging := &hangingDestroyBean{release: make(chan struct{})}
+	defer close(hanging.release)
+	logger := &bufferLogger{}
+
+	ctn, err := glue.NewWithOptions(
+		glue.WithLogger(logger),
+		glue.WithDestroyTimeout(10*time.Millisecond),
+		glue.WithBeans(hanging),
+	)
+	require.NoError(t, err)
+	require.Error(t, ctn.Close())
+
+	// the stack of the stuck Destroy is logged
+	var stack string
+	for _, line := range logger.lines {
+		if strings.Contains(line, "close proceeds, goroutine stack") {
+			stack = line
+		}
+	}
+	require.Contains(t, stack, "(*hangingDestroyBean).Destroy")
+}
@@ -0,0 +1,191 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type lifecycleRecorder struct {
+	trace *[]string
+}
+
+func (t *lifecycleRecorder) OnLifecycleEvent(event glue.LifecycleEvent) {
+	*t.trace = append(*t.trace, event.Phase.String())
+}
+
+func (t *lifecycleRecorder) OnBeanTransition(event glue.BeanTransitionEvent) {
+}
+
+type transitionListener struct {
+	mu    sync.Mutex
+	trace []string
+}
+
+func (t *transitionListener) OnLifecycleEvent(event glue.LifecycleEvent) {
+}
+
+func (t *transitionListener) OnBeanTransition(event glue.BeanTransitionEvent) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.trace = append(t.trace, fmt.Sprintf("%s %s->%s", event.Bean.Name(), event.From, event.To))
+}
+
+type panickingTransitionListener struct{}
+
+func (t *panickingTransitionListener) OnLifecycleEvent(event glue.LifecycleEvent) {
+}
+
+func (t *panickingTransitionListener) OnBeanTransition(event glue.BeanTransitionEvent) {
+	panic("listener failure")
+}
+
+type transitionBean struct {
+	name string
+}
+
+func (t *transitionBean) BeanName() string { return t.name }
+
+func (t *transitionBean) Destroy() error { return nil }
+
+type lifecycleResource struct {
+	trace *[]string
+}
+
+func (t *lifecycleResource) Destroy() error {
+	*t.trace = append(*t.trace, "destroy")
+	return nil
+}
+
+func TestLifecycleListener(t *testing.T) {
+
+	var trace []string
+
+	ctn, err := glue.New(
+		&lifecycleRecorder{trace: &trace},
+		&lifecycleResource{trace: &trace},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ContainerRefreshed"}, trace)
+
+	ctn.OnClose(func() { trace = append(trace, "first") })
+	ctn.OnClose(func() { trace = append(trace, "second") })
+
+	require.NoError(t, ctn.Close())
+	require.Equal(t, []string{"ContainerRefreshed", "ContainerClosing", "second", "first", "destroy", "ContainerClosed"}, trace)
+}
+
+func TestLifecycleListenerTransitions(t *testing.T) {
+
+	listener := &transitionListener{}
+	ctn, err := glue.New(
+		&panickingTransitionListener{},
+		listener,
+		&transitionBean{name: "root"},
+	)
+	require.NoError(t, err)
+	// subscribed after PostConstruct, construction of scanned beans is not observed
+	require.NotContains(t, listener.trace, "root BeanConstructing->BeanInitialized")
+
+	child, err := ctn.Extend(&transitionBean{name: "child"})
+	require.NoError(t, err)
+	require.Contains(t, listener.trace, "child BeanConstructing->BeanInitialized")
+
+	require.NoError(t, child.Close())
+	require.Contains(t, listener.trace, "child BeanDestroying->BeanDestroyed")
+
+	require.NoError(t, ctn.Close())
+	require.Contains(t, listener.trace, "root BeanDestroying->BeanDestroyed")
+}
+
+func TestOnClosePanic(t *testing.T) {
+
+	var trace []string
+
+	ctn, err := glue.New()
+	require.NoError(t, err)
+
+	ctn.OnClose(func() { trace = append(trace, "cleanup") })
+	ctn.OnClose(func() { panic("boom") })
+
+	err = ctn.Close()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "boom")
+	require.Equal(t, []string{"cleanup"}, trace)
+}
+
+type slowInitBean struct {
+	delay time.Duration
+}
+
+func (t *slowInitBean) PostConstruct(ctx context.Context) error {
+	select {
+	case <-time.After(t.delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type hangingDestroyBean struct {
+	release chan struct{}
+}
+
+func (t *hangingDestroyBean) Destroy() error {
+	<-t.release
+	return nil
+}
+
+type panicDestroyBean struct{}
+
+func (t *panicDestroyBean) Destroy() error {
+	panic("destroy failed")
+}
+
+func TestPostConstructTimeout(t *testing.T) {
+
+	_, err := glue.NewWithOptions(
+		glue.WithPostConstructTimeout(10*time.Millisecond),
+		glue.WithBeans(&slowInitBean{delay: time.Second}),
+	)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, glue.ErrLifecycleTimeout))
+
+	// bean timeout overrides the container timeout
+	ctn, err := glue.NewWithOptions(
+		glue.WithPostConstructTimeout(10*time.Millisecond),
+		glue.WithBeans(glue.LifecycleTimeout(&slowInitBean{delay: 20 * time.Millisecond}, time.Second, 0)),
+	)
+	require.NoError(t, err)
+	require.NoError(t, ctn.Close())
+}
+
+func TestDestroyTimeoutAndPanic(t *testing.T) {
+
+	hanging := &hangingDestroyBean{release: make(chan struct{})}
+	defer close(hanging.release)
+
+	ctn, err := glue.NewWithOptions(
+		glue.WithDestroyTimeout(10*time.Millisecond),
+		glue.WithBeans(hanging, &panicDestroyBean{}),
+	)
+	require.NoError(t, err)
+
+	err = ctn.Close()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Destroy of bean '*glue_test.hangingDestroyBean'")
+	require.Contains(t, err.Error(), "did not complete")
+	require.Contains(t, err.Error(), "Destroy of bean '*glue_test.panicDestroyBean'")
+	require.Contains(t, err.Error(), "destroy failed")
+}
@@ -0,0 +1,250 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LocksPropertyPrefix is the prefix of properties that configure semaphore limits, e.g. 'locks.db.users.limit'.
+var LocksPropertyPrefix = "locks."
+
+// DefaultSemaphoreLimit is the limit of the semaphore without configured property.
+var DefaultSemaphoreLimit = 1
+
+/**
+Locks is the bean with named hierarchical mutexes and semaphores for beans coordinating shared resources.
+Names are dot separated paths, e.g. 'db.users'.
+
+Lock of the name holds its ancestors in shared mode, so Lock("db") excludes Lock("db.users"),
+but Lock("db.users") and Lock("db.orders") run concurrently.
+
+Semaphore limits are configured by properties 'locks.<name>.limit', the closest configured ancestor
+is used if the name has no limit, otherwise DefaultSemaphoreLimit.
+
+Register it by glue.New(glue.NewLocks(), ...) and inject as *glue.Locks, the zero value is ready to use.
+*/
+
+type Locks struct {
+	Properties Properties `inject:""`
+
+	mu         sync.Mutex
+	nodes      map[string]*sync.RWMutex
+	semaphores map[string]*Semaphore
+	recorder   MetricsRecorder
+}
+
+func NewLocks() *Locks {
+	return &Locks{
+		nodes:      make(map[string]*sync.RWMutex),
+		semaphores: make(map[string]*Semaphore),
+		recorder:   noopRecorder{},
+	}
+}
+
+func (t *Locks) SetMetricsRecorder(recorder MetricsRecorder) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.recorder = recorder
+}
+
+func (t *Locks) metrics() MetricsRecorder {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.recorder == nil {
+		t.recorder = noopRecorder{}
+	}
+	return t.recorder
+}
+
+/**
+Lock acquires the exclusive lock of the name and shared locks of all ancestors, returns the unlock function.
+*/
+
+func (t *Locks) Lock(name string) (unlock func()) {
+	start := time.Now()
+	path := t.path(name)
+	for _, m := range path[:len(path)-1] {
+		m.RLock()
+	}
+	path[len(path)-1].Lock()
+	t.acquired("lock", name, start)
+	return t.unlocker(name, path)
+}
+
+/**
+TryLock acquires the lock of the name without waiting, returns false if it is held by someone else.
+*/
+
+func (t *Locks) TryLock(name string) (unlock func(), ok bool) {
+	path := t.path(name)
+	last := len(path) - 1
+	for i, m := range path[:last] {
+		if !m.TryRLock() {
+			for j := i - 1; j >= 0; j-- {
+				path[j].RUnlock()
+			}
+			t.metrics().Add("locks_contended_total", 1, "lock", name)
+			return nil, false
+		}
+	}
+	if !path[last].TryLock() {
+		for j := last - 1; j >= 0; j-- {
+			path[j].RUnlock()
+		}
+		t.metrics().Add("locks_contended_total", 1, "lock", name)
+		return nil, false
+	}
+	t.acquired("lock", name, time.Now())
+	return t.unlocker(name, path), true
+}
+
+func (t *Locks) unlocker(name string, path []*sync.RWMutex) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			last := len(path) - 1
+			path[last].Unlock()
+			for j := last - 1; j >= 0; j-- {
+				path[j].RUnlock()
+			}
+			t.metrics().Add("locks_released_total", 1, "lock", name)
+		})
+	}
+}
+
+/**
+Returns mutexes from the root to the name
+*/
+
+func (t *Locks) path(name string) []*sync.RWMutex {
+	parts := strings.Split(name, ".")
+	path := make([]*sync.RWMutex, len(parts))
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.nodes == nil {
+		t.nodes = make(map[string]*sync.RWMutex)
+	}
+	for i := range parts {
+		key := strings.Join(parts[:i+1], ".")
+		m, ok := t.nodes[key]
+		if !ok {
+			m = &sync.RWMutex{}
+			t.nodes[key] = m
+		}
+		path[i] = m
+	}
+	return path
+}
+
+func (t *Locks) acquired(kind, name string, start time.Time) {
+	t.metrics().Add("locks_acquired_total", 1, "kind", kind, "lock", name)
+	t.metrics().Observe("locks_wait_seconds", time.Since(start).Seconds(), "kind", kind, "lock", name)
+}
+
+/**
+Semaphore returns the semaphore of the name, the limit is resolved on the first call.
+*/
+
+func (t *Locks) Semaphore(name string) *Semaphore {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if s, ok := t.semaphores[name]; ok {
+		return s
+	}
+	if t.semaphores == nil {
+		t.semaphores = make(map[string]*Semaphore)
+	}
+	s := &Semaphore{
+		name:  name,
+		slots: make(chan struct{}, t.limit(name)),
+		locks: t,
+	}
+	t.semaphores[name] = s
+	return s
+}
+
+func (t *Locks) limit(name string) int {
+	if t.Properties != nil {
+		for key := name; key != ""; {
+			if limit := t.Properties.GetInt(LocksPropertyPrefix+key+".limit", 0); limit > 0 {
+				return limit
+			}
+			i := strings.LastIndexByte(key, '.')
+			if i < 0 {
+				break
+			}
+			key = key[:i]
+		}
+	}
+	if DefaultSemaphoreLimit > 0 {
+		return DefaultSemaphoreLimit
+	}
+	return 1
+}
+
+/**
+Semaphore limits the number of concurrent holders
+*/
+
+type Semaphore struct {
+	name  string
+	slots chan struct{}
+	locks *Locks
+}
+
+func (t *Semaphore) Name() string {
+	return t.name
+}
+
+func (t *Semaphore) Limit() int {
+	return cap(t.slots)
+}
+
+/**
+Acquire waits for the free slot or the context cancellation.
+*/
+
+func (t *Semaphore) Acquire(ctx context.Context) error {
+	start := time.Now()
+	select {
+	case t.slots <- struct{}{}:
+		t.acquired(start)
+		return nil
+	case <-ctx.Done():
+		t.locks.metrics().Add("locks_contended_total", 1, "lock", t.name)
+		return ctx.Err()
+	}
+}
+
+func (t *Semaphore) TryAcquire() bool {
+	select {
+	case t.slots <- struct{}{}:
+		t.acquired(time.Now())
+		return true
+	default:
+		t.locks.metrics().Add("locks_contended_total", 1, "lock", t.name)
+		return false
+	}
+}
+
+func (t *Semaphore) Release() {
+	select {
+	case <-t.slots:
+		t.locks.metrics().Add("locks_released_total", 1, "lock", t.name)
+		t.locks.metrics().Set("semaphore_in_use", float64(len(t.slots)), "lock", t.name)
+	default:
+		panic("glue: semaphore '" + t.name + "' released more times than acquired")
+	}
+}
+
+func (t *Semaphore) acquired(start time.Time) {
+	t.locks.acquired("semaphore", t.name, start)
+	t.locks.metrics().Set("semaphore_in_use", float64(len(t.slots)), "lock", t.name)
+}
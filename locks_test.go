@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type lockUser struct {
+	Locks *glue.Locks `inject:""`
+}
+
+func TestLocksHierarchy(t *testing.T) {
+
+	user := &lockUser{}
+	ctn, err := glue.New(glue.NewLocks(), user)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	locks := user.Locks
+	require.NotNil(t, locks)
+
+	unlockUsers := locks.Lock("db.users")
+
+	unlockOrders, ok := locks.TryLock("db.orders")
+	require.True(t, ok)
+	unlockOrders()
+
+	_, ok = locks.TryLock("db.users")
+	require.False(t, ok)
+
+	_, ok = locks.TryLock("db")
+	require.False(t, ok)
+
+	acquired := make(chan struct{})
+	go func() {
+		unlock := locks.Lock("db")
+		close(acquired)
+		unlock()
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("parent lock acquired while child is held")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	unlockUsers()
+	unlockUsers()
+
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("parent lock was not acquired")
+	}
+}
+
+func TestLocksSemaphore(t *testing.T) {
+
+	user := &lockUser{}
+	registry := glue.NewMetricsRegistry()
+	ctn, err := glue.New(
+		glue.NewLocks(),
+		user,
+		registry,
+		glue.MapPropertySource{
+			"locks.db.limit":        "3",
+			"locks.db.orders.limit": "1",
+		},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	locks := user.Locks
+	require.Equal(t, 3, locks.Semaphore("db.users").Limit())
+	require.Equal(t, 1, locks.Semaphore("db.orders").Limit())
+	require.Equal(t, glue.DefaultSemaphoreLimit, locks.Semaphore("cache").Limit())
+	require.Same(t, locks.Semaphore("db.users"), locks.Semaphore("db.users"))
+
+	sem := locks.Semaphore("db.orders")
+	require.NoError(t, sem.Acquire(context.Background()))
+	require.False(t, sem.TryAcquire())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, sem.Acquire(ctx), context.DeadlineExceeded)
+
+	sem.Release()
+	require.True(t, sem.TryAcquire())
+	sem.Release()
+	require.Panics(t, sem.Release)
+
+	var acquired float64
+	for _, s := range registry.Snapshot() {
+		if s.Name == "locks_acquired_total" && s.Labels["lock"] == "db.orders" {
+			acquired = s.Value
+		}
+	}
+	require.Equal(t, float64(2), acquired)
+}
+
+func TestLocksZeroValue(t *testing.T) {
+
+	registry := glue.NewMetricsRegistry()
+	ctn, err := glue.New(registry)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	// the literal registered after the creation gets the recorder as well
+	locks := &glue.Locks{}
+	_, err = ctn.Register(locks)
+	require.NoError(t, err)
+
+	locks.Lock("db.users")()
+	sem := locks.Semaphore("db")
+	require.Equal(t, glue.DefaultSemaphoreLimit, sem.Limit())
+	require.True(t, sem.TryAcquire())
+	sem.Release()
+
+	var acquired bool
+	for _, s := range registry.Snapshot() {
+		if s.Name == "locks_acquired_total" {
+			acquired = true
+		}
+	}
+	require.True(t, acquired)
+
+	// not a bean at all
+	(&glue.Locks{}).Lock("db")()
+}
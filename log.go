@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+/**
+LogLevel limits messages of the container logger.
+Zero value means LogDebug for containers with the logger, so every message is written.
+*/
+
+type LogLevel int
+
+const (
+	LogDefault LogLevel = iota
+	LogOff
+	LogError
+	LogInfo
+	LogDebug
+)
+
+func (t LogLevel) String() string {
+	switch t {
+	case LogDefault:
+		return "DEFAULT"
+	case LogOff:
+		return "OFF"
+	case LogError:
+		return "ERROR"
+	case LogInfo:
+		return "INFO"
+	case LogDebug:
+		return "DEBUG"
+	default:
+		return fmt.Sprintf("LogLevel(%d)", int(t))
+	}
+}
+
+/**
+NopLogger discards messages without formatting them, WithLogger(glue.NopLogger) turns off the global Verbose logger for the container.
+*/
+
+var NopLogger ContainerLogger = nullLogger{}
+
+/**
+Returns true if messages of the level are written, always false in 'glue_nolog' builds
+*/
+
+func (t *container) logEnabled(level LogLevel) bool {
+	return logCompiled && t.loggerEnabled && level <= t.logLevel
+}
+
+/**
+Returns true if the next wiring message is written, only every logSampling-th message passes
+*/
+
+func (t *container) logWiring() bool {
+	if !t.logEnabled(LogDebug) {
+		return false
+	}
+	n := atomic.AddUint64(&t.wiringMessages, 1)
+	return t.logSampling <= 1 || (n-1)%uint64(t.logSampling) == 0
+}
+
+func (t *container) logf(level LogLevel, format string, v ...any) {
+	if t.logEnabled(level) {
+		t.logger.Printf(format, v...)
+	}
+}
+
+/**
+Reports how many wiring messages were dropped by sampling
+*/
+
+func (t *container) logSamplingSummary() {
+	if t.logSampling > 1 && t.logEnabled(LogDebug) {
+		total := atomic.LoadUint64(&t.wiringMessages)
+		t.logger.Printf("Wiring messages sampled 1 of %d, total %d\n", t.logSampling, total)
+	}
+}
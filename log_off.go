@@ -0,0 +1,11 @@
+//go:build glue_nolog
+
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+// logCompiled is false in builds with the 'glue_nolog' tag, so the compiler drops container logging.
+const logCompiled = false
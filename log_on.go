@@ -0,0 +1,11 @@
+//go:build !glue_nolog
+
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+// logCompiled is true in builds without the 'glue_nolog' tag, the container logs through its logger.
+const logCompiled = true
@@ -0,0 +1,59 @@
+//go:build !glue_nolog
+
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+func TestLogSampling(t *testing.T) {
+
+	beans := []any{&prototypeClock{}, &prototypeConsumer{}, glue.Prototype(&prototypeWorker{})}
+
+	full := &bufferLogger{}
+	ctn, err := glue.NewWithOptions(glue.WithLogger(full), glue.WithBeans(beans...))
+	require.NoError(t, err)
+	ctn.Close()
+
+	sampled := &bufferLogger{}
+	ctn, err = glue.NewWithOptions(glue.WithLogger(sampled), glue.WithLogSampling(1000), glue.WithBeans(beans...))
+	require.NoError(t, err)
+	ctn.Close()
+
+	require.Less(t, len(sampled.lines), len(full.lines))
+	require.Contains(t, strings.Join(sampled.lines, ""), "Wiring messages sampled 1 of 1000")
+}
+
+func TestLogLevel(t *testing.T) {
+
+	logger := &bufferLogger{}
+	ctn, err := glue.NewWithOptions(
+		glue.WithLogger(logger),
+		glue.WithLogLevel(glue.LogInfo),
+		glue.WithBeans(&prototypeClock{}, glue.MapPropertySource{"a": "b"}),
+	)
+	require.NoError(t, err)
+	ctn.Close()
+
+	require.Contains(t, logger.lines, "MapPropertySource 1\n")
+	for _, line := range logger.lines {
+		require.NotContains(t, line, "Construct Bean")
+	}
+
+	logger = &bufferLogger{}
+	ctn, err = glue.NewWithOptions(glue.WithLogger(logger), glue.WithLogLevel(glue.LogOff), glue.WithBeans(&prototypeClock{}))
+	require.NoError(t, err)
+	ctn.Close()
+	require.Empty(t, logger.lines)
+
+	require.Equal(t, "INFO", glue.LogInfo.String())
+}
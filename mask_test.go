@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+func TestPropertiesMaskPatterns(t *testing.T) {
+
+	props := glue.NewProperties()
+	props.Set("db.main.password", "qwerty")
+	props.Set("api.secret", "s3cr3t")
+	props.Set("db.main.user", "admin")
+
+	props.SetMaskPatterns("*.password", "*.secret")
+
+	dump := props.Dump()
+	require.Equal(t, "api.secret = ******\ndb.main.password = ******\ndb.main.user = admin\n", dump)
+
+	var buf bytes.Buffer
+	_, err := props.Save(&buf)
+	require.NoError(t, err)
+	require.Equal(t, dump, buf.String())
+
+	// values are still available for the application
+	value, ok := props.Get("db.main.password")
+	require.True(t, ok)
+	require.Equal(t, "qwerty", value)
+
+	props.SetMaskFunc(func(key, value string) string {
+		return "enc:" + strings.ToUpper(value)
+	})
+	require.Equal(t, "enc:QWERTY", props.MaskValue("db.main.password", "qwerty"))
+	require.Equal(t, "admin", props.MaskValue("db.main.user", "admin"))
+	require.True(t, strings.Contains(props.Dump(), "api.secret = enc:S3CR3T\n"))
+
+	props.SetMaskPatterns()
+	require.True(t, strings.Contains(props.Dump(), "api.secret = s3cr3t\n"))
+}
@@ -0,0 +1,215 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+/**
+Returns beans of the container and its parents having the method matching the func field, grouped by level
+*/
+
+func (t *container) methodCandidates(def *injectionDef) []beanlist {
+	var candidates []beanlist
+	level := 1
+	for ctx := t; ctx != nil; ctx = ctx.parent {
+		seen := make(map[*bean]bool)
+		var list []*bean
+		visit := func(b *bean) {
+			if seen[b] || b.beanDef == nil {
+				return
+			}
+			seen[b] = true
+			if _, ok := matchMethod(b.beanDef.classPtr, def); ok {
+				list = append(list, b)
+			}
+		}
+		for _, beans := range ctx.core {
+			for _, b := range beans {
+				visit(b)
+			}
+		}
+		ctx.registryMu.RLock()
+		for _, beans := range ctx.registry.core {
+			for _, b := range beans {
+				visit(b)
+			}
+		}
+		ctx.registryMu.RUnlock()
+		if list = t.visible(ctx, list); len(list) > 0 {
+			sort.SliceStable(list, func(i, j int) bool {
+				return beanLess(list[i], list[j])
+			})
+			candidates = append(candidates, beanlist{level: level, list: list})
+		}
+		level++
+	}
+	return candidates
+}
+
+/**
+Selects the single bean for the func field and the name of its method
+*/
+
+func (t *container) methodImpl(def *injectionDef) (*bean, string, error) {
+	deep := t.methodCandidates(def)
+	var list []*bean
+	if len(deep) > 0 {
+		list = def.filterBeans(orderBeans(levelBeans(deep, def.level)))
+	}
+	if len(list) == 0 {
+		if def.optional {
+			return nil, "", nil
+		}
+		return nil, "", def.missing(nil)
+	}
+	impl, err := selectSingleCandidate(def.fieldName, def.class, list)
+	if err != nil {
+		return nil, "", err
+	}
+	method, _ := matchMethod(impl.beanDef.classPtr, def)
+	return impl, method, nil
+}
+
+/**
+Returns the method of the class with the signature of the func field, the named one or the only matching one
+*/
+
+func matchMethod(class reflect.Type, def *injectionDef) (string, bool) {
+	want := funcSignature(def.fieldType)
+	if def.method != "" {
+		m, ok := class.MethodByName(def.method)
+		if !ok || classMethodType(class, m) != want {
+			return "", false
+		}
+		return def.method, true
+	}
+	if class.Kind() == reflect.Ptr && class.Elem().PkgPath() == gluePkgPath {
+		// beans of the container match by the method name only
+		return "", false
+	}
+	var found string
+	for i := 0; i < class.NumMethod(); i++ {
+		m := class.Method(i)
+		if classMethodType(class, m) == want {
+			if found != "" {
+				// ambiguous methods of the bean
+				return "", false
+			}
+			found = m.Name
+		}
+	}
+	return found, found != ""
+}
+
+/**
+Returns the unnamed func type of the method without the receiver
+*/
+
+func classMethodType(class reflect.Type, m reflect.Method) reflect.Type {
+	if class.Kind() == reflect.Interface {
+		return m.Type
+	}
+	return methodFuncType(m.Type)
+}
+
+func methodFuncType(method reflect.Type) reflect.Type {
+	in := make([]reflect.Type, 0, method.NumIn())
+	for i := 1; i < method.NumIn(); i++ {
+		in = append(in, method.In(i))
+	}
+	out := make([]reflect.Type, 0, method.NumOut())
+	for i := 0; i < method.NumOut(); i++ {
+		out = append(out, method.Out(i))
+	}
+	return reflect.FuncOf(in, out, method.IsVariadic())
+}
+
+func funcSignature(fn reflect.Type) reflect.Type {
+	in := make([]reflect.Type, 0, fn.NumIn())
+	for i := 0; i < fn.NumIn(); i++ {
+		in = append(in, fn.In(i))
+	}
+	out := make([]reflect.Type, 0, fn.NumOut())
+	for i := 0; i < fn.NumOut(); i++ {
+		out = append(out, fn.Out(i))
+	}
+	return reflect.FuncOf(in, out, fn.IsVariadic())
+}
+
+func bindMethod(field reflect.Value, impl *bean, method string) {
+	field.Set(impl.valuePtr.MethodByName(method).Convert(field.Type()))
+}
+
+/**
+Binds the func field to the method of the bean during the wiring, the bean becomes the dependency
+*/
+
+func (t *injection) injectMethod() error {
+	def := t.injectionDef
+	impl, method, err := t.ctn.methodImpl(def)
+	if err != nil || impl == nil {
+		return err
+	}
+
+	field := def.field(t.value)
+	if !field.CanSet() {
+		shadow, err := t.bean.setterField(t.value, def)
+		if err != nil {
+			return err
+		}
+		field = shadow
+	}
+
+	if impl.beenFactory != nil {
+		t.bean.recordInjected(def, impl)
+		t.bean.factoryDependencies = append(t.bean.factoryDependencies,
+			&factoryDependency{
+				factory: impl.beenFactory,
+				injection: func(service *bean) error {
+					bindMethod(field, service, method)
+					return nil
+				},
+			})
+		return nil
+	}
+
+	bindMethod(field, impl, method)
+	t.bean.recordInjected(def, impl)
+	if !def.lazy && t.bean != impl {
+		t.bean.dependencies = append(t.bean.dependencies, impl)
+	}
+	return nil
+}
+
+/**
+Binds the func field to the method of the initialized bean at runtime
+*/
+
+func (t *container) injectMethodRuntime(value reflect.Value, def *injectionDef) error {
+	impl, method, err := t.methodImpl(def)
+	if err != nil || impl == nil {
+		return err
+	}
+	if impl.beenFactory != nil {
+		if impl, _, err = impl.beenFactory.ctor(context.Background()); err != nil {
+			return fmt.Errorf("field '%s' in class '%v' can not be injected because of factory bean error: %w", def.fieldName, def.class, err)
+		}
+	}
+	if impl.lifecycle != BeanInitialized {
+		return fmt.Errorf("field '%s' in class '%v' can not be injected with non-initialized bean %+v", def.fieldName, def.class, impl)
+	}
+	field := def.field(value)
+	if !field.CanSet() {
+		return fmt.Errorf("func field '%s' in class '%v' must be exported to be injected", def.fieldName, def.class)
+	}
+	bindMethod(field, impl, method)
+	return nil
+}
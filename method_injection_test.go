@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type orderValidator struct {
+	prefix string
+}
+
+func (t *orderValidator) PostConstruct() error {
+	t.prefix = "order"
+	return nil
+}
+
+func (t *orderValidator) Validate(id string) error {
+	if !strings.HasPrefix(id, t.prefix) {
+		return fmt.Errorf("invalid id '%s'", id)
+	}
+	return nil
+}
+
+type greetingFormatter struct{}
+
+func (t *greetingFormatter) BeanName() string { return "greeting" }
+
+func (t *greetingFormatter) Hello(name string) string { return "hello " + name }
+
+func (t *greetingFormatter) Bye(name string) string { return "bye " + name }
+
+type FormatFunc func(string) string
+
+type orderHandler struct {
+	Validate func(string) error  `inject:""`
+	Hello    FormatFunc          `inject:"bean=greeting,method=Hello"`
+	Bye      func(string) string `inject:"method=Bye"`
+	Audit    func(int) bool      `inject:"optional"`
+}
+
+func TestMethodInjection(t *testing.T) {
+
+	handler := &orderHandler{}
+	ctn, err := glue.New(&orderValidator{}, &greetingFormatter{}, handler)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.NoError(t, handler.Validate("order-1"))
+	require.Error(t, handler.Validate("invoice-1"))
+	require.Equal(t, "hello bob", handler.Hello("bob"))
+	require.Equal(t, "bye bob", handler.Bye("bob"))
+	require.Nil(t, handler.Audit)
+
+	runtime := &orderHandler{}
+	require.NoError(t, ctn.Inject(runtime))
+	require.Equal(t, "hello alice", runtime.Hello("alice"))
+}
+
+type ambiguousFormatUser struct {
+	Format func(string) string `inject:""`
+}
+
+type missingMethodUser struct {
+	Parse func([]byte) (int, error) `inject:""`
+}
+
+func TestMethodInjectionErrors(t *testing.T) {
+
+	// Hello and Bye have the same signature
+	_, err := glue.New(&greetingFormatter{}, &ambiguousFormatUser{})
+	var missing *glue.MissingDependenciesError
+	require.True(t, errors.As(err, &missing), err)
+	require.Equal(t, "Format", missing.Missing[0].Field)
+
+	_, err = glue.New(&orderValidator{}, &missingMethodUser{})
+	require.True(t, errors.As(err, &missing), err)
+	require.Equal(t, "func([]uint8) (int, error)", missing.Missing[0].Type)
+}
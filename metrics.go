@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// BeanLabel is the label name that carries the bean name in recorders produced for MeteredBean.
+const BeanLabel = "bean"
+
+func (t *container) applyMetrics() {
+	var metered []*bean
+	for _, beans := range t.core {
+		for _, b := range beans {
+			if _, ok := b.obj.(MeteredBean); ok {
+				metered = append(metered, b)
+			}
+		}
+	}
+
+	if len(metered) == 0 {
+		return
+	}
+
+	metrics := t.metricsBean()
+	for _, b := range metered {
+		t.setRecorder(b, metrics)
+	}
+}
+
+func (t *container) metricsBean() Metrics {
+	if list := t.Bean(MetricsClass, DefaultSearchLevel); len(list) > 0 {
+		metrics, _ := list[0].Object().(Metrics)
+		return metrics
+	}
+	return nil
+}
+
+func (t *container) setRecorder(b *bean, metrics Metrics) {
+	var recorder MetricsRecorder = noopRecorder{}
+	if metrics != nil {
+		recorder = metrics.Recorder(b.name)
+		if len(t.labels) > 0 {
+			recorder = &labeledRecorder{recorder: recorder, labels: t.labels.pairs()}
+		}
+	}
+	t.logf(LogInfo, "MeteredBean '%s' recorder %T\n", b.name, recorder)
+	b.obj.(MeteredBean).SetMetricsRecorder(recorder)
+}
+
+type noopRecorder struct {
+}
+
+func (noopRecorder) Add(name string, delta float64, labels ...string) {}
+
+func (noopRecorder) Set(name string, value float64, labels ...string) {}
+
+func (noopRecorder) Observe(name string, value float64, labels ...string) {}
+
+// MetricKind is the kind of recorded metric.
+type MetricKind int
+
+const (
+	MetricCounter MetricKind = iota
+	MetricGauge
+	MetricSummary
+)
+
+func (k MetricKind) String() string {
+	switch k {
+	case MetricCounter:
+		return "counter"
+	case MetricGauge:
+		return "gauge"
+	case MetricSummary:
+		return "summary"
+	default:
+		return "unknown"
+	}
+}
+
+// MetricSample is a point-in-time value of one metric series.
+type MetricSample struct {
+	Name   string
+	Kind   MetricKind
+	Labels map[string]string
+	// Value is the counter or gauge value, or the sum of observations for summary.
+	Value float64
+	// Count is the number of observations for summary.
+	Count uint64
+}
+
+// MetricsRegistry is the in-memory Metrics bean.
+type MetricsRegistry struct {
+	mu     sync.Mutex
+	series map[string]*MetricSample
+}
+
+// NewMetricsRegistry creates the in-memory Metrics bean.
+func NewMetricsRegistry() *MetricsRegistry {
+	return &MetricsRegistry{
+		series: make(map[string]*MetricSample),
+	}
+}
+
+func (t *MetricsRegistry) Recorder(beanName string) MetricsRecorder {
+	return &registryRecorder{registry: t, labels: []string{BeanLabel, beanName}}
+}
+
+// Snapshot returns copy of all metric series sorted by name and labels.
+func (t *MetricsRegistry) Snapshot() []MetricSample {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	list := make([]MetricSample, 0, len(t.series))
+	keys := make([]string, 0, len(t.series))
+	for k := range t.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		s := *t.series[k]
+		labels := make(map[string]string, len(s.Labels))
+		for lk, lv := range s.Labels {
+			labels[lk] = lv
+		}
+		s.Labels = labels
+		list = append(list, s)
+	}
+	return list
+}
+
+func (t *MetricsRegistry) record(kind MetricKind, name string, value float64, labels []string) {
+	m := make(map[string]string, len(labels)/2)
+	for i := 0; i+1 < len(labels); i += 2 {
+		m[labels[i]] = labels[i+1]
+	}
+	key := seriesKey(name, m)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s, ok := t.series[key]
+	if !ok {
+		s = &MetricSample{Name: name, Kind: kind, Labels: m}
+		t.series[key] = s
+	}
+	switch kind {
+	case MetricCounter:
+		s.Value += value
+	case MetricGauge:
+		s.Value = value
+	case MetricSummary:
+		s.Value += value
+		s.Count++
+	}
+}
+
+func seriesKey(name string, labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var out strings.Builder
+	out.WriteString(name)
+	for _, k := range keys {
+		out.WriteByte('|')
+		out.WriteString(k)
+		out.WriteByte('=')
+		out.WriteString(labels[k])
+	}
+	return out.String()
+}
+
+type registryRecorder struct {
+	registry *MetricsRegistry
+	labels   []string
+}
+
+func (t *registryRecorder) withLabels(labels []string) []string {
+	return append(append(make([]string, 0, len(t.labels)+len(labels)), t.labels...), labels...)
+}
+
+func (t *registryRecorder) Add(name string, delta float64, labels ...string) {
+	t.registry.record(MetricCounter, name, delta, t.withLabels(labels))
+}
+
+func (t *registryRecorder) Set(name string, value float64, labels ...string) {
+	t.registry.record(MetricGauge, name, value, t.withLabels(labels))
+}
+
+func (t *registryRecorder) Observe(name string, value float64, labels ...string) {
+	t.registry.record(MetricSummary, name, value, t.withLabels(labels))
+}
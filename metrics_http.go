@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MetricsEnabledProperty turns on the MetricsHandler bean.
+var MetricsEnabledProperty = "metrics.enabled"
+
+/**
+MetricsHandler is the Metrics bean that serves recorded metrics in Prometheus text format.
+It is registered only if property 'metrics.enabled' is true, mount it in the HTTP server by HandlerPattern().
+*/
+
+type MetricsHandler struct {
+	Path string `value:"metrics.path,default=/metrics"`
+
+	once     sync.Once
+	registry *MetricsRegistry
+}
+
+func NewMetricsHandler() *MetricsHandler {
+	return &MetricsHandler{}
+}
+
+func (t *MetricsHandler) metrics() *MetricsRegistry {
+	t.once.Do(func() {
+		t.registry = NewMetricsRegistry()
+	})
+	return t.registry
+}
+
+func (t *MetricsHandler) ShouldRegisterWithProperties(properties Properties) bool {
+	return properties.GetBool(MetricsEnabledProperty, false)
+}
+
+func (t *MetricsHandler) HandlerPattern() string {
+	return t.Path
+}
+
+func (t *MetricsHandler) Recorder(beanName string) MetricsRecorder {
+	return t.metrics().Recorder(beanName)
+}
+
+func (t *MetricsHandler) Snapshot() []MetricSample {
+	return t.metrics().Snapshot()
+}
+
+func (t *MetricsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	WritePrometheus(w, t.metrics().Snapshot())
+}
+
+/**
+WritePrometheus writes samples in Prometheus text exposition format.
+*/
+
+func WritePrometheus(w io.Writer, samples []MetricSample) error {
+	var out strings.Builder
+	var last string
+	for _, s := range samples {
+		name := prometheusName(s.Name, true)
+		if name != last {
+			out.WriteString(fmt.Sprintf("# TYPE %s %s\n", name, s.Kind))
+			last = name
+		}
+		labels := prometheusLabels(s.Labels)
+		if s.Kind == MetricSummary {
+			out.WriteString(fmt.Sprintf("%s_sum%s %s\n", name, labels, strconv.FormatFloat(s.Value, 'g', -1, 64)))
+			out.WriteString(fmt.Sprintf("%s_count%s %d\n", name, labels, s.Count))
+		} else {
+			out.WriteString(fmt.Sprintf("%s%s %s\n", name, labels, strconv.FormatFloat(s.Value, 'g', -1, 64)))
+		}
+	}
+	_, err := io.WriteString(w, out.String())
+	return err
+}
+
+func prometheusLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var out strings.Builder
+	out.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			out.WriteByte(',')
+		}
+		out.WriteString(prometheusName(k, false))
+		out.WriteString(`="`)
+		out.WriteString(strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(labels[k]))
+		out.WriteByte('"')
+	}
+	out.WriteByte('}')
+	return out.String()
+}
+
+func prometheusName(name string, allowColon bool) string {
+	var out strings.Builder
+	for i, r := range name {
+		switch {
+		case r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z':
+			out.WriteRune(r)
+		case r >= '0' && r <= '9' && i > 0:
+			out.WriteRune(r)
+		case r == ':' && allowColon:
+			out.WriteRune(r)
+		default:
+			out.WriteByte('_')
+		}
+	}
+	return out.String()
+}
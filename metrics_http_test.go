@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type meteredCache struct {
+	recorder glue.MetricsRecorder
+}
+
+func (t *meteredCache) SetMetricsRecorder(recorder glue.MetricsRecorder) {
+	t.recorder = recorder
+}
+
+func TestMetricsHandlerEnabled(t *testing.T) {
+
+	cache := &meteredCache{}
+	ctn, err := glue.New(
+		cache,
+		glue.NewMetricsHandler(),
+		glue.MapPropertySource{"metrics.enabled": "true"},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	handler, err := glue.GetBean[*glue.MetricsHandler](ctn)
+	require.NoError(t, err)
+	require.Equal(t, "/metrics", handler.HandlerPattern())
+
+	cache.recorder.Add("cache.hits", 2, "region", "eu")
+	cache.recorder.Set("cache_size", 10)
+	cache.recorder.Observe("load_seconds", 0.5)
+	cache.recorder.Observe("load_seconds", 1.5)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	require.Equal(t, "# TYPE cache_hits counter\n"+
+		"cache_hits{bean=\"*glue_test.meteredCache\",region=\"eu\"} 2\n"+
+		"# TYPE cache_size gauge\n"+
+		"cache_size{bean=\"*glue_test.meteredCache\"} 10\n"+
+		"# TYPE load_seconds summary\n"+
+		"load_seconds_sum{bean=\"*glue_test.meteredCache\"} 2\n"+
+		"load_seconds_count{bean=\"*glue_test.meteredCache\"} 2\n", rec.Body.String())
+	require.Contains(t, rec.Header().Get("Content-Type"), "text/plain")
+}
+
+func TestMetricsHandlerDisabled(t *testing.T) {
+
+	cache := &meteredCache{}
+	ctn, err := glue.New(
+		cache,
+		glue.NewMetricsHandler(),
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Empty(t, ctn.Bean(glue.MetricsClass, glue.DefaultSearchLevel))
+
+	// noop recorder
+	cache.recorder.Add("cache.hits", 1)
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type meteredWorker struct {
+	recorder glue.MetricsRecorder
+}
+
+func (t *meteredWorker) BeanName() string { return "worker" }
+
+func (t *meteredWorker) SetMetricsRecorder(recorder glue.MetricsRecorder) {
+	t.recorder = recorder
+}
+
+func (t *meteredWorker) PostConstruct() error {
+	t.recorder.Add("jobs_total", 1, "queue", "default")
+	t.recorder.Set("queue_depth", 5)
+	t.recorder.Observe("job_seconds", 0.5)
+	t.recorder.Observe("job_seconds", 1.5)
+	return nil
+}
+
+func TestMetrics_MeteredBeanRecorder(t *testing.T) {
+	registry := glue.NewMetricsRegistry()
+	worker := &meteredWorker{}
+
+	ctn, err := glue.New(registry, worker)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	samples := registry.Snapshot()
+	require.Len(t, samples, 3)
+
+	byName := make(map[string]glue.MetricSample)
+	for _, s := range samples {
+		byName[s.Name] = s
+		require.Equal(t, "worker", s.Labels[glue.BeanLabel])
+	}
+	require.Equal(t, 1.0, byName["jobs_total"].Value)
+	require.Equal(t, "default", byName["jobs_total"].Labels["queue"])
+	require.Equal(t, glue.MetricGauge, byName["queue_depth"].Kind)
+	require.Equal(t, 2.0, byName["job_seconds"].Value)
+	require.Equal(t, uint64(2), byName["job_seconds"].Count)
+}
+
+func TestMetrics_NoopWithoutRegistry(t *testing.T) {
+	worker := &meteredWorker{}
+
+	ctn, err := glue.New(worker)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.NotNil(t, worker.recorder)
+}
+
+func TestMetrics_RegistryFromParent(t *testing.T) {
+	registry := glue.NewMetricsRegistry()
+	parent, err := glue.New(registry)
+	require.NoError(t, err)
+	defer parent.Close()
+
+	worker := &meteredWorker{}
+	child, err := parent.Extend(worker)
+	require.NoError(t, err)
+	defer child.Close()
+
+	require.Len(t, registry.Snapshot(), 3)
+}
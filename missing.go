@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// NearMissMethods is the maximum number of missing or mismatched methods of the bean reported as the near miss of the interface
+var NearMissMethods = 2
+
+/**
+MissingDependency is the required field of the bean without candidates to inject.
+*/
+
+type MissingDependency struct {
+	Bean       string   // type of the bean with the field
+	Field      string   // field name
+	Type       string   // wanted type of the field or of its elements
+	Qualifier  string   // wanted bean name, empty if any
+	Candidates []string // beans of the wanted type rejected by the qualifier
+	NearMisses []string // beans almost implementing the wanted interface with the difference
+}
+
+func (t MissingDependency) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s.%s wants '%s'", t.Bean, t.Field, t.Type))
+	if t.Qualifier != "" {
+		sb.WriteString(fmt.Sprintf(" with qualifier '%s'", t.Qualifier))
+	}
+	if len(t.Candidates) > 0 {
+		sb.WriteString(", candidates: ")
+		sb.WriteString(strings.Join(t.Candidates, ", "))
+	} else {
+		sb.WriteString(", candidates: none")
+	}
+	if len(t.NearMisses) > 0 {
+		sb.WriteString(", near misses: ")
+		sb.WriteString(strings.Join(t.NearMisses, "; "))
+	}
+	return sb.String()
+}
+
+/**
+MissingDependenciesError is returned by the container creation with every required field
+that can not be injected, so all of them are fixed in one pass.
+*/
+
+type MissingDependenciesError struct {
+	Missing []MissingDependency
+}
+
+func (t *MissingDependenciesError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("can not find candidates for %d required fields:", len(t.Missing)))
+	for _, m := range t.Missing {
+		sb.WriteString("\n    ")
+		sb.WriteString(m.String())
+	}
+	return sb.String()
+}
+
+/**
+Error of the single required field without candidates, collected by the container in to MissingDependenciesError
+*/
+
+type missingDependencyError struct {
+	MissingDependency
+}
+
+func (t *missingDependencyError) Error() string {
+	if t.Qualifier != "" {
+		return fmt.Sprintf("can not find candidates to inject the required field '%s' in class '%s' with qualifier '%s'", t.Field, t.Bean, t.Qualifier)
+	}
+	return fmt.Sprintf("can not find candidates to inject the required field '%s' in class '%s'", t.Field, t.Bean)
+}
+
+func (t *injectionDef) missing(candidates []*bean) *missingDependencyError {
+	m := MissingDependency{
+		Bean:      t.class.String(),
+		Field:     t.fieldName,
+		Type:      t.fieldType.String(),
+		Qualifier: t.qualifier,
+	}
+	for _, b := range candidates {
+		m.Candidates = append(m.Candidates, beanGraphName(b))
+	}
+	return &missingDependencyError{MissingDependency: m}
+}
+
+/**
+Returns MissingDependenciesError with dependencies sorted by bean and field, nil if nothing is missing
+*/
+
+func missingDependencies(list []*missingDependencyError) error {
+	if len(list) == 0 {
+		return nil
+	}
+	err := &MissingDependenciesError{}
+	for _, m := range list {
+		err.Missing = append(err.Missing, m.MissingDependency)
+	}
+	sort.Slice(err.Missing, func(i, j int) bool {
+		a, b := err.Missing[i], err.Missing[j]
+		if a.Bean != b.Bean {
+			return a.Bean < b.Bean
+		}
+		return a.Field < b.Field
+	})
+	return err
+}
+
+/**
+Returns beans of the container and its parents that do not implement the interface by at most NearMissMethods methods,
+beans sharing no method name with the interface are not reported
+*/
+
+func (t *container) nearMisses(iface reflect.Type) []string {
+	if iface.Kind() != reflect.Interface || iface.NumMethod() == 0 {
+		return nil
+	}
+	seen := make(map[reflect.Type]bool)
+	var list []string
+	visit := func(b *bean) {
+		var class reflect.Type
+		if b.beanDef != nil {
+			class = b.beanDef.classPtr
+		} else if b.obj != nil {
+			class = reflect.TypeOf(b.obj)
+		}
+		if class == nil || seen[class] || class.Implements(iface) {
+			return
+		}
+		seen[class] = true
+		if diff, ok := methodDiff(class, iface); ok {
+			if name := beanGraphName(b); name != class.String() {
+				list = append(list, fmt.Sprintf("'%s' (%v) %s", name, class, diff))
+			} else {
+				list = append(list, fmt.Sprintf("%v %s", class, diff))
+			}
+		}
+	}
+	for c := t; c != nil; c = c.parent {
+		for _, beans := range c.core {
+			for _, b := range beans {
+				visit(b)
+			}
+		}
+		c.registryMu.RLock()
+		var registered []*bean
+		for _, beans := range c.registry.core {
+			registered = append(registered, beans...)
+		}
+		c.registryMu.RUnlock()
+		for _, b := range registered {
+			visit(b)
+		}
+	}
+	sort.Strings(list)
+	return list
+}
+
+/**
+Describes why the class does not implement the interface, false if it is not close enough
+*/
+
+func methodDiff(class, iface reflect.Type) (string, bool) {
+	if class.Kind() != reflect.Ptr && reflect.PtrTo(class).Implements(iface) {
+		return fmt.Sprintf("implements '%v' only by pointer receivers, register *%v", iface, class), true
+	}
+	var diff []string
+	named := 0
+	for i := 0; i < iface.NumMethod(); i++ {
+		want := iface.Method(i)
+		have, ok := class.MethodByName(want.Name)
+		if !ok {
+			diff = append(diff, fmt.Sprintf("missing %s%s", want.Name, strings.TrimPrefix(want.Type.String(), "func")))
+			continue
+		}
+		named++
+		// the method of the type has the receiver as the first argument
+		if got := methodSignature(have.Type); got != want.Type.String() {
+			diff = append(diff, fmt.Sprintf("%s has %s, wants %s", want.Name, strings.TrimPrefix(got, "func"), strings.TrimPrefix(want.Type.String(), "func")))
+		}
+	}
+	if named == 0 || len(diff) > NearMissMethods {
+		return "", false
+	}
+	return strings.Join(diff, " and "), true
+}
+
+func methodSignature(method reflect.Type) string {
+	return methodFuncType(method).String()
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type missingRepo struct{}
+
+type missingCache interface {
+	Get(key string) string
+}
+
+type missingCacheImpl struct{}
+
+func (t *missingCacheImpl) Get(key string) string { return key }
+
+func (t *missingCacheImpl) BeanName() string { return "slowCache" }
+
+type missingService struct {
+	Repo  *missingRepo `inject:""`
+	Cache missingCache `inject:"fastCache"`
+	Other missingCache `inject:""`
+}
+
+type missingConsumer struct {
+	Repo *missingRepo `inject:""`
+}
+
+func TestMissingDependencies(t *testing.T) {
+
+	_, err := glue.New(&missingService{}, &missingConsumer{}, &missingCacheImpl{})
+	require.Error(t, err)
+
+	var missing *glue.MissingDependenciesError
+	require.True(t, errors.As(err, &missing), err)
+	require.Equal(t, []glue.MissingDependency{
+		{Bean: "glue_test.missingConsumer", Field: "Repo", Type: "*glue_test.missingRepo"},
+		{Bean: "glue_test.missingService", Field: "Cache", Type: "glue_test.missingCache", Qualifier: "fastCache", Candidates: []string{"slowCache"}},
+		{Bean: "glue_test.missingService", Field: "Repo", Type: "*glue_test.missingRepo"},
+	}, missing.Missing)
+
+	require.Contains(t, err.Error(), "can not find candidates for 3 required fields")
+	require.Contains(t, err.Error(), "glue_test.missingService.Cache wants 'glue_test.missingCache' with qualifier 'fastCache', candidates: slowCache")
+}
+
+type missingStore interface {
+	Load(key string) (string, error)
+	Store(key, value string) error
+	Flush() error
+}
+
+// Store has the wrong signature and Flush is missing
+type almostStore struct{}
+
+func (t *almostStore) Load(key string) (string, error) { return key, nil }
+
+func (t *almostStore) Store(key string, value []byte) error { return nil }
+
+type unrelatedBean struct{}
+
+func (t *unrelatedBean) Close() error { return nil }
+
+type missingStoreUser struct {
+	Store missingStore `inject:""`
+}
+
+func TestMissingDependencyNearMisses(t *testing.T) {
+
+	_, err := glue.New(&missingStoreUser{}, &almostStore{}, &unrelatedBean{})
+	require.Error(t, err)
+
+	var missing *glue.MissingDependenciesError
+	require.True(t, errors.As(err, &missing), err)
+	require.Len(t, missing.Missing, 1)
+	require.Equal(t, []string{
+		"*glue_test.almostStore missing Flush() error and Store has (string, []uint8) error, wants (string, string) error",
+	}, missing.Missing[0].NearMisses)
+	require.Contains(t, err.Error(), "near misses: *glue_test.almostStore missing Flush() error")
+
+	ctn, err := glue.New(&almostStore{})
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	_, err = ctn.Register(&missingStoreUser{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "missing Flush() error")
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ProviderPrefix is the prefix of names of module methods registered as constructor functions.
+const ProviderPrefix = "Provide"
+
+/**
+Returns constructor factory beans of provider methods of the module, ordered by method name.
+The module is injected in to the hidden parameter, so it is initialized before its providers are called.
+*/
+
+func moduleProviders(module Module) ([]any, error) {
+	value := reflect.ValueOf(module)
+	classPtr := value.Type()
+	if classPtr.Kind() != reflect.Ptr || classPtr.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("module '%v' must be pointer to struct", classPtr)
+	}
+
+	listed := make(map[string]bool)
+	for _, name := range module.Providers() {
+		if _, ok := classPtr.MethodByName(name); !ok {
+			return nil, fmt.Errorf("module '%v' does not have provider method '%s'", classPtr, name)
+		}
+		listed[name] = true
+	}
+
+	moduleField := reflect.StructField{
+		Name: "Module",
+		Type: classPtr,
+		Tag:  `inject:""`,
+	}
+	var providers []any
+	for i := 0; i < classPtr.NumMethod(); i++ {
+		method := classPtr.Method(i)
+		if !listed[method.Name] && !isProviderName(method.Name) {
+			continue
+		}
+		ctor, err := newCtorFactory(value.Method(i).Interface(), []reflect.StructField{moduleField})
+		if err != nil {
+			return nil, fmt.Errorf("module '%v' provider '%s': %w", classPtr, method.Name, err)
+		}
+		ctor.name = fmt.Sprintf("provider (%v).%s", classPtr, method.Name)
+		providers = append(providers, ctor)
+	}
+	return providers, nil
+}
+
+/**
+Returns true for names like ProvideUserRepo, but not for Provider or Providers
+*/
+
+func isProviderName(name string) bool {
+	if !strings.HasPrefix(name, ProviderPrefix) || len(name) == len(ProviderPrefix) {
+		return false
+	}
+	r, _ := utf8.DecodeRuneInString(name[len(ProviderPrefix):])
+	return unicode.IsUpper(r) || unicode.IsDigit(r)
+}
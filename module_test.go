@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type moduleDB struct {
+	url string
+}
+
+type moduleRepo struct {
+	db *moduleDB
+}
+
+type ModuleCache interface {
+	CacheName() string
+}
+
+type moduleCache struct {
+	name string
+}
+
+func (t *moduleCache) CacheName() string { return t.name }
+
+type dataModule struct {
+	Properties  glue.Properties `inject:""`
+	initialized bool
+}
+
+func (t *dataModule) PostConstruct() error {
+	t.initialized = true
+	return nil
+}
+
+func (t *dataModule) Providers() []string { return []string{"NewCache"} }
+
+func (t *dataModule) ProvideDB(ctx context.Context) (*moduleDB, error) {
+	if !t.initialized {
+		panic("module is not initialized")
+	}
+	return &moduleDB{url: t.Properties.GetString("db.url", "")}, nil
+}
+
+func (t *dataModule) ProvideRepo(db *moduleDB) *moduleRepo {
+	return &moduleRepo{db: db}
+}
+
+func (t *dataModule) NewCache() ModuleCache {
+	return &moduleCache{name: "users"}
+}
+
+// not a provider
+func (t *dataModule) Provider() string { return "" }
+
+type moduleConsumer struct {
+	Repo  *moduleRepo `inject:""`
+	Cache ModuleCache `inject:""`
+}
+
+type brokenModule struct{}
+
+func (t *brokenModule) Providers() []string { return []string{"Missing"} }
+
+func TestModuleProviders(t *testing.T) {
+
+	skipUnsupported(t, glue.FeatureConstructors)
+
+	consumer := &moduleConsumer{}
+	ctn, err := glue.New(
+		glue.MapPropertySource{"db.url": "postgres://localhost"},
+		consumer,
+		&dataModule{},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.NotNil(t, consumer.Repo)
+	require.Equal(t, "postgres://localhost", consumer.Repo.db.url)
+	require.Equal(t, "users", consumer.Cache.CacheName())
+
+	_, err = glue.New(&brokenModule{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Missing")
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"reflect"
+)
+
+/**
+Optional is the injection field resolved on every Get call, so the dependency registered later,
+e.g. in the child container created by Extend, becomes visible without nil checks:
+
+	type service struct {
+		Cache glue.Optional[Cache] `inject:""`
+	}
+
+	if cache, ok := t.Cache.Get(); ok {
+		...
+	}
+
+Field of type func() (T, bool) with the 'inject' tag works the same way.
+*/
+
+type Optional[T any] struct {
+	lookup func() (any, bool)
+}
+
+/**
+Get returns the single candidate of the container, its parents by search level and then of child containers,
+the newest child first. Returns false if there is no candidate or several without primary.
+*/
+
+func (t Optional[T]) Get() (T, bool) {
+	var zero T
+	if t.lookup == nil {
+		return zero, false
+	}
+	obj, ok := t.lookup()
+	if !ok {
+		return zero, false
+	}
+	v, ok := obj.(T)
+	return v, ok
+}
+
+func (t *Optional[T]) optionalType() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func (t *Optional[T]) bind(lookup func() (any, bool)) {
+	t.lookup = lookup
+}
+
+type optionalBinder interface {
+	optionalType() reflect.Type
+	bind(lookup func() (any, bool))
+}
+
+var optionalBinderClass = reflect.TypeOf((*optionalBinder)(nil)).Elem()
+
+/**
+Returns T of Optional[T], func() (T, bool) and Provider[T] field types, optional is false for Provider[T]
+*/
+
+func getterType(fieldType reflect.Type) (elem reflect.Type, optional bool, ok bool) {
+	ptr := reflect.PtrTo(fieldType)
+	if ptr.Implements(optionalBinderClass) {
+		return reflect.New(fieldType).Interface().(optionalBinder).optionalType(), true, true
+	}
+	if ptr.Implements(providerBinderClass) {
+		return reflect.New(fieldType).Interface().(providerBinder).providerType(), false, true
+	}
+	if fieldType.Kind() == reflect.Func && fieldType.NumIn() == 0 && fieldType.NumOut() == 2 && fieldType.Out(1).Kind() == reflect.Bool {
+		return fieldType.Out(0), true, true
+	}
+	return nil, false, false
+}
+
+/**
+Sets the getter in to the field, b is nil for runtime injections without the bean
+*/
+
+func (t *container) bindGetter(value reflect.Value, def *injectionDef, b *bean) error {
+	field := def.field(value)
+	if field.Kind() == reflect.Func && !hasProviders {
+		return requireFeature(FeatureProviders)
+	}
+	if field.CanSet() {
+		field.Set(t.getterValue(def, field.Type()))
+		return nil
+	}
+	if b != nil {
+		shadow, err := b.setterField(value, def)
+		if err != nil {
+			return err
+		}
+		shadow.Set(t.getterValue(def, field.Type()))
+		return nil
+	}
+	if !hasSetters {
+		return requireFeature(FeatureSetters)
+	}
+	if !value.CanAddr() {
+		return notPublicErr(def.fieldName, def.class)
+	}
+	set, ok := findSetter(value.Addr(), def.fieldName, field.Type())
+	if !ok {
+		return notPublicErr(def.fieldName, def.class)
+	}
+	return set(t.getterValue(def, field.Type()))
+}
+
+func (t *container) getterValue(def *injectionDef, typ reflect.Type) reflect.Value {
+	if reflect.PtrTo(typ).Implements(providerBinderClass) {
+		getter := reflect.New(typ)
+		getter.Interface().(providerBinder).bind(t.providerResolver(def))
+		return getter.Elem()
+	}
+	lookup := func() (any, bool) {
+		return t.lookupOptional(def)
+	}
+	// func getters are rejected by bindGetter in builds without FeatureProviders
+	if hasProviders && typ.Kind() == reflect.Func {
+		return reflect.MakeFunc(typ, func([]reflect.Value) []reflect.Value {
+			if obj, ok := lookup(); ok {
+				return []reflect.Value{reflect.ValueOf(obj), reflect.ValueOf(true)}
+			}
+			return []reflect.Value{reflect.Zero(def.fieldType), reflect.ValueOf(false)}
+		})
+	}
+	getter := reflect.New(typ)
+	getter.Interface().(optionalBinder).bind(lookup)
+	return getter.Elem()
+}
+
+func (t *container) lookupOptional(def *injectionDef) (any, bool) {
+	if deep := t.getBean(def.fieldType); len(deep) > 0 {
+		var list []*bean
+		for _, b := range def.filterBeans(orderBeans(levelBeans(deep, def.level))) {
+			if b.obj != nil {
+				list = append(list, b)
+			}
+		}
+		if len(list) > 0 {
+			impl, err := selectSingleCandidate(def.fieldName, def.class, list)
+			if err != nil {
+				t.logf(LogError, "Optional field '%s' in '%v': %v\n", def.fieldName, def.class, err)
+				return nil, false
+			}
+			t.recordLookup(def.fieldType, []*bean{impl})
+			return t.prototypeInstance(impl).obj, true
+		}
+	}
+	t.extendedMu.Lock()
+	children := append([]*container(nil), t.extended...)
+	t.extendedMu.Unlock()
+	for j := len(children) - 1; j >= 0; j-- {
+		if obj, ok := children[j].lookupOptional(def); ok {
+			return obj, true
+		}
+	}
+	return nil, false
+}
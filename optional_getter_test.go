@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type optionalCache interface {
+	Lookup(key string) string
+}
+
+type optionalCacheImpl struct {
+	prefix string
+}
+
+func (t *optionalCacheImpl) Lookup(key string) string {
+	return t.prefix + key
+}
+
+type optionalConsumer struct {
+	Cache  glue.Optional[optionalCache]      `inject:""`
+	Direct func() (*optionalCacheImpl, bool) `inject:""`
+	Named  glue.Optional[optionalCache]      `inject:"bean=missing"`
+}
+
+func TestOptionalGetter(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
+	consumer := &optionalConsumer{}
+	parent, err := glue.New(consumer)
+	require.NoError(t, err)
+	defer parent.Close()
+
+	_, ok := consumer.Cache.Get()
+	require.False(t, ok)
+	_, ok = consumer.Direct()
+	require.False(t, ok)
+
+	// registered later in the child container
+	impl := &optionalCacheImpl{prefix: "child:"}
+	child, err := parent.Extend(impl)
+	require.NoError(t, err)
+
+	cache, ok := consumer.Cache.Get()
+	require.True(t, ok)
+	require.Equal(t, "child:a", cache.Lookup("a"))
+
+	direct, ok := consumer.Direct()
+	require.True(t, ok)
+	require.Same(t, impl, direct)
+
+	_, ok = consumer.Named.Get()
+	require.False(t, ok)
+
+	require.NoError(t, child.Close())
+	_, ok = consumer.Cache.Get()
+	require.False(t, ok)
+
+	// zero value without injection
+	var empty glue.Optional[optionalCache]
+	_, ok = empty.Get()
+	require.False(t, ok)
+}
+
+func TestOptionalGetterInContainer(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
+	impl := &optionalCacheImpl{prefix: "own:"}
+	consumer := &optionalConsumer{}
+	ctn, err := glue.New(impl, consumer)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	cache, ok := consumer.Cache.Get()
+	require.True(t, ok)
+	require.Equal(t, "own:a", cache.Lookup("a"))
+
+	other := &optionalConsumer{}
+	require.NoError(t, ctn.Inject(other))
+	direct, ok := other.Direct()
+	require.True(t, ok)
+	require.Same(t, impl, direct)
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"reflect"
+)
+
+/**
+Override registers the replacement instead of the original registrations of the scan list, so tests substitute
+a bean and keep the rest of the wiring, e.g. glue.New(app.Beans(), glue.Override("userService", &mockUserService{})).
+
+The original is one of:
+  - string, the bean name or alias
+  - reflect.Type, the registered pointer type or the interface implemented by registered beans
+  - the registered object itself, or the nil pointer of the registered type
+
+In Extend the original beans of the parent stay registered, beans of the child container see the replacement first.
+*/
+
+func Override(original any, replacement any) any {
+	return &beanOverride{original: original, replacement: replacement}
+}
+
+/**
+Replace registers the replacement instead of beans with the same name if it is NamedBean, otherwise of the same type.
+*/
+
+func Replace(replacement any) any {
+	obj := unwrapBeanObj(replacement)
+	if named, ok := obj.(NamedBean); ok {
+		return Override(named.BeanName(), replacement)
+	}
+	return Override(reflect.TypeOf(obj), replacement)
+}
+
+type beanOverride struct {
+	original    any
+	replacement any
+	matched     int
+}
+
+/**
+Returns true if the scan item is the original registration
+*/
+
+func (t *beanOverride) matches(item any) bool {
+	obj, options := unwrapBean(item)
+	if obj == nil || obj == unwrapBeanObj(t.replacement) {
+		return false
+	}
+	switch original := t.original.(type) {
+	case string:
+		if named, ok := obj.(NamedBean); ok && named.BeanName() == original {
+			return true
+		}
+		b := &bean{}
+		for _, opt := range options {
+			opt(b)
+		}
+		for _, alias := range b.aliases {
+			if alias == original {
+				return true
+			}
+		}
+		return false
+	case reflect.Type:
+		objType := reflect.TypeOf(obj)
+		if original.Kind() == reflect.Interface {
+			return objType.Implements(original)
+		}
+		return objType == original
+	default:
+		originalValue := reflect.ValueOf(original)
+		objValue := reflect.ValueOf(obj)
+		if originalValue.Kind() != reflect.Ptr || objValue.Kind() != reflect.Ptr {
+			return false
+		}
+		if originalValue.IsNil() {
+			return objValue.Type() == originalValue.Type()
+		}
+		return objValue.Pointer() == originalValue.Pointer()
+	}
+}
+
+/**
+Collects overrides of the scan list before registration
+*/
+
+func collectOverrides(active map[string]struct{}, scan []any) ([]*beanOverride, error) {
+	var list []*beanOverride
+	err := forEach(active, "", scan, func(pos string, obj any) error {
+		if o, ok := unwrapBeanObj(obj).(*beanOverride); ok {
+			if o.replacement == nil {
+				return fmt.Errorf("override of '%v' has nil replacement", o.original)
+			}
+			list = append(list, o)
+		}
+		return nil
+	})
+	return list, err
+}
+
+func findOverride(overrides []*beanOverride, item any) bool {
+	for _, o := range overrides {
+		if o.matches(item) {
+			o.matched++
+			return true
+		}
+	}
+	return false
+}
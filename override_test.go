@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type AccountService interface {
+	Account(id int) string
+}
+
+var AccountServiceClass = reflect.TypeOf((*AccountService)(nil)).Elem()
+
+type dbAccountService struct {
+	Clock *prototypeClock `inject:""`
+}
+
+func (t *dbAccountService) Account(id int) string {
+	return "db"
+}
+
+type mockAccountService struct {
+	name string
+}
+
+func (t *mockAccountService) Account(id int) string {
+	return "mock " + t.name
+}
+
+type accountController struct {
+	Users AccountService  `inject:""`
+	Clock *prototypeClock `inject:""`
+}
+
+func appBeans() []any {
+	return []any{&prototypeClock{}, glue.Alias(&dbAccountService{}, "userService"), &accountController{}}
+}
+
+func TestOverride(t *testing.T) {
+
+	for _, original := range []any{"userService", AccountServiceClass, reflect.TypeOf(&dbAccountService{}), (*dbAccountService)(nil)} {
+		controller := &accountController{}
+		ctn, err := glue.New(appBeans(), controller, glue.Override(original, &mockAccountService{name: "a"}))
+		require.NoError(t, err, "%v", original)
+
+		require.Equal(t, "mock a", controller.Users.Account(1))
+		require.NotNil(t, controller.Clock)
+
+		require.Empty(t, ctn.Lookup("userService", glue.DefaultSearchLevel))
+		ctn.Close()
+	}
+
+	db := &dbAccountService{}
+	controller := &accountController{}
+	ctn, err := glue.New(&prototypeClock{}, db, controller, glue.Override(db, &mockAccountService{name: "b"}))
+	require.NoError(t, err)
+	defer ctn.Close()
+	require.Equal(t, "mock b", controller.Users.Account(1))
+}
+
+func TestReplace(t *testing.T) {
+
+	replacement := &dbAccountService{}
+	controller := &accountController{}
+	ctn, err := glue.New(appBeans(), controller, glue.Replace(replacement))
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Same(t, replacement, controller.Users)
+	require.NotNil(t, replacement.Clock)
+
+	beans := ctn.Bean(reflect.TypeOf(replacement), glue.DefaultSearchLevel)
+	require.Equal(t, 1, len(beans))
+}
+
+func TestOverrideInExtend(t *testing.T) {
+
+	parent, err := glue.New(appBeans())
+	require.NoError(t, err)
+	defer parent.Close()
+
+	controller := &accountController{}
+	child, err := parent.Extend(glue.Override(AccountServiceClass, &mockAccountService{name: "child"}), controller)
+	require.NoError(t, err)
+	defer child.Close()
+
+	require.Equal(t, "mock child", controller.Users.Account(1))
+}
+
+func TestOverrideClone(t *testing.T) {
+
+	skipUnsupported(t, glue.FeatureConstructors)
+
+	db := &dbAccountService{}
+	mock := &mockAccountService{name: "clone"}
+	newMock := func() *mockAccountService {
+		return &mockAccountService{name: "clone"}
+	}
+	controller := &accountController{}
+	// instances are created again as zero values by the clone, the constructor keeps the name
+	ctn, err := glue.New(&prototypeClock{}, db, controller, glue.Override(db, newMock))
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	clone, err := ctn.CloneWith(nil)
+	require.NoError(t, err)
+	defer clone.Close()
+
+	list := clone.Bean(reflect.TypeOf(controller), glue.DefaultSearchLevel)
+	require.Equal(t, 1, len(list))
+	cloned := list[0].Object().(*accountController)
+	require.NotSame(t, mock, cloned.Users)
+	require.Equal(t, "mock clone", cloned.Users.Account(1))
+	require.Empty(t, clone.Bean(reflect.TypeOf(db), glue.DefaultSearchLevel))
+}
@@ -0,0 +1,317 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+)
+
+/**
+ParallelInit in the scan list turns on concurrent initialization of independent beans, e.g. glue.New(glue.ParallelInit{Workers: 8}, ...).
+Beans are grouped in levels by dependencies, beans of the same level are initialized concurrently
+and the next level starts when the previous one is done.
+If beans of the level fail, errors are reported in the scan order and the next levels are not initialized.
+*/
+
+type ParallelInit struct {
+	/*
+		Workers is the number of concurrent initializations, runtime.NumCPU() if not positive
+	*/
+	Workers int
+}
+
+func (t *ParallelInit) workers() int {
+	if t.Workers > 0 {
+		return t.Workers
+	}
+	return runtime.NumCPU()
+}
+
+/**
+Groups beans by the longest path to initialized beans, returns false on cycle
+*/
+
+func initLevels(lists ...[]*bean) ([][]*bean, bool) {
+	level := make(map[*bean]int)
+	visiting := make(map[*bean]bool)
+	var order []*bean
+
+	var visit func(b *bean) (int, bool)
+	visit = func(b *bean) (int, bool) {
+		if b.lifecycle == BeanInitialized {
+			return -1, true
+		}
+		if l, ok := level[b]; ok {
+			return l, true
+		}
+		if visiting[b] {
+			return 0, false
+		}
+		visiting[b] = true
+		l := 0
+		for _, dep := range initDependencies(b) {
+			depLevel, ok := visit(dep)
+			if !ok {
+				return 0, false
+			}
+			if depLevel+1 > l {
+				l = depLevel + 1
+			}
+		}
+		delete(visiting, b)
+		level[b] = l
+		order = append(order, b)
+		return l, true
+	}
+
+	for _, list := range lists {
+		for _, b := range list {
+			if _, ok := visit(b); !ok {
+				return nil, false
+			}
+		}
+	}
+
+	var levels [][]*bean
+	for _, b := range order {
+		l := level[b]
+		for len(levels) <= l {
+			levels = append(levels, nil)
+		}
+		levels[l] = append(levels[l], b)
+	}
+	return levels, true
+}
+
+/**
+Beans that have to be initialized before the bean, including products of factories it injects
+*/
+
+func initDependencies(b *bean) []*bean {
+	deps := append([]*bean(nil), b.dependencies...)
+	for _, fd := range b.factoryDependencies {
+		deps = append(deps, fd.factory.bean)
+		if len(fd.factory.instances) > 0 {
+			deps = append(deps, fd.factory.instances[0])
+		}
+	}
+	if b.beenFactory != nil {
+		deps = append(deps, b.beenFactory.bean)
+	}
+	return deps
+}
+
+func (t *container) constructLevels(ctx context.Context, levels [][]*bean, workers int) error {
+	// the first failure cancels FactoryBeanCtx factories of beans constructed concurrently
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for _, list := range levels {
+		errs := make([]error, len(list))
+		slots := make(chan struct{}, workers)
+		var wg sync.WaitGroup
+		for i, b := range list {
+			wg.Add(1)
+			slots <- struct{}{}
+			go func(i int, b *bean) {
+				defer func() {
+					<-slots
+					wg.Done()
+				}()
+				if errs[i] = t.constructBean(ctx, b, nil); errs[i] != nil {
+					cancel()
+				}
+			}(i, b)
+		}
+		wg.Wait()
+
+		var listErr []error
+		for _, err := range errs {
+			if err != nil {
+				listErr = append(listErr, err)
+			}
+		}
+		if len(listErr) > 0 {
+			return multipleErr(listErr)
+		}
+	}
+	return nil
+}
+
+/**
+ParallelDestroy in the scan list turns on concurrent destruction of independent beans on Close, e.g. glue.New(glue.ParallelDestroy{Workers: 16, Timeout: 10 * time.Second}, ...).
+Beans are grouped in levels by dependents, a bean is destroyed only after all beans injecting it.
+All levels are destroyed even if some beans fail, errors are reported in the destroy order.
+*/
+
+type ParallelDestroy struct {
+	/*
+		Workers is the number of concurrent destructions, runtime.NumCPU() if not positive
+	*/
+	Workers int
+
+	/*
+		Timeout of the whole destruction, beans not destroyed in time are abandoned, zero means no timeout
+	*/
+	Timeout time.Duration
+}
+
+func (t *ParallelDestroy) workers() int {
+	if t.Workers > 0 {
+		return t.Workers
+	}
+	return runtime.NumCPU()
+}
+
+/**
+Groups disposable beans by the longest path from their dependents, returns false on cycle
+*/
+
+func (t *container) destroyLevels() ([][]*bean, bool) {
+	dependents := make(map[*bean][]*bean)
+	t.forEachDependency(func(from, to *bean) {
+		if from != to {
+			dependents[to] = append(dependents[to], from)
+		}
+	})
+
+	// beans with higher DestroyOrder wait for the previous group, conflicts with the injection graph fall back to sequential order
+	groups := make(map[int][]*bean)
+	var orders []int
+	for _, b := range t.disposables {
+		if ordered, ok := b.obj.(DestroyOrderedBean); ok {
+			order := ordered.DestroyOrder()
+			if _, ok := groups[order]; !ok {
+				orders = append(orders, order)
+			}
+			groups[order] = append(groups[order], b)
+		}
+	}
+	sort.Ints(orders)
+	for i := 1; i < len(orders); i++ {
+		for _, b := range groups[orders[i]] {
+			dependents[b] = append(dependents[b], groups[orders[i-1]]...)
+		}
+	}
+
+	level := make(map[*bean]int)
+	visiting := make(map[*bean]bool)
+
+	var visit func(b *bean) (int, bool)
+	visit = func(b *bean) (int, bool) {
+		if l, ok := level[b]; ok {
+			return l, true
+		}
+		if visiting[b] {
+			return 0, false
+		}
+		visiting[b] = true
+		l := 0
+		for _, dep := range dependents[b] {
+			depLevel, ok := visit(dep)
+			if !ok {
+				return 0, false
+			}
+			if depLevel+1 > l {
+				l = depLevel + 1
+			}
+		}
+		delete(visiting, b)
+		level[b] = l
+		return l, true
+	}
+
+	var levels [][]*bean
+	for _, b := range t.destroyOrder() {
+		l, ok := visit(b)
+		if !ok {
+			return nil, false
+		}
+		for len(levels) <= l {
+			levels = append(levels, nil)
+		}
+		levels[l] = append(levels[l], b)
+	}
+	return levels, true
+}
+
+func (t *container) destroyParallel(ctx context.Context, opts *ParallelDestroy) []error {
+	levels, ok := t.destroyLevels()
+	if !ok {
+		return t.destroySequential(ctx)
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	type result struct {
+		i   int
+		err error
+	}
+
+	var listErr []error
+	for _, list := range levels {
+		errs := make([]error, len(list))
+		done := make([]bool, len(list))
+		results := make(chan result, len(list))
+		slots := make(chan struct{}, opts.workers())
+		started := 0
+
+	start:
+		for i, b := range list {
+			if ctx.Err() != nil {
+				break
+			}
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				break start
+			}
+			started++
+			go func(i int, b *bean) {
+				defer func() {
+					<-slots
+				}()
+				results <- result{i: i, err: t.destroyBean(ctx, b)}
+			}(i, b)
+		}
+
+	wait:
+		for n := 0; n < started; n++ {
+			select {
+			case r := <-results:
+				errs[r.i], done[r.i] = r.err, true
+			case <-ctx.Done():
+				break wait
+			}
+		}
+
+		for i, b := range list {
+			if errs[i] != nil {
+				listErr = append(listErr, errs[i])
+			} else if !done[i] {
+				listErr = append(listErr, fmt.Errorf("%w: destroy of bean '%s' with type '%v' abandoned: %v", ErrLifecycleTimeout, b.name, b.beanDef.classPtr, ctx.Err()))
+			}
+		}
+	}
+	return listErr
+}
+
+func (t *container) destroySequential(ctx context.Context) []error {
+	var listErr []error
+	for _, b := range t.destroyOrder() {
+		if err := t.destroyBean(ctx, b); err != nil {
+			listErr = append(listErr, err)
+		}
+	}
+	return listErr
+}
@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type parallelTracker struct {
+	running int32
+	peak    int32
+}
+
+func (t *parallelTracker) enter() {
+	n := atomic.AddInt32(&t.running, 1)
+	for {
+		peak := atomic.LoadInt32(&t.peak)
+		if n <= peak || atomic.CompareAndSwapInt32(&t.peak, peak, n) {
+			break
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+	atomic.AddInt32(&t.running, -1)
+}
+
+type parallelLeafA struct {
+	Tracker *parallelTracker `inject:""`
+	done    bool
+}
+
+func (t *parallelLeafA) PostConstruct() error {
+	t.Tracker.enter()
+	t.done = true
+	return nil
+}
+
+type parallelLeafB struct {
+	Tracker *parallelTracker `inject:""`
+	done    bool
+}
+
+func (t *parallelLeafB) PostConstruct() error {
+	t.Tracker.enter()
+	t.done = true
+	return nil
+}
+
+type parallelRoot struct {
+	A *parallelLeafA `inject:""`
+	B *parallelLeafB `inject:""`
+}
+
+func (t *parallelRoot) PostConstruct() error {
+	if !t.A.done || !t.B.done {
+		return errors.New("dependencies are not initialized")
+	}
+	return nil
+}
+
+type parallelFailing struct {
+	name string
+}
+
+func (t *parallelFailing) BeanName() string {
+	return t.name
+}
+
+func (t *parallelFailing) PostConstruct() error {
+	return errors.New(t.name + " failed")
+}
+
+func TestParallelInit(t *testing.T) {
+
+	tracker := &parallelTracker{}
+	ctn, err := glue.New(
+		glue.ParallelInit{Workers: 4},
+		tracker,
+		&parallelRoot{},
+		&parallelLeafA{},
+		&parallelLeafB{},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, int32(2), atomic.LoadInt32(&tracker.peak))
+}
+
+func TestParallelInitErrors(t *testing.T) {
+
+	for i := 0; i < 5; i++ {
+		_, err := glue.New(
+			glue.ParallelInit{},
+			&parallelFailing{name: "first"},
+			&parallelFailing{name: "second"},
+		)
+		require.Error(t, err)
+		require.Regexp(t, "first failed.*second failed", err.Error())
+	}
+}
+
+type parallelDisposable struct {
+	name    string
+	delay   time.Duration
+	tracker *parallelTracker
+	order   *[]string
+	mu      *sync.Mutex
+}
+
+func (t *parallelDisposable) Destroy() error {
+	if t.tracker != nil {
+		t.tracker.enter()
+	}
+	time.Sleep(t.delay)
+	t.mu.Lock()
+	*t.order = append(*t.order, t.name)
+	t.mu.Unlock()
+	return nil
+}
+
+type parallelDisposableRoot struct {
+	parallelDisposable
+	A *parallelDisposableA `inject:""`
+	B *parallelDisposableB `inject:""`
+}
+
+type parallelDisposableA struct {
+	parallelDisposable
+}
+
+type parallelDisposableB struct {
+	parallelDisposable
+}
+
+func TestParallelDestroy(t *testing.T) {
+
+	var order []string
+	var mu sync.Mutex
+	tracker := &parallelTracker{}
+	disposable := func(name string) parallelDisposable {
+		return parallelDisposable{name: name, tracker: tracker, order: &order, mu: &mu}
+	}
+
+	ctn, err := glue.New(
+		glue.ParallelDestroy{Workers: 4},
+		&parallelDisposableRoot{parallelDisposable: disposable("root")},
+		&parallelDisposableA{parallelDisposable: disposable("a")},
+		&parallelDisposableB{parallelDisposable: disposable("b")},
+	)
+	require.NoError(t, err)
+	require.NoError(t, ctn.Close())
+
+	require.Equal(t, 3, len(order))
+	require.Equal(t, "root", order[0])
+	require.Equal(t, int32(2), atomic.LoadInt32(&tracker.peak))
+}
+
+func TestParallelDestroyTimeout(t *testing.T) {
+
+	var order []string
+	var mu sync.Mutex
+
+	ctn, err := glue.New(
+		glue.ParallelDestroy{Timeout: 50 * time.Millisecond},
+		&parallelDisposableRoot{parallelDisposable: parallelDisposable{name: "root", delay: time.Second, order: &order, mu: &mu}},
+		&parallelDisposableA{parallelDisposable: parallelDisposable{name: "a", order: &order, mu: &mu}},
+		&parallelDisposableB{parallelDisposable: parallelDisposable{name: "b", order: &order, mu: &mu}},
+	)
+	require.NoError(t, err)
+
+	start := time.Now()
+	err = ctn.Close()
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+	require.Contains(t, err.Error(), "parallelDisposableRoot' abandoned")
+	require.Contains(t, err.Error(), "parallelDisposableA' abandoned")
+}
@@ -126,6 +126,47 @@ func TestParentDestroy(t *testing.T) {
 
 }
 
+type closeOrderBean struct {
+	name   string
+	closed *[]string
+}
+
+func (t *closeOrderBean) Destroy() error {
+	*t.closed = append(*t.closed, t.name)
+	return nil
+}
+
+func TestParentClosesChildren(t *testing.T) {
+
+	var closed []string
+	parent, err := glue.New(
+		&closeOrderBean{name: "parent", closed: &closed},
+	)
+	require.NoError(t, err)
+
+	first, err := parent.Extend(&closeOrderBean{name: "first", closed: &closed})
+	require.NoError(t, err)
+
+	second, err := parent.Extend(&closeOrderBean{name: "second", closed: &closed})
+	require.NoError(t, err)
+
+	_, err = second.Extend(&closeOrderBean{name: "grandchild", closed: &closed})
+	require.NoError(t, err)
+
+	detached, err := parent.ExtendWithOptions(glue.WithDetached(), glue.WithBeans(&closeOrderBean{name: "detached", closed: &closed}))
+	require.NoError(t, err)
+
+	// closed child is not closed again
+	require.NoError(t, first.Close())
+	require.Equal(t, []string{"first"}, closed)
+
+	require.NoError(t, parent.Close())
+	require.Equal(t, []string{"first", "grandchild", "second", "parent"}, closed)
+
+	require.NoError(t, detached.Close())
+	require.Equal(t, []string{"first", "grandchild", "second", "parent", "detached"}, closed)
+}
+
 func TestParentCollection(t *testing.T) {
 
 	coreBean := &coreBean{}
@@ -0,0 +1,217 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+/**
+GraphPartition is the result of Container.Partition, used to plan splitting of the container
+in to modules or child containers.
+*/
+
+type GraphPartition struct {
+	/*
+		Clusters of beans that depend on each other only inside of the cluster, the biggest first
+	*/
+	Clusters []GraphCluster
+
+	/*
+		Shared beans requested by the caller and beans of parent containers
+	*/
+	Shared []string
+
+	/*
+		Edges from clusters to shared beans
+	*/
+	CrossEdges []GraphEdge
+}
+
+type GraphCluster struct {
+	Beans []string
+	Edges int
+}
+
+type GraphEdge struct {
+	From        string
+	To          string
+	FromCluster int // -1 for shared beans
+	ToCluster   int // -1 for shared beans
+}
+
+func (t GraphPartition) String() string {
+	var sb strings.Builder
+	for i, c := range t.Clusters {
+		sb.WriteString(fmt.Sprintf("cluster %d: %d beans, %d edges\n", i, len(c.Beans), c.Edges))
+		for _, name := range c.Beans {
+			sb.WriteString(fmt.Sprintf("    %s\n", name))
+		}
+	}
+	if len(t.Shared) > 0 {
+		sb.WriteString(fmt.Sprintf("shared: %s\n", strings.Join(t.Shared, ", ")))
+	}
+	for _, e := range t.CrossEdges {
+		sb.WriteString(fmt.Sprintf("%s (%d) -> %s (%d)\n", e.From, e.FromCluster, e.To, e.ToCluster))
+	}
+	return sb.String()
+}
+
+func (t *container) Partition(shared ...string) GraphPartition {
+
+	local := make(map[*bean]bool)
+	for _, beans := range t.core {
+		for _, b := range beans {
+			if b.obj != t && b.name != "" {
+				local[b] = true
+			}
+		}
+	}
+
+	isShared := func(b *bean) bool {
+		if !local[b] {
+			return true
+		}
+		for _, name := range shared {
+			if b.hasName(name) || beanGraphName(b) == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	// union-find over beans that are not shared
+	parent := make(map[*bean]*bean)
+	var find func(b *bean) *bean
+	find = func(b *bean) *bean {
+		if p, ok := parent[b]; ok && p != b {
+			root := find(p)
+			parent[b] = root
+			return root
+		}
+		return b
+	}
+	for b := range local {
+		if !isShared(b) {
+			parent[b] = b
+		}
+	}
+
+	type edge struct {
+		from, to *bean
+	}
+	seen := make(map[edge]bool)
+	var edges []edge
+	t.forEachDependency(func(from, to *bean) {
+		if from == to || from.obj == t || to.obj == t {
+			return
+		}
+		e := edge{from, to}
+		if seen[e] {
+			return
+		}
+		seen[e] = true
+		edges = append(edges, e)
+		if _, ok := parent[from]; !ok {
+			return
+		}
+		if _, ok := parent[to]; !ok {
+			return
+		}
+		if a, b := find(from), find(to); a != b {
+			parent[a] = b
+		}
+	})
+
+	groups := make(map[*bean][]*bean)
+	for b := range parent {
+		root := find(b)
+		groups[root] = append(groups[root], b)
+	}
+	var clusters []GraphCluster
+	var clusterBeans [][]*bean
+	for _, list := range groups {
+		names := make([]string, len(list))
+		for i, b := range list {
+			names[i] = beanGraphName(b)
+		}
+		sort.Strings(names)
+		clusters = append(clusters, GraphCluster{Beans: names})
+		clusterBeans = append(clusterBeans, list)
+	}
+	order := make([]int, len(clusters))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		a, b := clusters[order[i]], clusters[order[j]]
+		if len(a.Beans) != len(b.Beans) {
+			return len(a.Beans) > len(b.Beans)
+		}
+		return a.Beans[0] < b.Beans[0]
+	})
+
+	index := make(map[*bean]int)
+	sorted := make([]GraphCluster, len(clusters))
+	for i, k := range order {
+		sorted[i] = clusters[k]
+		for _, b := range clusterBeans[k] {
+			index[b] = i
+		}
+	}
+	clusters = sorted
+	clusterOf := func(b *bean) int {
+		if i, ok := index[b]; ok {
+			return i
+		}
+		return -1
+	}
+
+	sharedNames := make(map[string]bool)
+	for b := range local {
+		if _, ok := parent[b]; !ok {
+			sharedNames[beanGraphName(b)] = true
+		}
+	}
+
+	result := GraphPartition{}
+	for _, e := range edges {
+		from, to := clusterOf(e.from), clusterOf(e.to)
+		if from == -1 {
+			sharedNames[beanGraphName(e.from)] = true
+		}
+		if to == -1 {
+			sharedNames[beanGraphName(e.to)] = true
+		}
+		switch {
+		case from == -1 && to == -1:
+		case from == to:
+			clusters[from].Edges++
+		default:
+			result.CrossEdges = append(result.CrossEdges, GraphEdge{
+				From:        beanGraphName(e.from),
+				To:          beanGraphName(e.to),
+				FromCluster: from,
+				ToCluster:   to,
+			})
+		}
+	}
+	sort.Slice(result.CrossEdges, func(i, j int) bool {
+		if result.CrossEdges[i].From != result.CrossEdges[j].From {
+			return result.CrossEdges[i].From < result.CrossEdges[j].From
+		}
+		return result.CrossEdges[i].To < result.CrossEdges[j].To
+	})
+
+	for name := range sharedNames {
+		result.Shared = append(result.Shared, name)
+	}
+	sort.Strings(result.Shared)
+	result.Clusters = clusters
+	return result
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type partitionDB struct{}
+
+type partitionUserRepo struct {
+	DB *partitionDB `inject:""`
+}
+
+type partitionUserService struct {
+	Repo *partitionUserRepo `inject:""`
+}
+
+type partitionOrderRepo struct {
+	DB *partitionDB `inject:""`
+}
+
+type partitionOrderService struct {
+	Repo *partitionOrderRepo `inject:""`
+}
+
+type partitionClock struct{}
+
+func TestPartition(t *testing.T) {
+
+	ctn, err := glue.New(
+		&partitionDB{},
+		&partitionUserRepo{},
+		&partitionUserService{},
+		&partitionOrderRepo{},
+		&partitionOrderService{},
+		&partitionClock{},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	p := ctn.Partition()
+	require.Equal(t, 2, len(p.Clusters))
+	require.Equal(t, 5, len(p.Clusters[0].Beans))
+	require.Equal(t, 4, p.Clusters[0].Edges)
+	require.Equal(t, []string{"*glue_test.partitionClock"}, p.Clusters[1].Beans)
+	require.Empty(t, p.CrossEdges)
+
+	p = ctn.Partition("*glue_test.partitionDB")
+	require.Equal(t, 3, len(p.Clusters))
+	require.Equal(t, []string{"*glue_test.partitionOrderRepo", "*glue_test.partitionOrderService"}, p.Clusters[0].Beans)
+	require.Equal(t, []string{"*glue_test.partitionUserRepo", "*glue_test.partitionUserService"}, p.Clusters[1].Beans)
+	require.Equal(t, []string{"*glue_test.partitionDB"}, p.Shared)
+	require.Equal(t, []glue.GraphEdge{
+		{From: "*glue_test.partitionOrderRepo", To: "*glue_test.partitionDB", FromCluster: 0, ToCluster: -1},
+		{From: "*glue_test.partitionUserRepo", To: "*glue_test.partitionDB", FromCluster: 1, ToCluster: -1},
+	}, p.CrossEdges)
+	require.Contains(t, p.String(), "shared: *glue_test.partitionDB")
+}
@@ -0,0 +1,32 @@
+//go:build !glue_restricted
+
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"plugin"
+)
+
+func openPlugin(file, symbol string) ([]any, error) {
+	p, err := plugin.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("open plugin '%s': %w", file, err)
+	}
+	sym, err := p.Lookup(symbol)
+	if err != nil {
+		return nil, fmt.Errorf("plugin '%s': %w", file, err)
+	}
+	switch beans := sym.(type) {
+	case func() []any:
+		return beans(), nil
+	case *[]any:
+		return *beans, nil
+	default:
+		return nil, fmt.Errorf("plugin '%s' symbol '%s' has type '%T', expected 'func() []any' or '[]any'", file, symbol, sym)
+	}
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type extensionExporter struct {
+	Format string
+}
+
+type extensionHost struct {
+	Exporters []*extensionExporter `inject:""`
+}
+
+func init() {
+	glue.RegisterExtension("test-csv", func() []any {
+		return []any{&extensionExporter{Format: "csv"}}
+	})
+	glue.RegisterExtension("test-json", func() []any {
+		return []any{&extensionExporter{Format: "json"}}
+	})
+}
+
+func TestPluginLoaderExtensions(t *testing.T) {
+
+	skipUnsupported(t, glue.FeaturePlugins)
+
+	require.Subset(t, glue.Extensions(), []string{"test-csv", "test-json"})
+	require.Panics(t, func() {
+		glue.RegisterExtension("test-csv", func() []any { return nil })
+	})
+
+	host := &extensionHost{}
+	ctn, err := glue.New(
+		&glue.PluginLoader{Extensions: []string{"test-json"}},
+		host,
+	)
+	require.NoError(t, err)
+	require.Len(t, host.Exporters, 1)
+	require.Equal(t, "json", host.Exporters[0].Format)
+	require.NoError(t, ctn.Close())
+
+	host = &extensionHost{}
+	ctn, err = glue.New(
+		&glue.PluginLoader{
+			Extensions: []string{"*"},
+			Files:      []string{filepath.Join(t.TempDir(), "*.so")},
+		},
+		host,
+	)
+	require.NoError(t, err)
+	require.Len(t, host.Exporters, 2)
+	require.NoError(t, ctn.Close())
+
+	_, err = glue.New(&glue.PluginLoader{Extensions: []string{"missing"}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "extension 'missing' is not registered")
+
+	_, err = glue.New(&glue.PluginLoader{Files: []string{filepath.Join(t.TempDir(), "missing.so")}})
+	require.Error(t, err)
+}
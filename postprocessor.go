@@ -36,7 +36,7 @@ func (t *container) applyPostProcessors() error {
 	})
 
 	for _, p := range processors {
-		t.logger.Printf("PostProcessor %T\n", p)
+		t.logf(LogInfo, "PostProcessor %T\n", p)
 
 		for _, beans := range t.core {
 			for _, b := range beans {
@@ -0,0 +1,227 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func (t *container) runPreflightChecks(ctx context.Context) error {
+	// collect all PreflightCheck beans
+	var beans []*bean
+	for _, list := range t.core {
+		for _, b := range list {
+			if _, ok := b.obj.(PreflightCheck); ok {
+				beans = append(beans, b)
+			}
+		}
+	}
+
+	if len(beans) == 0 {
+		return nil
+	}
+
+	// ordered checks go first by BeanOrder, then all in the registration order like collections
+	sort.Slice(beans, func(i, j int) bool {
+		return beanLess(beans[i], beans[j])
+	})
+	checks := make([]PreflightCheck, len(beans))
+	for i, b := range beans {
+		checks[i] = b.obj.(PreflightCheck)
+	}
+
+	var listErr []error
+	for _, check := range checks {
+		t.logf(LogInfo, "PreflightCheck %T\n", check)
+		if err := check.Preflight(ctx, t.properties); err != nil {
+			listErr = append(listErr, err)
+		}
+	}
+
+	if len(listErr) > 0 {
+		var out strings.Builder
+		out.WriteString("preflight checks failed:")
+		for _, err := range listErr {
+			out.WriteString("\n  - ")
+			out.WriteString(err.Error())
+		}
+		return errors.New(out.String())
+	}
+	return nil
+}
+
+/**
+Returns the property value or the default, empty value means the check is skipped
+*/
+
+func preflightValue(properties Properties, property, def string) (string, error) {
+	if property != "" {
+		value, ok, err := properties.Resolve(property)
+		if err != nil {
+			return "", fmt.Errorf("property '%s' resolution error: %w", property, err)
+		}
+		if ok {
+			return strings.TrimSpace(value), nil
+		}
+	}
+	return def, nil
+}
+
+func preflightSource(property string) string {
+	if property != "" {
+		return fmt.Sprintf(" (property '%s')", property)
+	}
+	return ""
+}
+
+/**
+PortAvailableCheck verifies that the TCP port is free to listen on.
+The address is taken from the property, it could be a port "8080", ":8080" or "host:8080".
+When the property is not set and Default is empty the check is skipped.
+*/
+
+type PortAvailableCheck struct {
+
+	/**
+	Property holding the listen address or port, for example "server.port"
+	*/
+	Property string
+
+	/**
+	Default address used when the property is not set
+	*/
+	Default string
+
+	/**
+	Network to check, default "tcp"
+	*/
+	Network string
+}
+
+func (t *PortAvailableCheck) Preflight(ctx context.Context, properties Properties) error {
+	addr, err := preflightValue(properties, t.Property, t.Default)
+	if err != nil || addr == "" {
+		return err
+	}
+	if _, err := strconv.Atoi(addr); err == nil {
+		addr = ":" + addr
+	}
+	network := t.Network
+	if network == "" {
+		network = "tcp"
+	}
+	ln, err := net.Listen(network, addr)
+	if err != nil {
+		return fmt.Errorf("address '%s'%s is not available: %v; stop the process holding it or configure another address", addr, preflightSource(t.Property), err)
+	}
+	return ln.Close()
+}
+
+/**
+FileWritableCheck verifies that the path is writable by the current process.
+For an existing directory a temporary file is created and removed inside of it,
+otherwise the file is opened for append and the parent directory must exist.
+When the property is not set and Default is empty the check is skipped.
+*/
+
+type FileWritableCheck struct {
+
+	/**
+	Property holding the path, for example "app.data.dir"
+	*/
+	Property string
+
+	/**
+	Default path used when the property is not set
+	*/
+	Default string
+}
+
+func (t *FileWritableCheck) Preflight(ctx context.Context, properties Properties) error {
+	if !hasFileProperties {
+		return requireFeature(FeatureFileProperties)
+	}
+	path, err := preflightValue(properties, t.Property, t.Default)
+	if err != nil || path == "" {
+		return err
+	}
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		f, err := os.CreateTemp(path, ".glue-preflight-*")
+		if err != nil {
+			return fmt.Errorf("directory '%s'%s is not writable: %v; check permissions or mount options", path, preflightSource(t.Property), err)
+		}
+		name := f.Name()
+		f.Close()
+		return os.Remove(name)
+	}
+	_, statErr := os.Stat(path)
+	existed := statErr == nil
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("file '%s'%s can not be created, directory '%s' does not exist", path, preflightSource(t.Property), filepath.Dir(path))
+		}
+		return fmt.Errorf("file '%s'%s is not writable: %v; check permissions or mount options", path, preflightSource(t.Property), err)
+	}
+	f.Close()
+	if !existed {
+		return os.Remove(path)
+	}
+	return nil
+}
+
+/**
+OpenFilesLimitCheck verifies that the soft limit of open file descriptors (ulimit -n)
+is at least the required minimum. The minimum is taken from the property or Min field.
+On platforms without resource limits the check is skipped.
+*/
+
+type OpenFilesLimitCheck struct {
+
+	/**
+	Property holding the required minimum, for example "server.max-open-files"
+	*/
+	Property string
+
+	/**
+	Min used when the property is not set
+	*/
+	Min uint64
+}
+
+func (t *OpenFilesLimitCheck) Preflight(ctx context.Context, properties Properties) error {
+	var def string
+	if t.Min > 0 {
+		def = strconv.FormatUint(t.Min, 10)
+	}
+	str, err := preflightValue(properties, t.Property, def)
+	if err != nil || str == "" {
+		return err
+	}
+	min, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid open files minimum '%s'%s: %v", str, preflightSource(t.Property), err)
+	}
+	current, ok, err := openFilesLimit()
+	if err != nil {
+		return fmt.Errorf("can not read open files limit: %v", err)
+	}
+	if !ok {
+		return nil
+	}
+	if current < min {
+		return fmt.Errorf("open files limit %d is lower than required %d%s; raise it with 'ulimit -n %d' or in the service unit (LimitNOFILE)", current, min, preflightSource(t.Property), min)
+	}
+	return nil
+}
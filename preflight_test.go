@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type preflightInitBean struct {
+	constructed bool
+}
+
+func (t *preflightInitBean) PostConstruct() error {
+	t.constructed = true
+	return nil
+}
+
+type preflightCustomCheck struct {
+	err error
+}
+
+func (t *preflightCustomCheck) Preflight(ctx context.Context, properties glue.Properties) error {
+	return t.err
+}
+
+func TestPreflight_PortInUse(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	init := &preflightInitBean{}
+	_, err = glue.New(
+		glue.MapPropertySource{"server.addr": ln.Addr().String()},
+		&glue.PortAvailableCheck{Property: "server.addr"},
+		init,
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "preflight checks failed")
+	require.Contains(t, err.Error(), "server.addr")
+	require.False(t, init.constructed)
+}
+
+func TestPreflight_PortFree(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := ln.Addr().(*net.TCPAddr).Port
+	ln.Close()
+
+	init := &preflightInitBean{}
+	ctn, err := glue.New(
+		glue.MapPropertySource{"server.addr": "127.0.0.1:" + strconv.Itoa(port)},
+		&glue.PortAvailableCheck{Property: "server.addr"},
+		init,
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+	require.True(t, init.constructed)
+}
+
+func TestPreflight_SkippedWithoutProperty(t *testing.T) {
+	skipUnsupported(t, glue.FeatureFileProperties)
+
+	ctn, err := glue.New(
+		&glue.PortAvailableCheck{Property: "server.addr"},
+		&glue.FileWritableCheck{Property: "app.data.dir"},
+	)
+	require.NoError(t, err)
+	ctn.Close()
+}
+
+func TestPreflight_FileWritable(t *testing.T) {
+	skipUnsupported(t, glue.FeatureFileProperties)
+
+	dir := t.TempDir()
+
+	ctn, err := glue.New(
+		glue.MapPropertySource{
+			"app.data.dir":  dir,
+			"app.data.file": filepath.Join(dir, "data.db"),
+		},
+		&glue.FileWritableCheck{Property: "app.data.dir"},
+		&glue.FileWritableCheck{Property: "app.data.file"},
+	)
+	require.NoError(t, err)
+	ctn.Close()
+
+	_, err = glue.New(
+		&glue.FileWritableCheck{Default: filepath.Join(dir, "missing", "data.db")},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not exist")
+}
+
+func TestPreflight_AggregatesErrors(t *testing.T) {
+	_, err := glue.New(
+		&preflightCustomCheck{err: errors.New("first problem")},
+		&preflightCustomCheck{err: errors.New("second problem")},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "first problem")
+	require.Contains(t, err.Error(), "second problem")
+}
+
+type preflightNamedCheck struct {
+	name string
+	log  *[]string
+}
+
+func (t *preflightNamedCheck) Preflight(ctx context.Context, properties glue.Properties) error {
+	*t.log = append(*t.log, t.name)
+	return nil
+}
+
+type preflightLateCheck struct {
+	preflightNamedCheck
+}
+
+type preflightOrderedCheck struct {
+	preflightNamedCheck
+}
+
+func (t *preflightOrderedCheck) BeanOrder() int {
+	return 1
+}
+
+func TestPreflight_Order(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		var log []string
+		ctn, err := glue.New(
+			&preflightNamedCheck{name: "first", log: &log},
+			&preflightLateCheck{preflightNamedCheck{name: "second", log: &log}},
+			&preflightOrderedCheck{preflightNamedCheck{name: "ordered", log: &log}},
+		)
+		require.NoError(t, err)
+		ctn.Close()
+		// ordered checks go first, others in the registration order
+		require.Equal(t, []string{"ordered", "first", "second"}, log)
+	}
+}
+
+func TestPreflight_OpenFilesLimit(t *testing.T) {
+	ctn, err := glue.New(
+		&glue.OpenFilesLimitCheck{Min: 1},
+	)
+	require.NoError(t, err)
+	ctn.Close()
+}
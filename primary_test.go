@@ -91,3 +91,50 @@ func TestNoPrimaryBean(t *testing.T) {
 	require.Nil(t, ctx)
 	require.Contains(t, err.Error(), "multiple candidates")
 }
+
+type plainServiceA struct{}
+
+func (s *plainServiceA) GetName() string {
+	return "a"
+}
+
+type plainServiceB struct{}
+
+func (s *plainServiceB) GetName() string {
+	return "b"
+}
+
+type primaryWrapperConsumer struct {
+	Service  Service   `inject:""`
+	Services []Service `inject:""`
+}
+
+func TestPrimaryWrapper(t *testing.T) {
+
+	skipUnsupported(t, glue.FeatureConstructors)
+
+	consumerBean := &primaryWrapperConsumer{}
+	ctn, err := glue.New(
+		&plainServiceA{},
+		glue.Primary(&plainServiceB{}),
+		func() Service { return &thirdServiceImpl{name: "c"} },
+		consumerBean,
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, "b", consumerBean.Service.GetName())
+	require.Equal(t, 3, len(consumerBean.Services))
+
+	consumerBean = &primaryWrapperConsumer{}
+	ctn, err = glue.New(
+		&plainServiceA{},
+		glue.Primary(func() Service { return &thirdServiceImpl{name: "c"} }),
+		consumerBean,
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, "c", consumerBean.Service.GetName())
+	require.Equal(t, 2, len(consumerBean.Services))
+}
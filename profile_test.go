@@ -45,3 +45,39 @@ func TestProfileBeanFiltering(t *testing.T) {
 	list := ctx.Bean(glue.ProfileBeanClass, glue.DefaultSearchLevel)
 	require.Len(t, list, 3)
 }
+
+type profileAwareBean struct {
+	Profiles glue.Profiles `inject:""`
+	Active   string        `value:"glue.profiles.active"`
+}
+
+func TestActiveProfilesBean(t *testing.T) {
+	aware := &profileAwareBean{}
+	ctn, err := glue.NewWithProfiles([]string{"dev", " local", "dev"}, aware)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, []string{"dev", "local"}, aware.Profiles.List())
+	require.True(t, aware.Profiles.Has("local"))
+	require.False(t, aware.Profiles.Has("prod"))
+	require.True(t, aware.Profiles.Matches("dev&!prod"))
+	require.Equal(t, "dev,local", aware.Active)
+
+	require.True(t, ctn.HasProfile("dev"))
+	require.Same(t, aware.Profiles, ctn.Profiles())
+
+	p, err := glue.GetBean[glue.Profiles](ctn)
+	require.NoError(t, err)
+	require.Same(t, aware.Profiles, p)
+
+	// the child container inherits profiles by the property
+	child, err := ctn.Extend()
+	require.NoError(t, err)
+	require.True(t, child.HasProfile("local"))
+
+	empty, err := glue.New()
+	require.NoError(t, err)
+	defer empty.Close()
+	require.Empty(t, empty.Profiles().List())
+	require.False(t, empty.Properties().Contains(glue.ActiveProfilesProperty))
+}
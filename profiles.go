@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"strings"
+)
+
+/**
+Active profiles of the container, registered as the bean
+*/
+
+type activeProfiles struct {
+	list []string
+	set  map[string]struct{}
+}
+
+func newActiveProfiles(list []string) *activeProfiles {
+	t := &activeProfiles{set: make(map[string]struct{}, len(list))}
+	for _, profile := range list {
+		profile = strings.TrimSpace(profile)
+		if _, ok := t.set[profile]; profile != "" && !ok {
+			t.set[profile] = struct{}{}
+			t.list = append(t.list, profile)
+		}
+	}
+	return t
+}
+
+func (t *activeProfiles) List() []string {
+	return append([]string(nil), t.list...)
+}
+
+func (t *activeProfiles) Has(name string) bool {
+	_, ok := t.set[name]
+	return ok
+}
+
+func (t *activeProfiles) Matches(expression string) bool {
+	return isProfileActive(t.set, expression)
+}
+
+func (t *activeProfiles) String() string {
+	return strings.Join(t.list, ",")
+}
+
+func (t *container) Profiles() Profiles {
+	return t.profiles
+}
+
+func (t *container) HasProfile(name string) bool {
+	return t.profiles.Has(name)
+}
+
+/**
+Publishes active profiles in the property, so beans and child containers see the effective list
+*/
+
+func (t *container) publishProfiles() {
+	if len(t.profiles.list) == 0 {
+		return
+	}
+	value := t.profiles.String()
+	if current, ok := t.properties.GetProperty(ActiveProfilesProperty); ok && current == value {
+		return
+	}
+	t.properties.SetEntry(ActiveProfilesProperty, PropertyEntry{Value: value, Origin: "profiles"})
+}
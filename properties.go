@@ -10,12 +10,15 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
-	"unicode/utf8"
+
+	"go.arpabet.com/glue/propfmt"
 )
 
 // Properties contains the key/value pairs from the properties input.
@@ -26,12 +29,43 @@ type properties struct {
 
 	store map[string]string
 
+	// decoded values of structured sources by key
+	typed map[string]typedValue
+
+	// comments and origins of values by key, origin of the running load
+	meta       map[string]propertyMeta
+	loadOrigin string
+
+	// changes after Freeze with FreezeDynamic, they take precedence over the store
+	frozen      FreezeMode
+	dynamic     map[string]string
+	dynamicMeta map[string]propertyMeta
+
 	resolvers []PropertyResolver
 
 	// property conversion error handler
 	errorHandler func(string, error)
+
+	// logs changes rejected by FreezeStrict when there is no error handler
+	frozenLog func(string)
+
+	listeners []PropertyChangeListener
+
+	// keys masked in Dump and Save
+	maskPatterns []string
+	maskFunc     func(key, value string) string
+
+	// *propertyAudit of reads, nil if not audited
+	auditState atomic.Value
+	auditReads int64
 }
 
+/*
+	Replacement of masked values in Dump and Save
+*/
+
+var DefaultMask = "******"
+
 func NewProperties() Properties {
 	return NewPropertiesWithPriority(defaultPropertyResolverPriority)
 }
@@ -76,12 +110,17 @@ func (t *properties) Priority() int {
 }
 
 func (t *properties) LoadMap(source map[string]any) {
+	if t.rejectFrozen("") {
+		return
+	}
+	var events []PropertyChangedEvent
 	t.Lock()
-	defer t.Unlock()
-	t.loadMapRec(make([]byte, 0, 100), source)
+	t.loadMapRec(make([]byte, 0, 100), source, &events)
+	t.Unlock()
+	t.notify(events)
 }
 
-func (t *properties) loadMapRec(stack []byte, m map[string]any) {
+func (t *properties) loadMapRec(stack []byte, m map[string]any, events *[]PropertyChangedEvent) {
 	for k, v := range m {
 		n := len(stack)
 		if n > 0 {
@@ -89,14 +128,100 @@ func (t *properties) loadMapRec(stack []byte, m map[string]any) {
 		}
 		stack = append(stack, []byte(k)...)
 		if next, ok := v.(map[string]any); ok {
-			t.loadMapRec(stack, next)
+			t.loadMapRec(stack, next, events)
+		} else if list, ok := v.([]any); ok {
+			t.loadListRec(stack, list, events)
+		} else if tables, ok := v.([]map[string]any); ok {
+			list := make([]any, len(tables))
+			for i, table := range tables {
+				list[i] = table
+			}
+			t.loadListRec(stack, list, events)
 		} else {
-			t.store[string(stack)] = fmt.Sprint(v)
+			t.put(string(stack), fmt.Sprint(v), "LoadMap", events)
+			t.putTyped(string(stack), v)
 		}
 		stack = stack[:n]
 	}
 }
 
+/*
+	Stores the sequence as ';' separated values and maps in the sequence under 'key[i].'
+*/
+
+func (t *properties) loadListRec(stack []byte, list []any, events *[]PropertyChangedEvent) {
+	var values []string
+	var typed []any
+	for i, v := range list {
+		if next, ok := v.(map[string]any); ok {
+			n := len(stack)
+			stack = append(stack, fmt.Sprintf("[%d]", i)...)
+			t.loadMapRec(stack, next, events)
+			stack = stack[:n]
+		} else {
+			values = append(values, fmt.Sprint(v))
+			typed = append(typed, v)
+		}
+	}
+	if len(values) > 0 {
+		t.put(string(stack), strings.Join(values, ";"), "LoadMap", events)
+		t.putTyped(string(stack), typed)
+	}
+}
+
+/*
+	Stores the value and collects the event if the value was changed, must be called under the lock
+*/
+
+func (t *properties) put(key, value, source string, events *[]PropertyChangedEvent) {
+	old, ok := t.local(key)
+	if t.frozen == FreezeDynamic {
+		t.dynamic[key] = value
+	} else {
+		t.store[key] = value
+		delete(t.typed, key)
+	}
+	t.putOrigin(key, source)
+	if len(t.listeners) > 0 && (!ok || old != value) {
+		*events = append(*events, PropertyChangedEvent{Key: key, Old: old, New: value, Source: source})
+	}
+}
+
+/*
+	Notifies listeners outside of the lock, so they can read properties
+*/
+
+func (t *properties) notify(events []PropertyChangedEvent) {
+	if len(events) == 0 {
+		return
+	}
+	t.RLock()
+	listeners := append([]PropertyChangeListener(nil), t.listeners...)
+	t.RUnlock()
+	for _, event := range events {
+		for _, listener := range listeners {
+			listener.OnPropertyChanged(event)
+		}
+	}
+}
+
+func (t *properties) AddChangeListener(listener PropertyChangeListener) {
+	t.Lock()
+	defer t.Unlock()
+	t.listeners = append(t.listeners, listener)
+}
+
+func (t *properties) RemoveChangeListener(listener PropertyChangeListener) {
+	t.Lock()
+	defer t.Unlock()
+	for i, l := range t.listeners {
+		if l == listener {
+			t.listeners = append(t.listeners[:i:i], t.listeners[i+1:]...)
+			return
+		}
+	}
+}
+
 func (t *properties) Load(reader io.Reader) error {
 	content, err := ioutil.ReadAll(reader)
 	if err != nil {
@@ -110,42 +235,27 @@ func (t *properties) Save(writer io.Writer) (n int, err error) {
 }
 
 func (t *properties) Parse(content string) error {
-	var key string
-	var inside bool
+	if t.rejectFrozen("") {
+		return ErrPropertiesFrozen
+	}
+	var events []PropertyChangedEvent
+	defer func() {
+		t.notify(events)
+	}()
 
 	t.Lock()
 	defer t.Unlock()
 
-	for _, item := range lex(content) {
-		switch item.typ {
-		case itemEOF:
-			if inside {
-				t.store[key] = ""
-			}
-			break
-		case itemComment:
-			continue
-		case itemKey:
-			if inside {
-				return fmt.Errorf("key is not expected inside the property on key '%s'", key)
-			}
-			key = item.val
-			inside = true
-		case itemValue:
-			if !inside {
-				return fmt.Errorf("value is not expected outside of the property after key '%s'", key)
-			}
-			t.store[key] = item.val
-			inside = false
-		case itemError:
-			if inside {
-				return fmt.Errorf("property parsing error on key '%s', %s", key, item.val)
-			} else {
-				return fmt.Errorf("property parsing error after key '%s', %s", key, item.val)
-			}
+	return t.parse(content, &events)
+}
+
+func (t *properties) parse(content string, events *[]PropertyChangedEvent) error {
+	return propfmt.ScanEntries(content, func(e propfmt.Entry) {
+		t.put(e.Key, e.Value, "Parse", events)
+		if len(e.Comments) > 0 {
+			t.putComments(e.Key, e.Comments)
 		}
-	}
-	return nil
+	})
 }
 
 func (t *properties) Dump() string {
@@ -157,17 +267,58 @@ func (t *properties) Dump() string {
 	t.RLock()
 	defer t.RUnlock()
 
+	entries := make([]propfmt.Entry, 0, len(keys))
 	for _, key := range keys {
 
-		if value, ok := t.store[key]; ok {
-			output.WriteString(fmt.Sprintf("%s = %s\n", encodeUtf8(key, " :"), encodeUtf8(value, "")))
+		if value, ok := t.local(key); ok {
+			meta, dynamic := t.metaOf(key)
+			comments := meta.comments
+			if dynamic {
+				comments = append(append([]string(nil), comments...), "dynamic: "+meta.origin)
+			}
+			entries = append(entries, propfmt.Entry{
+				Key:      key,
+				Value:    t.maskValue(key, value),
+				Comments: comments,
+			})
 		}
 
 	}
 
+	propfmt.Format(&output, entries)
 	return output.String()
 }
 
+func (t *properties) SetMaskPatterns(patterns ...string) {
+	t.Lock()
+	defer t.Unlock()
+	t.maskPatterns = append([]string(nil), patterns...)
+}
+
+func (t *properties) SetMaskFunc(mask func(key, value string) string) {
+	t.Lock()
+	defer t.Unlock()
+	t.maskFunc = mask
+}
+
+func (t *properties) MaskValue(key, value string) string {
+	t.RLock()
+	defer t.RUnlock()
+	return t.maskValue(key, value)
+}
+
+func (t *properties) maskValue(key, value string) string {
+	for _, pattern := range t.maskPatterns {
+		if matched, _ := path.Match(pattern, key); matched {
+			if t.maskFunc != nil {
+				return t.maskFunc(key, value)
+			}
+			return DefaultMask
+		}
+	}
+	return value
+}
+
 func (t *properties) Extend(parent Properties) {
 	r := parent.PropertyResolvers()
 	t.Lock()
@@ -192,7 +343,13 @@ func max(a, b int) int {
 func (t *properties) Len() int {
 	t.RLock()
 	defer t.RUnlock()
-	return len(t.store)
+	n := len(t.store)
+	for k := range t.dynamic {
+		if _, ok := t.store[k]; !ok {
+			n++
+		}
+	}
+	return n
 }
 
 func (t *properties) Keys() []string {
@@ -202,6 +359,11 @@ func (t *properties) Keys() []string {
 	for k, _ := range t.store {
 		keys = append(keys, k)
 	}
+	for k := range t.dynamic {
+		if _, ok := t.store[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
 	return keys
 }
 
@@ -212,20 +374,23 @@ func (t *properties) Map() map[string]string {
 	for k, v := range t.store {
 		m[k] = v
 	}
+	for k, v := range t.dynamic {
+		m[k] = v
+	}
 	return m
 }
 
 func (t *properties) Contains(key string) bool {
 	t.RLock()
 	defer t.RUnlock()
-	_, ok := t.store[key]
+	_, ok := t.local(key)
 	return ok
 }
 
 func (t *properties) GetProperty(key string) (value string, ok bool) {
 	t.RLock()
 	defer t.RUnlock()
-	value, ok = t.store[key]
+	value, ok = t.local(key)
 	return
 }
 
@@ -239,24 +404,72 @@ func (t *properties) nextPropertyResolver(i int) (PropertyResolver, bool) {
 }
 
 func (t *properties) Get(key string) (value string, ok bool) {
+	return t.getAs(key, "")
+}
+
+/*
+	Gets the property and reports the access to the auditor with the bean reading it
+*/
+
+func (t *properties) getAs(key, bean string) (string, bool) {
+	value, ok, r := t.lookup(key)
+	if t.auditEnabled() {
+		t.audit(key, ok, r, bean)
+	}
+	return value, ok
+}
+
+func (t *properties) lookup(key string) (string, bool, PropertyResolver) {
 	for i := 0; ; i++ {
 		r, ok := t.nextPropertyResolver(i)
 		if !ok {
 			break
 		}
 		if value, ok := r.GetProperty(key); ok {
-			return value, true
+			return value, true, r
 		}
 	}
-	return "", false
+	return t.lookupElement(key)
+}
+
+/*
+	Gets element of the array property by key like 'hosts[2]', arrays are stored as ';' separated values
+*/
+
+func (t *properties) getElement(key string) (string, bool) {
+	value, ok, _ := t.lookupElement(key)
+	return value, ok
+}
+
+func (t *properties) lookupElement(key string) (string, bool, PropertyResolver) {
+	if !strings.HasSuffix(key, "]") {
+		return "", false, nil
+	}
+	open := strings.LastIndexByte(key, '[')
+	if open <= 0 {
+		return "", false, nil
+	}
+	index, err := strconv.Atoi(key[open+1 : len(key)-1])
+	if err != nil || index < 0 {
+		return "", false, nil
+	}
+	value, ok, r := t.lookup(key[:open])
+	if !ok {
+		return "", false, nil
+	}
+	parts := trimSplit(value, ";")
+	if index >= len(parts) {
+		return "", false, nil
+	}
+	return parts[index], true, r
 }
 
 func (t *properties) Resolve(key string) (value string, ok bool, err error) {
-	return t.resolveKey(key, nil)
+	return t.resolveKey(key, nil, "")
 }
 
 func (t *properties) ResolveText(text string) (string, error) {
-	return t.resolveText(text, nil)
+	return t.resolveText(text, nil, "")
 }
 
 func (t *properties) GetString(key, def string) string {
@@ -409,26 +622,26 @@ func (t *properties) GetFileMode(key string, def os.FileMode) os.FileMode {
 	}
 }
 
-func (t *properties) resolveKey(key string, stack []string) (string, bool, error) {
+func (t *properties) resolveKey(key string, stack []string, bean string) (string, bool, error) {
 	for _, item := range stack {
 		if item == key {
 			return "", false, fmt.Errorf("circular property reference: %s", strings.Join(append(stack, key), " -> "))
 		}
 	}
 
-	raw, ok := t.Get(key)
+	raw, ok := t.getAs(key, bean)
 	if !ok {
 		return "", false, nil
 	}
 
-	resolved, err := t.resolveText(raw, append(stack, key))
+	resolved, err := t.resolveText(raw, append(stack, key), bean)
 	if err != nil {
 		return "", false, err
 	}
 	return resolved, true, nil
 }
 
-func (t *properties) resolveText(text string, stack []string) (string, error) {
+func (t *properties) resolveText(text string, stack []string, bean string) (string, error) {
 	if !strings.Contains(text, "${") {
 		return text, nil
 	}
@@ -460,7 +673,7 @@ func (t *properties) resolveText(text string, stack []string) (string, error) {
 			return "", fmt.Errorf("empty property expression in '%s'", text)
 		}
 
-		value, ok, err := t.resolveKey(key, stack)
+		value, ok, err := t.resolveKey(key, stack, bean)
 		if err != nil {
 			return "", err
 		}
@@ -468,7 +681,7 @@ func (t *properties) resolveText(text string, stack []string) (string, error) {
 			if !hasDefault {
 				return "", fmt.Errorf("property '%s' not found", key)
 			}
-			value, err = t.resolveText(def, stack)
+			value, err = t.resolveText(def, stack, bean)
 			if err != nil {
 				return "", err
 			}
@@ -482,56 +695,69 @@ func (t *properties) resolveText(text string, stack []string) (string, error) {
 }
 
 func (t *properties) Set(key string, value string) {
+	if t.rejectFrozen(key) {
+		return
+	}
+	var events []PropertyChangedEvent
 	t.Lock()
-	defer t.Unlock()
-	t.store[key] = value
+	t.put(key, value, "Set", &events)
+	t.Unlock()
+	t.notify(events)
 }
 
 func (t *properties) Remove(key string) bool {
+	if t.rejectFrozen(key) {
+		return false
+	}
+	t.RLock()
+	dynamic := t.frozen == FreezeDynamic
+	t.RUnlock()
+	if dynamic {
+		return t.removeDynamic(key)
+	}
 	t.Lock()
-	defer t.Unlock()
-	_, ok := t.store[key]
+	old, ok := t.store[key]
 	if !ok {
+		t.Unlock()
 		return false
 	}
 	delete(t.store, key)
+	delete(t.typed, key)
+	delete(t.meta, key)
+	notify := len(t.listeners) > 0
+	t.Unlock()
+	if notify {
+		t.notify([]PropertyChangedEvent{{Key: key, Old: old, Source: "Remove"}})
+	}
 	return true
 }
 
 func (t *properties) Clear() {
-	t.Lock()
-	defer t.Unlock()
-	t.store = make(map[string]string)
-}
-
-func encodeUtf8(s string, special string) string {
-	v := ""
-	for pos := 0; pos < len(s); {
-		r, w := utf8.DecodeRuneInString(s[pos:])
-		pos += w
-		v += escape(r, special)
+	if t.rejectFrozen("") {
+		return
 	}
-	return v
-}
-
-func escape(r rune, special string) string {
-	switch r {
-	case '\f':
-		return "\\f"
-	case '\n':
-		return "\\n"
-	case '\r':
-		return "\\r"
-	case '\t':
-		return "\\t"
-	case '\\':
-		return "\\\\"
-	default:
-		if strings.ContainsRune(special, r) {
-			return "\\" + string(r)
+	t.RLock()
+	dynamic := t.frozen == FreezeDynamic
+	t.RUnlock()
+	if dynamic {
+		t.clearDynamic()
+		return
+	}
+	var events []PropertyChangedEvent
+	t.Lock()
+	if len(t.listeners) > 0 {
+		for key, old := range t.store {
+			events = append(events, PropertyChangedEvent{Key: key, Old: old, Source: "Clear"})
 		}
-		return string(r)
+		sort.Slice(events, func(i, j int) bool {
+			return events[i].Key < events[j].Key
+		})
 	}
+	t.store = make(map[string]string)
+	t.typed = nil
+	t.meta = nil
+	t.Unlock()
+	t.notify(events)
 }
 
 func parseBool(str string) (bool, error) {
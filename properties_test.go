@@ -420,3 +420,118 @@ func TestParseFileMode(t *testing.T) {
 	}
 
 }
+
+var typedPropertiesYAML = `
+server:
+  enabled: true
+  mode: "on"
+  flag: on
+  perm: 0755
+  scale: 1e3
+  port: 8080
+  started: 2022-10-22T10:00:00Z
+  hosts: [alpha, 2, false]
+`
+
+func TestTypedProperties(t *testing.T) {
+
+	fileName := "typed.yaml"
+	ctx, err := glue.New(
+		glue.ResourceSource{
+			Name:       "resources",
+			AssetNames: []string{fileName},
+			AssetFiles: oneFile{name: fileName, content: typedPropertiesYAML},
+		},
+		glue.PropertySource{File: "resources:" + fileName},
+	)
+	require.NoError(t, err)
+	defer ctx.Close()
+
+	p := ctx.Properties()
+
+	value, kind := p.GetTyped("server.enabled")
+	require.Equal(t, glue.PropertyBool, kind)
+	require.Equal(t, true, value)
+
+	value, kind = p.GetTyped("server.mode")
+	require.Equal(t, glue.PropertyString, kind)
+	require.Equal(t, "on", value)
+
+	value, kind = p.GetTyped("server.flag")
+	require.Equal(t, glue.PropertyString, kind)
+	require.Equal(t, "on", value)
+
+	value, kind = p.GetTyped("server.perm")
+	require.Equal(t, glue.PropertyInt, kind)
+	require.Equal(t, int64(0755), value)
+
+	value, kind = p.GetTyped("server.scale")
+	require.Equal(t, glue.PropertyFloat, kind)
+	require.Equal(t, float64(1000), value)
+	require.Equal(t, "1000", p.GetString("server.scale", ""))
+
+	value, kind = p.GetTyped("server.port")
+	require.Equal(t, glue.PropertyInt, kind)
+	require.Equal(t, int64(8080), value)
+
+	value, kind = p.GetTyped("server.started")
+	require.Equal(t, glue.PropertyTime, kind)
+	require.Equal(t, time.Date(2022, 10, 22, 10, 0, 0, 0, time.UTC), value)
+
+	value, kind = p.GetTyped("server.hosts")
+	require.Equal(t, glue.PropertyList, kind)
+	require.Equal(t, []any{"alpha", int64(2), false}, value)
+
+	p.Set("server.port", "9090")
+	value, kind = p.GetTyped("server.port")
+	require.Equal(t, glue.PropertyString, kind)
+	require.Equal(t, "9090", value)
+
+	value, kind = p.GetTyped("server.unknown")
+	require.Equal(t, glue.PropertyMissing, kind)
+	require.Nil(t, value)
+}
+
+func TestPropertyEntries(t *testing.T) {
+
+	p := glue.NewProperties()
+	require.NoError(t, p.Parse("# database url\n# set per environment\ndb.url = localhost\ndb.port = 5432\n"))
+
+	e, ok := p.GetEntry("db.url")
+	require.True(t, ok)
+	require.Equal(t, glue.PropertyEntry{Value: "localhost", Comments: []string{"database url", "set per environment"}, Origin: "Parse"}, e)
+
+	p.Set("db.url", "remote")
+	e, _ = p.GetEntry("db.url")
+	require.Equal(t, "remote", e.Value)
+	require.Equal(t, "Set", e.Origin)
+	require.Equal(t, []string{"database url", "set per environment"}, e.Comments)
+
+	p.SetEntry("db.port", glue.PropertyEntry{Value: "6432", Comments: []string{"pooler port"}, Origin: "tuning"})
+	require.Equal(t, "# pooler port\ndb.port = 6432\n# database url\n# set per environment\ndb.url = remote\n", p.Dump())
+
+	q := glue.NewProperties()
+	require.NoError(t, q.Parse(p.Dump()))
+	e, _ = q.GetEntry("db.port")
+	require.Equal(t, []string{"pooler port"}, e.Comments)
+
+	p.Remove("db.url")
+	_, ok = p.GetEntry("db.url")
+	require.False(t, ok)
+
+	ctn, err := glue.New(
+		glue.ResourceSource{
+			Name:       "resources",
+			AssetNames: []string{"application.properties"},
+			AssetFiles: oneFile{name: "application.properties", content: "# greeting\napp.name = demo\n"},
+		},
+		glue.PropertySource{File: "resources:application.properties"},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	e, ok = ctn.Properties().GetEntry("app.name")
+	require.True(t, ok)
+	require.Equal(t, []string{"greeting"}, e.Comments)
+	require.Equal(t, "resources:application.properties", e.Origin)
+}
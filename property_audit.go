@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+type propertyAudit struct {
+	auditor  PropertyAuditor
+	sampling int64
+}
+
+func (t *properties) SetAuditor(auditor PropertyAuditor, sampling int) {
+	if auditor == nil {
+		t.auditState.Store((*propertyAudit)(nil))
+		return
+	}
+	if sampling < 1 {
+		sampling = 1
+	}
+	t.auditState.Store(&propertyAudit{auditor: auditor, sampling: int64(sampling)})
+}
+
+func (t *properties) auditEnabled() bool {
+	state, _ := t.auditState.Load().(*propertyAudit)
+	return state != nil
+}
+
+/**
+Reports every sampling-th read to the auditor
+*/
+
+func (t *properties) audit(key string, found bool, r PropertyResolver, bean string) {
+	state, _ := t.auditState.Load().(*propertyAudit)
+	if state == nil {
+		return
+	}
+	if state.sampling > 1 && atomic.AddInt64(&t.auditReads, 1)%state.sampling != 1 {
+		return
+	}
+	state.auditor.OnPropertyAccess(PropertyAccess{
+		Key:    key,
+		Found:  found,
+		Origin: t.origin(key, r),
+		Bean:   bean,
+	})
+}
+
+/**
+Returns the origin of the value recorded by the properties store or the type of the resolver
+*/
+
+func (t *properties) origin(key string, r PropertyResolver) string {
+	switch resolver := r.(type) {
+	case nil:
+		return ""
+	case *properties:
+		if entry, ok := resolver.GetEntry(key); ok && entry.Origin != "" {
+			return entry.Origin
+		}
+		return "properties"
+	default:
+		return fmt.Sprintf("%T", resolver)
+	}
+}
+
+/**
+Properties view that reports reads of property injection with the name of the bean
+*/
+
+type beanProperties struct {
+	*properties
+	bean string
+}
+
+func (t beanProperties) Resolve(key string) (string, bool, error) {
+	return t.properties.resolveKey(key, nil, t.bean)
+}
+
+func (t beanProperties) ResolveText(text string) (string, error) {
+	return t.properties.resolveText(text, nil, t.bean)
+}
+
+/**
+Returns properties reporting reads with the bean name if auditing is on
+*/
+
+func auditedProperties(props Properties, bean string) Properties {
+	if p, ok := props.(*properties); ok && p.auditEnabled() {
+		return beanProperties{properties: p, bean: bean}
+	}
+	return props
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type recordingAuditor struct {
+	mu     sync.Mutex
+	access []glue.PropertyAccess
+}
+
+func (t *recordingAuditor) OnPropertyAccess(access glue.PropertyAccess) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.access = append(t.access, access)
+}
+
+type auditedBean struct {
+	Password string `value:"db.password"`
+	Timeout  int    `value:"db.timeout,default=5"`
+}
+
+func TestPropertyAuditor(t *testing.T) {
+
+	auditor := &recordingAuditor{}
+	ctn, err := glue.NewWithOptions(
+		glue.WithPropertyAuditor(auditor, 1),
+		glue.WithBeans(&auditedBean{}, glue.MapPropertySource{"db.password": "${db.secret}", "db.secret": "s3cret"}),
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	byKey := make(map[string]glue.PropertyAccess)
+	for _, a := range auditor.access {
+		if a.Bean != "" {
+			byKey[a.Key] = a
+		}
+	}
+
+	require.Equal(t, glue.PropertyAccess{Key: "db.password", Found: true, Origin: "LoadMap", Bean: "*glue_test.auditedBean"}, byKey["db.password"])
+	require.Equal(t, "*glue_test.auditedBean", byKey["db.secret"].Bean)
+	require.False(t, byKey["db.timeout"].Found)
+
+	auditor.access = nil
+	ctn.Properties().GetString("db.secret", "")
+	require.Equal(t, []glue.PropertyAccess{{Key: "db.secret", Found: true, Origin: "LoadMap"}}, auditor.access)
+
+	auditor.access = nil
+	ctn.Properties().SetAuditor(auditor, 3)
+	for i := 0; i < 6; i++ {
+		ctn.Properties().Get("db.secret")
+	}
+	require.Equal(t, 2, len(auditor.access))
+
+	auditor.access = nil
+	ctn.Properties().SetAuditor(nil, 0)
+	ctn.Properties().Get("db.secret")
+	require.Empty(t, auditor.access)
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"io"
+	"io/ioutil"
+)
+
+type propertyMeta struct {
+	comments []string
+	origin   string
+}
+
+/**
+Properties implementations that record the file name as the origin of loaded values
+*/
+
+type originLoader interface {
+	loadMapFrom(source map[string]any, origin string)
+	loadFrom(reader io.Reader, origin string) error
+}
+
+func (t *properties) GetEntry(key string) (PropertyEntry, bool) {
+	t.RLock()
+	defer t.RUnlock()
+	value, ok := t.local(key)
+	if !ok {
+		return PropertyEntry{}, false
+	}
+	meta, dynamic := t.metaOf(key)
+	return PropertyEntry{
+		Value:    value,
+		Comments: append([]string(nil), meta.comments...),
+		Origin:   meta.origin,
+		Dynamic:  dynamic,
+	}, true
+}
+
+func (t *properties) SetEntry(key string, entry PropertyEntry) {
+	if t.rejectFrozen(key) {
+		return
+	}
+	var events []PropertyChangedEvent
+	t.Lock()
+	t.put(key, entry.Value, "SetEntry", &events)
+	if entry.Origin != "" {
+		t.putOrigin(key, entry.Origin)
+	}
+	t.putComments(key, entry.Comments)
+	t.Unlock()
+	t.notify(events)
+}
+
+func (t *properties) putOrigin(key, origin string) {
+	if t.loadOrigin != "" {
+		origin = t.loadOrigin
+	}
+	layer := t.metaLayer()
+	meta := layer[key]
+	meta.origin = origin
+	layer[key] = meta
+}
+
+func (t *properties) putComments(key string, comments []string) {
+	layer := t.metaLayer()
+	meta := layer[key]
+	meta.comments = append([]string(nil), comments...)
+	layer[key] = meta
+}
+
+func (t *properties) loadMapFrom(source map[string]any, origin string) {
+	if t.rejectFrozen("") {
+		return
+	}
+	var events []PropertyChangedEvent
+	t.Lock()
+	t.loadOrigin = origin
+	t.loadMapRec(make([]byte, 0, 100), source, &events)
+	t.loadOrigin = ""
+	t.Unlock()
+	t.notify(events)
+}
+
+func (t *properties) loadFrom(reader io.Reader, origin string) error {
+	if t.rejectFrozen("") {
+		return ErrPropertiesFrozen
+	}
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	var events []PropertyChangedEvent
+	defer func() {
+		t.notify(events)
+	}()
+	t.Lock()
+	defer t.Unlock()
+	t.loadOrigin = origin
+	defer func() {
+		t.loadOrigin = ""
+	}()
+	return t.parse(string(content), &events)
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type propertyCache struct {
+	Size   int `value:"cache.size,default=10"`
+	events []glue.PropertyChangedEvent
+}
+
+func (t *propertyCache) OnPropertyChanged(event glue.PropertyChangedEvent) {
+	t.events = append(t.events, event)
+}
+
+func TestPropertyChangedEvents(t *testing.T) {
+
+	cache := &propertyCache{}
+	ctn, err := glue.New(
+		cache,
+		glue.MapPropertySource{"cache.size": "5"},
+	)
+	require.NoError(t, err)
+
+	// initial load happens before subscription
+	require.Empty(t, cache.events)
+
+	props := ctn.Properties()
+	props.Set("cache.size", "20")
+	props.Set("cache.size", "20")
+	require.Equal(t, []glue.PropertyChangedEvent{{Key: "cache.size", Old: "5", New: "20", Source: "Set"}}, cache.events)
+
+	props.LoadMap(map[string]any{"cache": map[string]any{"ttl": 60}})
+	require.Equal(t, glue.PropertyChangedEvent{Key: "cache.ttl", New: "60", Source: "LoadMap"}, cache.events[1])
+
+	require.NoError(t, props.Parse("cache.ttl = 120\n"))
+	require.Equal(t, glue.PropertyChangedEvent{Key: "cache.ttl", Old: "60", New: "120", Source: "Parse"}, cache.events[2])
+
+	require.True(t, props.Remove("cache.ttl"))
+	require.Equal(t, glue.PropertyChangedEvent{Key: "cache.ttl", Old: "120", Source: "Remove"}, cache.events[3])
+
+	props.Clear()
+	require.Equal(t, glue.PropertyChangedEvent{Key: "cache.size", Old: "20", Source: "Clear"}, cache.events[4])
+	require.Equal(t, 5, len(cache.events))
+
+	require.NoError(t, ctn.Close())
+
+	props.Set("cache.size", "1")
+	require.Equal(t, 5, len(cache.events))
+}
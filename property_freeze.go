@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrPropertiesFrozen is reported by changes of properties frozen with FreezeStrict.
+var ErrPropertiesFrozen = errors.New("properties are frozen")
+
+/**
+FreezeMode defines what happens with changes of properties after Properties.Freeze,
+for example after the container started with WithPropertyFreeze option.
+*/
+
+type FreezeMode int
+
+const (
+	// FreezeNone changes the store directly, the dynamic layer is merged back to the store
+	FreezeNone FreezeMode = iota
+
+	// FreezeDynamic routes changes in to the dynamic layer on top of the loaded values, so origins of loaded values are kept
+	FreezeDynamic
+
+	// FreezeStrict rejects changes, the error handler receives ErrPropertiesFrozen
+	FreezeStrict
+)
+
+func (t FreezeMode) String() string {
+	switch t {
+	case FreezeNone:
+		return "FreezeNone"
+	case FreezeDynamic:
+		return "FreezeDynamic"
+	case FreezeStrict:
+		return "FreezeStrict"
+	default:
+		return "FreezeUnknown"
+	}
+}
+
+func (t *properties) Freeze(mode FreezeMode) {
+	t.Lock()
+	defer t.Unlock()
+	t.frozen = mode
+	switch mode {
+	case FreezeDynamic:
+		if t.dynamic == nil {
+			t.dynamic = make(map[string]string)
+			t.dynamicMeta = make(map[string]propertyMeta)
+		}
+	case FreezeNone:
+		for key, value := range t.dynamic {
+			t.store[key] = value
+			delete(t.typed, key)
+			if t.meta == nil {
+				t.meta = make(map[string]propertyMeta)
+			}
+			t.meta[key] = t.dynamicMeta[key]
+		}
+		t.dynamic, t.dynamicMeta = nil, nil
+	}
+}
+
+/**
+Returns true and reports ErrPropertiesFrozen to the error handler if changes are rejected,
+without the handler the rejected key goes to the log of the container that froze the properties
+*/
+
+func (t *properties) rejectFrozen(key string) bool {
+	t.RLock()
+	strict, cb, logf := t.frozen == FreezeStrict, t.errorHandler, t.frozenLog
+	t.RUnlock()
+	if strict {
+		if cb != nil {
+			cb(key, ErrPropertiesFrozen)
+		} else if logf != nil {
+			logf(key)
+		}
+	}
+	return strict
+}
+
+/**
+Gets the value of the dynamic layer, otherwise of the store, must be called under the lock
+*/
+
+func (t *properties) local(key string) (string, bool) {
+	if value, ok := t.dynamic[key]; ok {
+		return value, true
+	}
+	value, ok := t.store[key]
+	return value, ok
+}
+
+/**
+Returns comments and origins of the layer receiving changes, must be called under the lock
+*/
+
+func (t *properties) metaLayer() map[string]propertyMeta {
+	if t.frozen == FreezeDynamic {
+		return t.dynamicMeta
+	}
+	if t.meta == nil {
+		t.meta = make(map[string]propertyMeta)
+	}
+	return t.meta
+}
+
+/**
+Returns meta of the layer providing the value of the key, must be called under the lock
+*/
+
+func (t *properties) metaOf(key string) (propertyMeta, bool) {
+	if _, ok := t.dynamic[key]; ok {
+		return t.dynamicMeta[key], true
+	}
+	return t.meta[key], false
+}
+
+/**
+Removes the value of the dynamic layer, the loaded value becomes visible again
+*/
+
+func (t *properties) removeDynamic(key string) bool {
+	t.Lock()
+	old, ok := t.dynamic[key]
+	if !ok {
+		t.Unlock()
+		return false
+	}
+	delete(t.dynamic, key)
+	delete(t.dynamicMeta, key)
+	value := t.store[key]
+	notify := len(t.listeners) > 0 && old != value
+	t.Unlock()
+	if notify {
+		t.notify([]PropertyChangedEvent{{Key: key, Old: old, New: value, Source: "Remove"}})
+	}
+	return true
+}
+
+func (t *properties) clearDynamic() {
+	var events []PropertyChangedEvent
+	t.Lock()
+	for key, old := range t.dynamic {
+		if value := t.store[key]; len(t.listeners) > 0 && old != value {
+			events = append(events, PropertyChangedEvent{Key: key, Old: old, New: value, Source: "Clear"})
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Key < events[j].Key
+	})
+	t.dynamic = make(map[string]string)
+	t.dynamicMeta = make(map[string]propertyMeta)
+	t.Unlock()
+	t.notify(events)
+}
+
+func (t *properties) Explain(key string) []PropertyEntry {
+	var list []PropertyEntry
+	for i := 0; ; i++ {
+		r, ok := t.nextPropertyResolver(i)
+		if !ok {
+			break
+		}
+		if p, ok := r.(*properties); ok {
+			list = append(list, p.layers(key)...)
+		} else if value, ok := r.GetProperty(key); ok {
+			list = append(list, PropertyEntry{Value: value, Origin: fmt.Sprintf("%T", r)})
+		}
+	}
+	return list
+}
+
+/**
+Returns entries of the dynamic layer and the store having the key
+*/
+
+func (t *properties) layers(key string) []PropertyEntry {
+	t.RLock()
+	defer t.RUnlock()
+	var list []PropertyEntry
+	if value, ok := t.dynamic[key]; ok {
+		meta := t.dynamicMeta[key]
+		list = append(list, PropertyEntry{Value: value, Comments: append([]string(nil), meta.comments...), Origin: meta.origin, Dynamic: true})
+	}
+	if value, ok := t.store[key]; ok {
+		meta := t.meta[key]
+		list = append(list, PropertyEntry{Value: value, Comments: append([]string(nil), meta.comments...), Origin: meta.origin})
+	}
+	return list
+}
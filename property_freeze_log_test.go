@@ -0,0 +1,34 @@
+//go:build !glue_nolog
+
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"bytes"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+func TestPropertyFreezeStrictLogged(t *testing.T) {
+
+	var buf bytes.Buffer
+	ctn, err := glue.NewWithOptions(
+		glue.WithLogger(log.New(&buf, "", 0)),
+		glue.WithLogLevel(glue.LogError),
+		glue.WithPropertyFreeze(glue.FreezeStrict),
+		glue.WithBeans(glue.MapPropertySource{"app.name": "demo"}),
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	ctn.Properties().Set("app.name", "other")
+	require.Equal(t, "demo", ctn.Properties().GetString("app.name", ""))
+	require.Contains(t, buf.String(), "Change of property 'app.name' rejected: properties are frozen")
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+func TestPropertyFreezeDynamic(t *testing.T) {
+
+	ctn, err := glue.NewWithOptions(
+		glue.WithPropertyFreeze(glue.FreezeDynamic),
+		glue.WithBeans(glue.MapPropertySource{"db.url": "localhost", "db.pool": "5"}),
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	props := ctn.Properties()
+	loaded, ok := props.GetEntry("db.url")
+	require.True(t, ok)
+	require.False(t, loaded.Dynamic)
+
+	props.Set("db.url", "remote")
+	props.Set("db.timeout", "30")
+	require.Equal(t, "remote", props.GetString("db.url", ""))
+	require.Equal(t, 3, props.Len())
+	require.Equal(t, map[string]string{"db.url": "remote", "db.pool": "5", "db.timeout": "30"}, props.Map())
+
+	e, ok := props.GetEntry("db.url")
+	require.True(t, ok)
+	require.True(t, e.Dynamic)
+	require.Equal(t, "Set", e.Origin)
+
+	list := props.Explain("db.url")
+	require.Equal(t, 2, len(list))
+	require.Equal(t, "remote", list[0].Value)
+	require.True(t, list[0].Dynamic)
+	require.Equal(t, loaded, list[1])
+
+	require.Contains(t, props.Dump(), "# dynamic: Set\ndb.timeout = 30")
+
+	// removes only the dynamic value
+	require.True(t, props.Remove("db.url"))
+	require.Equal(t, "localhost", props.GetString("db.url", ""))
+	require.False(t, props.Remove("db.pool"))
+	require.Equal(t, "5", props.GetString("db.pool", ""))
+
+	props.Clear()
+	require.Equal(t, 2, props.Len())
+
+	// merges the dynamic layer back in to the store
+	props.Set("db.pool", "10")
+	props.Freeze(glue.FreezeNone)
+	require.Equal(t, 1, len(props.Explain("db.pool")))
+	require.Equal(t, 10, props.GetInt("db.pool", 0))
+}
+
+func TestPropertyFreezeStrict(t *testing.T) {
+
+	props := glue.NewProperties()
+	props.Set("app.name", "demo")
+
+	var rejected []string
+	props.SetErrorHandler(func(key string, err error) {
+		require.ErrorIs(t, err, glue.ErrPropertiesFrozen)
+		rejected = append(rejected, key)
+	})
+	props.Freeze(glue.FreezeStrict)
+
+	props.Set("app.name", "other")
+	require.False(t, props.Remove("app.name"))
+	require.ErrorIs(t, props.Parse("app.port = 80\n"), glue.ErrPropertiesFrozen)
+	props.Clear()
+
+	require.Equal(t, "demo", props.GetString("app.name", ""))
+	require.Equal(t, 1, props.Len())
+	require.Equal(t, []string{"app.name", "app.name", "", ""}, rejected)
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"math"
+	"time"
+)
+
+type typedValue struct {
+	value any
+	kind  PropertyKind
+}
+
+/*
+	Keeps the decoded scalar of the structured source, must be called under the lock after put
+*/
+
+func (t *properties) putTyped(key string, v any) {
+	value, kind := propertyKindOf(v)
+	if kind == PropertyString || t.frozen == FreezeDynamic {
+		return
+	}
+	if t.typed == nil {
+		t.typed = make(map[string]typedValue)
+	}
+	t.typed[key] = typedValue{value: value, kind: kind}
+}
+
+func (t *properties) GetTyped(key string) (any, PropertyKind) {
+	for i := 0; ; i++ {
+		r, ok := t.nextPropertyResolver(i)
+		if !ok {
+			break
+		}
+		value, ok := r.GetProperty(key)
+		if !ok {
+			continue
+		}
+		if p, ok := r.(*properties); ok {
+			p.RLock()
+			_, dynamic := p.dynamic[key]
+			tv, ok := p.typed[key]
+			p.RUnlock()
+			if dynamic {
+				ok = false
+			}
+			if ok {
+				return tv.value, tv.kind
+			}
+		}
+		return value, PropertyString
+	}
+	if value, ok := t.getElement(key); ok {
+		return value, PropertyString
+	}
+	return nil, PropertyMissing
+}
+
+func propertyKindOf(v any) (any, PropertyKind) {
+	switch x := v.(type) {
+	case bool:
+		return x, PropertyBool
+	case int:
+		return int64(x), PropertyInt
+	case int8:
+		return int64(x), PropertyInt
+	case int16:
+		return int64(x), PropertyInt
+	case int32:
+		return int64(x), PropertyInt
+	case int64:
+		return x, PropertyInt
+	case uint:
+		return unsignedProperty(uint64(x))
+	case uint8:
+		return int64(x), PropertyInt
+	case uint16:
+		return int64(x), PropertyInt
+	case uint32:
+		return int64(x), PropertyInt
+	case uint64:
+		return unsignedProperty(x)
+	case float32:
+		return float64(x), PropertyFloat
+	case float64:
+		return x, PropertyFloat
+	case time.Time:
+		return x, PropertyTime
+	case []any:
+		list := make([]any, len(x))
+		for i, e := range x {
+			list[i], _ = propertyKindOf(e)
+		}
+		return list, PropertyList
+	default:
+		return v, PropertyString
+	}
+}
+
+func unsignedProperty(x uint64) (any, PropertyKind) {
+	if x > math.MaxInt64 {
+		return float64(x), PropertyFloat
+	}
+	return int64(x), PropertyInt
+}
@@ -0,0 +1,63 @@
+//go:build !glue_nolog
+
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type bufferLogger struct {
+	lines []string
+}
+
+func (t *bufferLogger) Printf(format string, v ...any) {
+	t.lines = append(t.lines, fmt.Sprintf(format, v...))
+}
+
+func (t *bufferLogger) Println(v ...any) {
+	t.lines = append(t.lines, fmt.Sprintln(v...))
+}
+
+func TestVerbosePropertyLoadStats(t *testing.T) {
+
+	fileName := "stats.yaml"
+	content := "server:\n  port: 8080\n  hosts: [a, b]\n  tls:\n    enabled: true\n"
+	logger := &bufferLogger{}
+
+	ctx, err := glue.NewWithOptions(
+		glue.WithLogger(logger),
+		glue.WithBeans(
+			glue.ResourceSource{
+				Name:       "resources",
+				AssetNames: []string{fileName},
+				AssetFiles: oneFile{name: fileName, content: content},
+			},
+			glue.PropertySource{File: "resources:" + fileName},
+		),
+	)
+	require.NoError(t, err)
+	defer ctx.Close()
+
+	var stats string
+	for _, line := range logger.lines {
+		if strings.HasPrefix(line, "Properties 'resources:stats.yaml'") {
+			stats = line
+		}
+	}
+	require.Contains(t, stats, "format=yaml")
+	require.Contains(t, stats, fmt.Sprintf("size=%d", len(content)))
+	require.Contains(t, stats, "values=4")
+	require.Contains(t, stats, "depth=3")
+	require.Contains(t, stats, "decode=")
+	require.Contains(t, stats, "flatten=")
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type propertyMapConfig struct {
+	Port int               `value:"server.port"`
+	Host string            `value:"server.host,default=localhost"`
+	DB   map[string]string `value:"prefix=db"`
+}
+
+func TestPropertyMap_OverridesSources(t *testing.T) {
+	cfg := &propertyMapConfig{}
+	ctn, err := glue.New(
+		glue.MapPropertySource{"server.port": 9090, "db.host": "file-host"},
+		glue.PropertyMap{"server.port": "8080", "db.user": "admin"},
+		cfg,
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, 8080, cfg.Port)
+	require.Equal(t, "localhost", cfg.Host)
+	require.Equal(t, map[string]string{"host": "file-host", "user": "admin"}, cfg.DB)
+}
+
+func TestPropertyMap_OverridesEnv(t *testing.T) {
+	os.Setenv("SERVER_PORT", "7070")
+	defer os.Unsetenv("SERVER_PORT")
+
+	cfg := &propertyMapConfig{}
+	ctn, err := glue.New(
+		&glue.EnvPropertyResolver{},
+		glue.PropertyMap{"server.port": "8080"},
+		cfg,
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, 8080, cfg.Port)
+	require.Equal(t, "8080", ctn.Properties().GetString("server.port", ""))
+}
+
+func TestPropertyMap_Copied(t *testing.T) {
+	m := glue.PropertyMap{"server.port": "8080"}
+	ctn, err := glue.New(m)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	m["server.port"] = "1"
+	require.Equal(t, 8080, ctn.Properties().GetInt("server.port", 0))
+}
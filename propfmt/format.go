@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package propfmt
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// Entry is the parsed property with comment lines preceding it.
+type Entry struct {
+	Key      string
+	Value    string
+	Comments []string
+}
+
+/*
+Scan parses the content and calls fn for every property in the order of appearance.
+Properties before the syntax error are reported.
+*/
+
+func Scan(content string, fn func(key, value string)) error {
+	return ScanEntries(content, func(e Entry) {
+		fn(e.Key, e.Value)
+	})
+}
+
+/*
+ScanEntries works like Scan and reports comment lines preceding the property without comment markers.
+*/
+
+func ScanEntries(content string, fn func(Entry)) error {
+	var key string
+	var inside bool
+	var comments []string
+
+	for _, token := range Lex(content) {
+		switch token.Type {
+		case TokenEOF:
+			if inside {
+				fn(Entry{Key: key, Comments: comments})
+			}
+		case TokenComment:
+			comments = append(comments, token.Value)
+		case TokenKey:
+			if inside {
+				return fmt.Errorf("key is not expected inside the property on key '%s'", key)
+			}
+			key = token.Value
+			inside = true
+		case TokenValue:
+			if !inside {
+				return fmt.Errorf("value is not expected outside of the property after key '%s'", key)
+			}
+			fn(Entry{Key: key, Value: token.Value, Comments: comments})
+			comments = nil
+			inside = false
+		case TokenError:
+			if inside {
+				return fmt.Errorf("property parsing error on key '%s', %s", key, token.Value)
+			} else {
+				return fmt.Errorf("property parsing error after key '%s', %s", key, token.Value)
+			}
+		}
+	}
+	return nil
+}
+
+/*
+Parse returns properties of the content, later duplicates are kept.
+*/
+
+func Parse(content string) ([]Entry, error) {
+	var list []Entry
+	err := ScanEntries(content, func(e Entry) {
+		list = append(list, e)
+	})
+	return list, err
+}
+
+/*
+Format writes entries as 'key = value' lines preceded by '# comment' lines the way glue saves properties.
+*/
+
+func Format(w io.Writer, entries []Entry) error {
+	var out strings.Builder
+	for _, e := range entries {
+		for _, comment := range e.Comments {
+			out.WriteString(FormatComment(comment))
+		}
+		out.WriteString(FormatEntry(e.Key, e.Value))
+	}
+	_, err := io.WriteString(w, out.String())
+	return err
+}
+
+func FormatEntry(key, value string) string {
+	return EscapeKey(key) + " = " + EscapeValue(value) + "\n"
+}
+
+// FormatComment returns the comment line, line breaks of the comment are replaced by spaces.
+func FormatComment(comment string) string {
+	return "# " + strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(comment) + "\n"
+}
+
+// EscapeKey escapes control characters, backslash, space and colon of the key.
+func EscapeKey(key string) string {
+	return encodeUtf8(key, " :")
+}
+
+// EscapeValue escapes control characters and backslash of the value.
+func EscapeValue(value string) string {
+	return encodeUtf8(value, "")
+}
+
+func encodeUtf8(s string, special string) string {
+	var out strings.Builder
+	for pos := 0; pos < len(s); {
+		r, w := utf8.DecodeRuneInString(s[pos:])
+		pos += w
+		out.WriteString(escape(r, special))
+	}
+	return out.String()
+}
+
+func escape(r rune, special string) string {
+	switch r {
+	case '\f':
+		return "\\f"
+	case '\n':
+		return "\\n"
+	case '\r':
+		return "\\r"
+	case '\t':
+		return "\\t"
+	case '\\':
+		return "\\\\"
+	default:
+		if strings.ContainsRune(special, r) {
+			return "\\" + string(r)
+		}
+		return string(r)
+	}
+}
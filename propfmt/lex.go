@@ -3,7 +3,8 @@
  * SPDX-License-Identifier: BUSL-1.1
  */
 
-package glue
+// Package propfmt is the lexer, parser and formatter of the .properties dialect used by glue.
+package propfmt
 
 import (
 	"fmt"
@@ -12,16 +13,34 @@ import (
 	"unicode/utf8"
 )
 
-type itemType int
+// TokenType is the type of the lexed token.
+type TokenType int
 
 const (
-	itemError itemType = iota
-	itemEOF
-	itemKey
-	itemValue
-	itemComment
+	TokenError TokenType = iota
+	TokenEOF
+	TokenKey
+	TokenValue
+	TokenComment
 )
 
+func (t TokenType) String() string {
+	switch t {
+	case TokenError:
+		return "Error"
+	case TokenEOF:
+		return "EOF"
+	case TokenKey:
+		return "Key"
+	case TokenValue:
+		return "Value"
+	case TokenComment:
+		return "Comment"
+	default:
+		return "Unknown"
+	}
+}
+
 const (
 	eof        = -1
 	whitespace = " \f\t"
@@ -29,22 +48,24 @@ const (
 
 var unicodeLiteralMap = indexMap("0123456789abcdefABCDEF")
 
-type item struct {
-	typ itemType
-	pos int
-	val string
+// Token is the lexed key, value or comment with unescaped Value and byte offset Pos in the input.
+// Error token carries the error message in Value.
+type Token struct {
+	Type  TokenType
+	Pos   int
+	Value string
 }
 
-func (t item) String() string {
+func (t Token) String() string {
 	switch {
-	case t.typ == itemEOF:
+	case t.Type == TokenEOF:
 		return "EOF"
-	case t.typ == itemError:
-		return t.val
-	case len(t.val) > 10:
-		return fmt.Sprintf("%.10q...", t.val)
+	case t.Type == TokenError:
+		return t.Value
+	case len(t.Value) > 10:
+		return fmt.Sprintf("%.10q...", t.Value)
 	}
-	return fmt.Sprintf("%q", t.val)
+	return fmt.Sprintf("%q", t.Value)
 }
 
 type stateFn func(*lexer) stateFn
@@ -56,7 +77,7 @@ type lexer struct {
 	start int
 	width int
 	runes []rune
-	items []item
+	items []Token
 }
 
 func (t *lexer) next() rune {
@@ -80,8 +101,8 @@ func (t *lexer) backup() {
 	t.pos -= t.width
 }
 
-func (t *lexer) emit(typ itemType) {
-	i := item{typ, t.start, string(t.runes)}
+func (t *lexer) emit(typ TokenType) {
+	i := Token{typ, t.start, string(t.runes)}
 	t.items = append(t.items, i)
 	t.start = t.pos
 	t.runes = t.runes[:0]
@@ -110,12 +131,13 @@ func (t *lexer) acceptRun(valid string) {
 }
 
 func (t *lexer) errorf(format string, args ...any) stateFn {
-	i := item{itemError, t.start, fmt.Sprintf(format, args...)}
+	i := Token{TokenError, t.start, fmt.Sprintf(format, args...)}
 	t.items = append(t.items, i)
 	return nil
 }
 
-func lex(input string) []item {
+// Lex splits the input in to the token stream terminated by EOF or Error token.
+func Lex(input string) []Token {
 	l := &lexer{
 		input: input,
 		runes: make([]rune, 0, 32),
@@ -133,7 +155,7 @@ func (t *lexer) run() {
 func lexBeforeKey(t *lexer) stateFn {
 	switch r := t.next(); {
 	case isEOF(r):
-		t.emit(itemEOF)
+		t.emit(TokenEOF)
 		return nil
 
 	case isEOL(r):
@@ -160,10 +182,10 @@ func lexComment(t *lexer) stateFn {
 		switch r := t.next(); {
 		case isEOF(r):
 			t.ignore()
-			t.emit(itemEOF)
+			t.emit(TokenEOF)
 			return nil
 		case isEOL(r):
-			t.emit(itemComment)
+			t.emit(TokenComment)
 			return lexBeforeKey
 		default:
 			t.appendRune(r)
@@ -197,11 +219,11 @@ Loop:
 	}
 
 	if len(t.runes) > 0 {
-		t.emit(itemKey)
+		t.emit(TokenKey)
 	}
 
 	if isEOF(r) {
-		t.emit(itemEOF)
+		t.emit(TokenEOF)
 		return nil
 	}
 
@@ -231,13 +253,13 @@ func lexValue(t *lexer) stateFn {
 			}
 
 		case isEOL(r):
-			t.emit(itemValue)
+			t.emit(TokenValue)
 			t.ignore()
 			return lexBeforeKey
 
 		case isEOF(r):
-			t.emit(itemValue)
-			t.emit(itemEOF)
+			t.emit(TokenValue)
+			t.emit(TokenEOF)
 			return nil
 
 		default:
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package propfmt_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue/propfmt"
+)
+
+func TestLex(t *testing.T) {
+
+	tokens := propfmt.Lex("# db\nhost: local\\thost\nport=5432\n")
+
+	var types []propfmt.TokenType
+	var values []string
+	for _, token := range tokens {
+		types = append(types, token.Type)
+		values = append(values, token.Value)
+	}
+	require.Equal(t, []propfmt.TokenType{propfmt.TokenComment, propfmt.TokenKey, propfmt.TokenValue, propfmt.TokenKey, propfmt.TokenValue, propfmt.TokenEOF}, types)
+	require.Equal(t, []string{"db", "host", "local\thost", "port", "5432", ""}, values)
+	require.Equal(t, 5, tokens[1].Pos)
+
+	tokens = propfmt.Lex("key = \\u12")
+	require.Equal(t, propfmt.TokenError, tokens[len(tokens)-1].Type)
+	require.Equal(t, "invalid unicode literal", tokens[len(tokens)-1].Value)
+}
+
+func TestParseAndFormat(t *testing.T) {
+
+	content := "a.b = multi\\\n    line\nempty\nkey\\ with\\:colon = back\\\\slash\\n\n"
+	entries, err := propfmt.Parse(content)
+	require.NoError(t, err)
+	require.Equal(t, []propfmt.Entry{
+		{Key: "a.b", Value: "multiline"},
+		{Key: "empty", Value: ""},
+		{Key: "key with:colon", Value: "back\\slash\n"},
+	}, entries)
+
+	var out strings.Builder
+	require.NoError(t, propfmt.Format(&out, entries))
+	require.Equal(t, "a.b = multiline\nempty = \nkey\\ with\\:colon = back\\\\slash\\n\n", out.String())
+
+	again, err := propfmt.Parse(out.String())
+	require.NoError(t, err)
+	require.Equal(t, entries, again)
+
+	entries, err = propfmt.Parse("# first\n#second\na = 1\nb = 2\n")
+	require.NoError(t, err)
+	require.Equal(t, []propfmt.Entry{
+		{Key: "a", Value: "1", Comments: []string{"first", "second"}},
+		{Key: "b", Value: "2"},
+	}, entries)
+	out.Reset()
+	require.NoError(t, propfmt.Format(&out, entries))
+	require.Equal(t, "# first\n# second\na = 1\nb = 2\n", out.String())
+
+	_, err = propfmt.Parse("key = \\u00zz")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "property parsing error on key 'key'")
+}
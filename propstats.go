@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"io"
+	"time"
+)
+
+/**
+Timing and size of one property file load reported to the container logger
+*/
+
+type propertyLoadStats struct {
+	format      string
+	bytes       int64
+	decode      time.Duration
+	flattenTime time.Duration
+	values      int
+	depth       int
+}
+
+func (t *propertyLoadStats) flatten(props Properties, holder map[string]any, origin string) {
+	t.values, t.depth = propertyTreeSize(holder, 1)
+	start := time.Now()
+	if loader, ok := props.(originLoader); ok {
+		loader.loadMapFrom(holder, origin)
+	} else {
+		props.LoadMap(holder)
+	}
+	t.flattenTime = time.Since(start)
+}
+
+func (t *container) loadPropertiesFromFile(filePath string, file io.Reader) error {
+	if !t.logEnabled(LogInfo) {
+		return t.decodePropertiesFile(filePath, file, &propertyLoadStats{})
+	}
+
+	stats := &propertyLoadStats{}
+	counter := &countingReader{r: file}
+	before := t.properties.Len()
+	start := time.Now()
+	err := t.decodePropertiesFile(filePath, counter, stats)
+	stats.decode = time.Since(start) - stats.flattenTime
+	stats.bytes = counter.n
+	if stats.values == 0 {
+		stats.values = t.properties.Len() - before
+	}
+	if err != nil {
+		t.logger.Printf("Properties '%s' format=%s size=%d decode=%v failed: %v\n", filePath, stats.format, stats.bytes, stats.decode, err)
+		return err
+	}
+	t.logger.Printf("Properties '%s' format=%s size=%d decode=%v flatten=%v values=%d depth=%d\n", filePath, stats.format, stats.bytes, stats.decode, stats.flattenTime, stats.values, stats.depth)
+	return nil
+}
+
+/**
+Returns number of leaf values and maximum nesting depth of the decoded tree
+*/
+
+func propertyTreeSize(v any, depth int) (keys, maxDepth int) {
+	maxDepth = depth
+	visit := func(e any) {
+		k, d := propertyTreeSize(e, depth+1)
+		keys += k
+		if d > maxDepth {
+			maxDepth = d
+		}
+	}
+	switch x := v.(type) {
+	case map[string]any:
+		for _, e := range x {
+			visit(e)
+		}
+	case []map[string]any:
+		for _, e := range x {
+			visit(e)
+		}
+	case []any:
+		for _, e := range x {
+			visit(e)
+		}
+	default:
+		return 1, depth - 1
+	}
+	return keys, maxDepth
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (t *countingReader) Read(p []byte) (int, error) {
+	n, err := t.r.Read(p)
+	t.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+/**
+Prototype registers the struct pointer as the template of the prototype scoped bean.
+Every injection point and every Container.Bean or Container.Lookup call receives the new copy of the template
+with injected fields and properties, PostConstruct is called on each copy.
+Instances are not tracked by the container, so Destroy is never called for them.
+*/
+
+func Prototype(obj any) any {
+	return &prototypeFactoryBean{template: obj}
+}
+
+type prototypeFactoryBean struct {
+	template any
+}
+
+func (t *prototypeFactoryBean) Object(ctx context.Context) (any, error) {
+	templatePtr := reflect.ValueOf(t.template)
+	newPtr := reflect.New(templatePtr.Type().Elem())
+	newPtr.Elem().Set(templatePtr.Elem())
+	obj := newPtr.Interface()
+
+	if init, ok := obj.(ContextInitializingBean); ok {
+		if err := init.PostConstruct(ctx); err != nil {
+			return nil, fmt.Errorf("prototype bean '%v' PostConstruct(ctx) failed: %w", templatePtr.Type(), err)
+		}
+	} else if init, ok := obj.(InitializingBean); ok {
+		if err := init.PostConstruct(); err != nil {
+			return nil, fmt.Errorf("prototype bean '%v' PostConstruct failed: %w", templatePtr.Type(), err)
+		}
+	}
+	return obj, nil
+}
+
+func (t *prototypeFactoryBean) ObjectType() reflect.Type {
+	return reflect.TypeOf(t.template)
+}
+
+func (t *prototypeFactoryBean) ObjectName() string {
+	if named, ok := t.template.(NamedBean); ok {
+		return named.BeanName()
+	}
+	return ""
+}
+
+func (t *prototypeFactoryBean) Singleton() bool {
+	return false
+}
+
+func (t *prototypeFactoryBean) String() string {
+	return fmt.Sprintf("prototype %v", reflect.TypeOf(t.template))
+}
+
+/**
+Injections and properties go to the template, so every copy shares injected singletons
+*/
+
+func (t *prototypeFactoryBean) bindParameters(b *bean) error {
+	templatePtr := reflect.ValueOf(t.template)
+	if templatePtr.Kind() != reflect.Ptr || templatePtr.IsNil() || templatePtr.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("prototype bean '%T' must be non-nil pointer to struct", t.template)
+	}
+	bd, err := cachedBeanDef(templatePtr.Type())
+	if err != nil {
+		return err
+	}
+	bd.applyStubs(templatePtr.Elem())
+	b.name = t.String()
+	b.beanDef = bd
+	b.valuePtr = templatePtr
+	return nil
+}
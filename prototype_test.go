@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type prototypeClock struct {
+	ticks int
+}
+
+type prototypeWorker struct {
+	Clock *prototypeClock `inject:""`
+	Name  string          `value:"worker.name,default=worker"`
+
+	constructed int
+}
+
+func (t *prototypeWorker) PostConstruct() error {
+	t.constructed++
+	return nil
+}
+
+type prototypeConsumer struct {
+	First  *prototypeWorker `inject:""`
+	Second *prototypeWorker `inject:""`
+}
+
+func TestPrototypeBean(t *testing.T) {
+
+	clock := &prototypeClock{}
+	consumer := &prototypeConsumer{}
+
+	ctn, err := glue.New(
+		clock,
+		glue.Prototype(&prototypeWorker{}),
+		consumer,
+		glue.MapPropertySource{"worker.name": "alpha"},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.NotNil(t, consumer.First)
+	require.NotNil(t, consumer.Second)
+	require.NotSame(t, consumer.First, consumer.Second)
+
+	for _, w := range []*prototypeWorker{consumer.First, consumer.Second} {
+		require.Same(t, clock, w.Clock)
+		require.Equal(t, "alpha", w.Name)
+		require.Equal(t, 1, w.constructed)
+	}
+
+	a, err := glue.GetBean[*prototypeWorker](ctn)
+	require.NoError(t, err)
+	b, err := glue.GetBean[*prototypeWorker](ctn)
+	require.NoError(t, err)
+	require.NotSame(t, a, b)
+	require.Same(t, clock, a.Clock)
+	require.Equal(t, 1, a.constructed)
+
+	list := ctn.Bean(reflect.TypeOf((*prototypeWorker)(nil)), glue.DefaultSearchLevel)
+	require.Equal(t, 1, len(list))
+	require.NotSame(t, a, list[0].Object())
+}
+
+func TestPrototypeBeanErrors(t *testing.T) {
+
+	_, err := glue.New(glue.Prototype(&prototypeClock{}), glue.Prototype(prototypeClock{}))
+	require.Error(t, err)
+
+	_, err = glue.New(glue.Prototype(nil))
+	require.Error(t, err)
+}
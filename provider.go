@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ErrProviderNotInjected is returned by Provider.Get of the field not injected by the container.
+var ErrProviderNotInjected = errors.New("provider is not injected")
+
+/**
+Provider is injected instead of T and resolves the bean on the first Get call. It is not a dependency,
+so it breaks cycles without the 'lazy' tag and never holds nil:
+
+	type service struct {
+		Reports glue.Provider[*reportBuilder] `inject:""`
+	}
+
+	builder, err := t.Reports.Get()
+
+The bean not constructed yet, e.g. when Get is called in PostConstruct, is constructed on demand.
+Also known as lazy injection, the resolved bean is cached, prototype beans produce a new instance on every call.
+*/
+
+type Provider[T any] struct {
+	resolve func() (any, error)
+}
+
+func (t Provider[T]) Get() (T, error) {
+	var zero T
+	if t.resolve == nil {
+		return zero, ErrProviderNotInjected
+	}
+	obj, err := t.resolve()
+	if err != nil {
+		return zero, err
+	}
+	v, ok := obj.(T)
+	if !ok {
+		return zero, fmt.Errorf("provider of '%v' resolved the bean of type '%T'", reflect.TypeOf((*T)(nil)).Elem(), obj)
+	}
+	return v, nil
+}
+
+/**
+MustGet returns the bean or panics, for beans known to exist.
+*/
+
+func (t Provider[T]) MustGet() T {
+	v, err := t.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (t *Provider[T]) providerType() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func (t *Provider[T]) bind(resolve func() (any, error)) {
+	t.resolve = resolve
+}
+
+type providerBinder interface {
+	providerType() reflect.Type
+	bind(resolve func() (any, error))
+}
+
+var providerBinderClass = reflect.TypeOf((*providerBinder)(nil)).Elem()
+
+/**
+Returns the resolver of the provider field caching the selected bean
+*/
+
+func (t *container) providerResolver(def *injectionDef) func() (any, error) {
+	var (
+		mu   sync.Mutex
+		impl *bean
+	)
+	return func() (any, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		// the destroyed bean is resolved again, e.g. after Unregister
+		if impl == nil || impl.lifecycle == BeanDestroying || impl.lifecycle == BeanDestroyed {
+			b, err := t.resolveProvider(def)
+			if err != nil {
+				return nil, err
+			}
+			impl = b
+			t.recordLookup(def.fieldType, []*bean{impl})
+		}
+		return t.prototypeInstance(impl).obj, nil
+	}
+}
+
+func (t *container) resolveProvider(def *injectionDef) (*bean, error) {
+	var candidates, list []*bean
+	if deep := t.getBean(def.fieldType); len(deep) > 0 {
+		candidates = orderBeans(levelBeans(deep, def.level))
+		list = def.filterBeans(candidates)
+	}
+	if len(list) == 0 {
+		return nil, def.missing(candidates)
+	}
+	impl, err := selectSingleCandidate(def.fieldName, def.class, list)
+	if err != nil {
+		return nil, err
+	}
+	switch impl.lifecycle {
+	case BeanInitialized:
+	case BeanConstructing:
+		return nil, fmt.Errorf("provider field '%s' in '%v': bean '%s' is being constructed, Get is called in the dependency cycle", def.fieldName, def.class, impl.name)
+	case BeanDestroying, BeanDestroyed:
+		return nil, fmt.Errorf("provider field '%s' in '%v': bean '%s' is destroyed", def.fieldName, def.class, impl.name)
+	default:
+		// beans of the current container are constructed sequentially by the goroutine creating it
+		if t.parallelInit != nil {
+			return nil, fmt.Errorf("provider field '%s' in '%v': bean '%s' is not constructed yet", def.fieldName, def.class, impl.name)
+		}
+		if err := t.constructBean(context.Background(), impl, nil); err != nil {
+			return nil, err
+		}
+	}
+	if impl.obj == nil {
+		return nil, fmt.Errorf("provider field '%s' in '%v': bean '%s' has no object", def.fieldName, def.class, impl.name)
+	}
+	return impl, nil
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type providerOrders struct {
+	Billing *providerBilling `inject:""`
+}
+
+type providerBilling struct {
+	Orders glue.Provider[*providerOrders] `inject:""`
+}
+
+type providerReport struct {
+	constructed bool
+}
+
+func (t *providerReport) PostConstruct() error {
+	t.constructed = true
+	return nil
+}
+
+type providerStartup struct {
+	Report glue.Provider[*providerReport] `inject:""`
+	Clock  glue.Provider[*prototypeClock] `inject:""`
+
+	report *providerReport
+}
+
+func (t *providerStartup) PostConstruct() (err error) {
+	// the report is not a dependency, it is constructed on demand
+	t.report, err = t.Report.Get()
+	return err
+}
+
+func TestProvider(t *testing.T) {
+
+	orders, billing := &providerOrders{}, &providerBilling{}
+	startup := &providerStartup{}
+
+	ctn, err := glue.New(startup, orders, billing, &providerReport{}, glue.Prototype(&prototypeWorker{}), &prototypeClock{})
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	// the cycle is broken by the provider
+	require.Same(t, billing, orders.Billing)
+	o, err := billing.Orders.Get()
+	require.NoError(t, err)
+	require.Same(t, orders, o)
+	require.Same(t, orders, billing.Orders.MustGet())
+
+	require.NotNil(t, startup.report)
+	require.True(t, startup.report.constructed)
+
+	_, err = startup.Clock.Get()
+	require.NoError(t, err)
+
+	var empty glue.Provider[*providerOrders]
+	_, err = empty.Get()
+	require.ErrorIs(t, err, glue.ErrProviderNotInjected)
+}
+
+type providerPrototypes struct {
+	Worker glue.Provider[*prototypeWorker] `inject:""`
+	Absent glue.Provider[*providerOrders]  `inject:""`
+}
+
+func TestProviderPrototypeAndMissing(t *testing.T) {
+
+	p := &providerPrototypes{}
+	ctn, err := glue.New(p, glue.Prototype(&prototypeWorker{}), &prototypeClock{})
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	a, err := p.Worker.Get()
+	require.NoError(t, err)
+	b, err := p.Worker.Get()
+	require.NoError(t, err)
+	require.NotSame(t, a, b)
+
+	_, err = p.Absent.Get()
+	require.Error(t, err)
+	require.Panics(t, func() { p.Absent.MustGet() })
+}
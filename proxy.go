@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+)
+
+/**
+MethodInterceptor receives the method name, arguments and the next function calling the original method.
+It returns results of the method.
+*/
+
+type MethodInterceptor func(method string, args []reflect.Value, next func([]reflect.Value) []reflect.Value) []reflect.Value
+
+/**
+ProxyFactory creates the proxy of the interface for the target with all methods wrapped by the interceptor.
+*/
+
+type ProxyFactory func(target any, interceptor MethodInterceptor) any
+
+var proxyRegistry sync.Map // key is reflect.Type of interface, value is ProxyFactory
+
+/**
+RegisterProxy registers the proxy factory for the interface type.
+Code generated by gluegen for '//glue:decorator' interfaces registers proxies in init().
+*/
+
+func RegisterProxy(ifaceType reflect.Type, factory ProxyFactory) {
+	proxyRegistry.Store(ifaceType, factory)
+}
+
+func lookupProxy(ifaceType reflect.Type) (ProxyFactory, bool) {
+	if v, ok := proxyRegistry.Load(ifaceType); ok {
+		return v.(ProxyFactory), true
+	}
+	return nil, false
+}
+
+/**
+Returns registered interfaces implemented by the type sorted by name
+*/
+
+func proxyInterfaces(typ reflect.Type) []reflect.Type {
+	var list []reflect.Type
+	proxyRegistry.Range(func(key, value any) bool {
+		if ifaceType := key.(reflect.Type); typ.Implements(ifaceType) {
+			list = append(list, ifaceType)
+		}
+		return true
+	})
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].String() < list[j].String()
+	})
+	return list
+}
+
+/**
+Returns the object injected instead of the bean for the interface, the bean itself if it has no proxy
+*/
+
+func (t *bean) proxied(ifaceType reflect.Type) any {
+	if proxy, ok := t.proxies[ifaceType]; ok {
+		return proxy
+	}
+	return t.obj
+}
+
+func (t *bean) setProxy(ifaceType reflect.Type, proxy any) {
+	if t.proxies == nil {
+		t.proxies = make(map[reflect.Type]any)
+	}
+	t.proxies[ifaceType] = proxy
+}
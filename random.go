@@ -0,0 +1,191 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RandSeedProperty is the seed of the Rand bean, set it in tests to make random behavior reproducible.
+var RandSeedProperty = "rand.seed"
+
+/**
+Rand is the random source shared by beans and glue features, e.g. jitter of PropertyRefresher.
+It is seeded by property 'rand.seed' if present, otherwise by the random seed, so tests set the property
+to get the same sequence on every run. Rand is safe for concurrent use.
+
+Register it by glue.New(glue.NewRand(), ...) and inject as *glue.Rand.
+*/
+
+type Rand struct {
+	Properties Properties `inject:"optional"`
+
+	mu   sync.Mutex
+	src  *rand.Rand
+	seed int64
+}
+
+func NewRand() *Rand {
+	return &Rand{}
+}
+
+/**
+NewSeededRand returns Rand with the fixed seed, property 'rand.seed' is ignored.
+*/
+
+func NewSeededRand(seed int64) *Rand {
+	t := &Rand{}
+	t.reset(seed)
+	return t
+}
+
+func (t *Rand) PostConstruct() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.src != nil {
+		return nil
+	}
+	if t.Properties != nil {
+		if s, ok := t.Properties.Get(RandSeedProperty); ok {
+			seed, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+			if err != nil {
+				return fmt.Errorf("property '%s' must be integer: %w", RandSeedProperty, err)
+			}
+			t.reset(seed)
+			return nil
+		}
+	}
+	t.reset(randomSeed())
+	return nil
+}
+
+func (t *Rand) reset(seed int64) {
+	t.seed = seed
+	t.src = rand.New(rand.NewSource(seed))
+}
+
+/**
+Returns the source, seeds it randomly if Rand is used without the container, must be called under the lock
+*/
+
+func (t *Rand) source() *rand.Rand {
+	if t.src == nil {
+		t.reset(randomSeed())
+	}
+	return t.src
+}
+
+/**
+Seed returns the seed of the sequence, log it to reproduce the failed run with property 'rand.seed'.
+*/
+
+func (t *Rand) Seed() int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.source()
+	return t.seed
+}
+
+func (t *Rand) Int63() int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.source().Int63()
+}
+
+func (t *Rand) Intn(n int) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.source().Intn(n)
+}
+
+func (t *Rand) Float64() float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.source().Float64()
+}
+
+func (t *Rand) Read(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.source().Read(p)
+}
+
+func (t *Rand) Shuffle(n int, swap func(i, j int)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.source().Shuffle(n, swap)
+}
+
+/**
+Jitter returns the duration randomly increased or decreased by up to the fraction of it, fraction is limited by 1.
+*/
+
+func (t *Rand) Jitter(d time.Duration, fraction float64) time.Duration {
+	if fraction <= 0 {
+		return d
+	}
+	if fraction > 1 {
+		fraction = 1
+	}
+	delta := time.Duration(float64(d) * fraction * (2*t.Float64() - 1))
+	if j := d + delta; j > 0 {
+		return j
+	}
+	return d
+}
+
+func randomSeed() int64 {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		return time.Now().UnixNano()
+	}
+	return int64(binary.LittleEndian.Uint64(b[:]))
+}
+
+/**
+IDGenerator is the bean generating random UUID version 4 identifiers, e.g. correlation IDs.
+It uses the Rand bean if registered, so identifiers are reproducible with property 'rand.seed'.
+
+Register it by glue.New(glue.NewRand(), glue.NewIDGenerator(), ...) and inject as *glue.IDGenerator.
+*/
+
+type IDGenerator struct {
+	Rand *Rand `inject:"optional"`
+
+	once sync.Once
+}
+
+func NewIDGenerator() *IDGenerator {
+	return &IDGenerator{}
+}
+
+func (t *IDGenerator) rand() *Rand {
+	t.once.Do(func() {
+		if t.Rand == nil {
+			t.Rand = NewRand()
+		}
+	})
+	return t.Rand
+}
+
+/**
+NewID returns the new identifier in the canonical form, e.g. '0b1c2d3e-4f50-4617-8293-a4b5c6d7e8f9'.
+*/
+
+func (t *IDGenerator) NewID() string {
+	var b [16]byte
+	t.rand().Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
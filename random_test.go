@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type randUser struct {
+	Rand *glue.Rand        `inject:""`
+	IDs  *glue.IDGenerator `inject:""`
+}
+
+func newRandUser(t *testing.T, props glue.MapPropertySource) *randUser {
+	user := &randUser{}
+	ctn, err := glue.New(glue.NewRand(), glue.NewIDGenerator(), user, props)
+	require.NoError(t, err)
+	t.Cleanup(func() { ctn.Close() })
+	return user
+}
+
+func TestRandSeedProperty(t *testing.T) {
+
+	first := newRandUser(t, glue.MapPropertySource{"rand.seed": "42"})
+	second := newRandUser(t, glue.MapPropertySource{"rand.seed": "42"})
+
+	require.Equal(t, int64(42), first.Rand.Seed())
+	require.Equal(t, first.Rand.Int63(), second.Rand.Int63())
+	require.Equal(t, first.IDs.NewID(), second.IDs.NewID())
+
+	id := first.IDs.NewID()
+	require.Regexp(t, "^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$", id)
+	require.NotEqual(t, id, first.IDs.NewID())
+
+	_, err := glue.New(glue.NewRand(), glue.MapPropertySource{"rand.seed": "abc"})
+	require.Error(t, err)
+}
+
+func TestRandJitter(t *testing.T) {
+
+	r := glue.NewSeededRand(7)
+	require.Equal(t, int64(7), r.Seed())
+	for i := 0; i < 100; i++ {
+		d := r.Jitter(time.Second, 0.5)
+		require.True(t, d >= 500*time.Millisecond && d <= 1500*time.Millisecond, d)
+	}
+	require.Equal(t, time.Second, r.Jitter(time.Second, 0))
+
+	require.NotEmpty(t, glue.NewIDGenerator().NewID())
+}
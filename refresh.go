@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RefreshPropertyPrefix is the prefix of per source refresh properties, e.g. 'config.refresh.consul.interval'.
+var RefreshPropertyPrefix = "config.refresh."
+
+// RefreshIntervalProperty is the refresh interval of sources without their own interval, zero disables refresh.
+var RefreshIntervalProperty = "config.refresh.interval"
+
+// RefreshJitterProperty is the fraction of the interval randomly added or subtracted from every delay.
+var RefreshJitterProperty = "config.refresh.jitter"
+
+// DefaultRefreshJitter is the jitter used when RefreshJitterProperty is not set.
+var DefaultRefreshJitter = 0.1
+
+/**
+PropertyRefresher is the central bean that loads all RefreshableSource beans on PostConstruct
+and reloads each of them in the own loop with the interval and jitter from properties.
+The jitter uses the Rand bean if registered.
+Failed refresh keeps previous values and is reported to OnError.
+
+Register it by glue.New(glue.NewPropertyRefresher(), ...).
+*/
+
+type PropertyRefresher struct {
+	Properties Properties          `inject:""`
+	Sources    []RefreshableSource `inject:"optional"`
+	Rand       *Rand               `inject:"optional"`
+
+	/**
+	Called when the source failed to refresh, by default the error is ignored
+	*/
+	OnError func(source string, err error)
+
+	recorder MetricsRecorder
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+func NewPropertyRefresher() *PropertyRefresher {
+	return &PropertyRefresher{recorder: noopRecorder{}}
+}
+
+func (t *PropertyRefresher) SetMetricsRecorder(recorder MetricsRecorder) {
+	t.recorder = recorder
+}
+
+func (t *PropertyRefresher) PostConstruct(ctx context.Context) error {
+	if t.Rand == nil {
+		t.Rand = NewRand()
+	}
+	for _, source := range t.Sources {
+		if err := t.Refresh(ctx, source); err != nil {
+			return err
+		}
+	}
+	loopCtx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+	for _, source := range t.Sources {
+		if interval := t.Interval(source.SourceName()); interval > 0 {
+			if !hasPropertyRefresh {
+				return requireFeature(FeaturePropertyRefresh)
+			}
+			t.wg.Add(1)
+			go t.loop(loopCtx, source, interval)
+		}
+	}
+	return nil
+}
+
+func (t *PropertyRefresher) Destroy() error {
+	if t.cancel != nil {
+		t.cancel()
+	}
+	t.wg.Wait()
+	return nil
+}
+
+/**
+Interval returns the refresh interval of the source name, falling back to RefreshIntervalProperty.
+*/
+
+func (t *PropertyRefresher) Interval(sourceName string) time.Duration {
+	if t.Properties == nil {
+		return 0
+	}
+	return t.Properties.GetDuration(RefreshPropertyPrefix+sourceName+".interval", t.Properties.GetDuration(RefreshIntervalProperty, 0))
+}
+
+/**
+Refresh fetches the source and loads its properties.
+*/
+
+func (t *PropertyRefresher) Refresh(ctx context.Context, source RefreshableSource) error {
+	name := source.SourceName()
+	recorder := t.recorder
+	if recorder == nil {
+		// the literal outside of the container
+		recorder = noopRecorder{}
+	}
+	start := time.Now()
+	m, err := source.FetchProperties(ctx)
+	recorder.Observe("config_refresh_seconds", time.Since(start).Seconds(), "source", name)
+	if err != nil {
+		recorder.Add("config_refresh_errors_total", 1, "source", name)
+		return fmt.Errorf("refresh property source '%s' failed: %w", name, err)
+	}
+	recorder.Add("config_refresh_total", 1, "source", name)
+	t.Properties.LoadMap(m)
+	return nil
+}
+
+func (t *PropertyRefresher) loop(ctx context.Context, source RefreshableSource, interval time.Duration) {
+	defer t.wg.Done()
+	timer := time.NewTimer(t.jitter(interval))
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			if err := t.Refresh(ctx, source); err != nil && t.OnError != nil {
+				t.OnError(source.SourceName(), err)
+			}
+			timer.Reset(t.jitter(interval))
+		}
+	}
+}
+
+func (t *PropertyRefresher) jitter(interval time.Duration) time.Duration {
+	jitter := DefaultRefreshJitter
+	if t.Properties != nil {
+		jitter = t.Properties.GetDouble(RefreshJitterProperty, DefaultRefreshJitter)
+	}
+	return t.Rand.Jitter(interval, jitter)
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type countingSource struct {
+	name  string
+	calls int32
+	fail  int32
+}
+
+func (t *countingSource) SourceName() string {
+	return t.name
+}
+
+func (t *countingSource) FetchProperties(ctx context.Context) (map[string]any, error) {
+	n := atomic.AddInt32(&t.calls, 1)
+	if atomic.LoadInt32(&t.fail) == 1 {
+		return nil, errors.New("unavailable")
+	}
+	return map[string]any{t.name + ".version": n}, nil
+}
+
+func TestPropertyRefresherPerSourceInterval(t *testing.T) {
+
+	skipUnsupported(t, glue.FeaturePropertyRefresh)
+
+	fast := &countingSource{name: "fast"}
+	static := &countingSource{name: "static"}
+	failures := make(chan string, 16)
+
+	refresher := glue.NewPropertyRefresher()
+	refresher.OnError = func(source string, err error) {
+		failures <- source
+	}
+
+	ctn, err := glue.New(
+		refresher,
+		fast,
+		static,
+		glue.MapPropertySource{
+			"config.refresh.fast.interval": "5ms",
+			"config.refresh.jitter":        "0.5",
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, 5*time.Millisecond, refresher.Interval("fast"))
+	require.Equal(t, time.Duration(0), refresher.Interval("static"))
+	require.Equal(t, "1", ctn.Properties().GetString("static.version", ""))
+
+	require.Eventually(t, func() bool {
+		return ctn.Properties().GetInt("fast.version", 0) >= 3
+	}, time.Second, time.Millisecond)
+
+	atomic.StoreInt32(&fast.fail, 1)
+	select {
+	case source := <-failures:
+		require.Equal(t, "fast", source)
+	case <-time.After(time.Second):
+		t.Fatal("refresh failure was not reported")
+	}
+
+	require.NoError(t, ctn.Close())
+	calls := atomic.LoadInt32(&fast.calls)
+	time.Sleep(20 * time.Millisecond)
+	require.Equal(t, calls, atomic.LoadInt32(&fast.calls))
+	require.Equal(t, int32(1), atomic.LoadInt32(&static.calls))
+}
+
+func TestPropertyRefresherInitialFailure(t *testing.T) {
+
+	source := &countingSource{name: "remote"}
+	source.fail = 1
+
+	_, err := glue.New(glue.NewPropertyRefresher(), source)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "refresh property source 'remote' failed")
+}
+
+func TestPropertyRefresherWithoutSources(t *testing.T) {
+
+	ctn, err := glue.New(glue.NewPropertyRefresher())
+	require.NoError(t, err)
+	require.NoError(t, ctn.Close())
+}
+
+func TestPropertyRefresherLiteral(t *testing.T) {
+
+	source := &countingSource{name: "remote"}
+	refresher := &glue.PropertyRefresher{Properties: glue.NewProperties()}
+	require.NoError(t, refresher.Refresh(context.Background(), source))
+	require.Equal(t, "1", refresher.Properties.GetString("remote.version", ""))
+
+	ctn, err := glue.New(&glue.PropertyRefresher{}, &countingSource{name: "local"})
+	require.NoError(t, err)
+	require.Equal(t, "1", ctn.Properties().GetString("local.version", ""))
+	require.NoError(t, ctn.Close())
+}
@@ -0,0 +1,462 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync/atomic"
+)
+
+// ErrContainerClosed is returned by Register on the closing or closed container.
+var ErrContainerClosed = errors.New("container is closed")
+
+// ErrContainerSealed is returned by Register, Unregister and Extend on the sealed container.
+var ErrContainerSealed = errors.New("container is sealed")
+
+/**
+Beans registered by Register after the container creation, the core stays immutable
+*/
+
+type runtimeRegistry struct {
+	core   map[reflect.Type][]*bean
+	names  map[string][]*bean
+	closed bool
+	sealed bool
+}
+
+func (t *container) Register(obj any) (Bean, error) {
+	return t.RegisterWithContext(context.Background(), obj)
+}
+
+func (t *container) RegisterWithContext(ctx context.Context, obj any) (Bean, error) {
+	obj, options := unwrapBean(obj)
+	if obj == nil {
+		return nil, errors.New("null beans are not allowed")
+	}
+	classPtr := reflect.TypeOf(obj)
+	if classPtr.Kind() != reflect.Ptr || classPtr.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("registered bean '%v' must be pointer to struct", classPtr)
+	}
+	switch obj.(type) {
+	case FactoryBean, ContextFactoryBean:
+		return nil, fmt.Errorf("factory bean '%v' can not be registered after the container creation", classPtr)
+	}
+
+	t.registryMu.RLock()
+	closed, sealed := t.registry.closed, t.registry.sealed
+	t.registryMu.RUnlock()
+	if sealed {
+		return nil, ErrContainerSealed
+	}
+	if closed {
+		return nil, ErrContainerClosed
+	}
+
+	b, err := investigate(obj, classPtr)
+	if err != nil {
+		return nil, err
+	}
+	for _, option := range options {
+		option(b)
+	}
+	t.recordTransition(b, BeanAllocated, b.lifecycle)
+
+	if err := t.Inject(obj); err != nil {
+		return nil, fmt.Errorf("register bean '%s' with type '%v': %w", b.name, classPtr, err)
+	}
+	t.recordDependencies(b)
+	if _, ok := obj.(MeteredBean); ok {
+		t.setRecorder(b, t.metricsBean())
+	}
+	if err := t.constructBean(ctx, b, nil); err != nil {
+		return nil, fmt.Errorf("register bean '%s' with type '%v': %w", b.name, classPtr, err)
+	}
+
+	t.registryMu.Lock()
+	if t.registry.closed || t.registry.sealed {
+		err := ErrContainerClosed
+		if !t.registry.closed {
+			err = ErrContainerSealed
+		}
+		t.registryMu.Unlock()
+		t.destroyBean(ctx, b)
+		return nil, err
+	}
+	for _, dep := range b.dependencies {
+		if atomic.LoadInt32(&dep.unregistered) == 1 {
+			t.registryMu.Unlock()
+			t.destroyBean(ctx, b)
+			return nil, fmt.Errorf("register bean '%s' with type '%v': injected bean '%s' is unregistered", b.name, classPtr, dep.name)
+		}
+	}
+	if t.registry.core == nil {
+		t.registry.core = make(map[reflect.Type][]*bean)
+		t.registry.names = make(map[string][]*bean)
+	}
+	registerBean(t.registry.core, t.registry.names, classPtr, b)
+	if listener, ok := obj.(PropertyChangeListener); ok {
+		t.propertyListeners = append(t.propertyListeners, listener)
+		t.properties.AddChangeListener(listener)
+	}
+	t.registryMu.Unlock()
+
+	// interfaces of the new bean are searched again
+	t.clearSearchCaches()
+	t.subscribeRegistered(b)
+	t.logf(LogInfo, "Registered bean '%s' with type '%v'\n", b.name, classPtr)
+	return b, nil
+}
+
+/**
+Drops cached interface lookups and scope templates, they hold candidates of the registry
+*/
+
+func (t *container) clearSearchCaches() {
+	t.ifaceCache.clear()
+	t.scopeTemplates.Range(func(key, _ any) bool {
+		t.scopeTemplates.Delete(key)
+		return true
+	})
+}
+
+func (t *container) subscribeRegistered(b *bean) {
+	_, isEvents := b.obj.(EventListener)
+	_, isLifecycle := b.obj.(LifecycleListener)
+	if !isEvents && !isLifecycle {
+		return
+	}
+	t.eventsMu.Lock()
+	defer t.eventsMu.Unlock()
+	if isEvents {
+		t.eventListeners = orderBeans(append(append([]*bean(nil), t.eventListeners...), b))
+	}
+	if isLifecycle {
+		t.lifecycleListeners = orderBeans(append(append([]*bean(nil), t.lifecycleListeners...), b))
+	}
+}
+
+func (t *container) Seal() {
+	t.registryMu.Lock()
+	sealed := t.registry.sealed
+	t.registry.sealed = true
+	t.registryMu.Unlock()
+	if !sealed {
+		t.logf(LogInfo, "Container sealed\n")
+	}
+}
+
+func (t *container) Sealed() bool {
+	t.registryMu.RLock()
+	defer t.registryMu.RUnlock()
+	return t.registry.sealed
+}
+
+func (t *container) Unregister(name string) error {
+	return t.UnregisterWithContext(context.Background(), name)
+}
+
+func (t *container) UnregisterWithContext(ctx context.Context, name string) error {
+	t.registryMu.Lock()
+	b, err := t.removeRegistered(name)
+	if err != nil {
+		t.registryMu.Unlock()
+		return err
+	}
+	if listener, ok := b.obj.(PropertyChangeListener); ok {
+		for i, l := range t.propertyListeners {
+			if l == listener {
+				t.propertyListeners = append(t.propertyListeners[:i:i], t.propertyListeners[i+1:]...)
+				t.properties.RemoveChangeListener(listener)
+				break
+			}
+		}
+	}
+	t.registryMu.Unlock()
+
+	t.clearSearchCaches()
+	t.eventsMu.Lock()
+	t.eventListeners = withoutBean(t.eventListeners, b)
+	t.lifecycleListeners = withoutBean(t.lifecycleListeners, b)
+	t.eventsMu.Unlock()
+	t.disposablesMu.Lock()
+	t.disposables = withoutBean(t.disposables, b)
+	t.disposablesMu.Unlock()
+
+	t.forEachLiveBean(func(other *bean) {
+		releaseLazy(other, b)
+	})
+
+	t.logf(LogInfo, "Unregistered bean '%s' with type '%v'\n", b.name, b.beanDef.classPtr)
+	return t.destroyBean(ctx, b)
+}
+
+/**
+Finds the registered bean by name and removes it from the registry if no live bean depends on it,
+must be called under the registry lock, so the check and the removal are one step for Register
+*/
+
+func (t *container) removeRegistered(name string) (*bean, error) {
+	list := t.registry.names[name]
+	switch {
+	case t.registry.closed:
+		return nil, ErrContainerClosed
+	case t.registry.sealed:
+		return nil, ErrContainerSealed
+	case len(list) > 1:
+		return nil, fmt.Errorf("bean name '%s' is ambiguous, %d beans are registered with it", name, len(list))
+	case len(list) == 0 && len(t.localNames[name]) > 0:
+		return nil, fmt.Errorf("bean '%s' was not registered by Register and can not be unregistered", name)
+	case len(list) == 0:
+		return nil, fmt.Errorf("bean '%s' is not registered", name)
+	}
+	b := list[0]
+
+	// registrations of extended containers see the flag before they add the dependent, or the check sees the dependent
+	atomic.StoreInt32(&b.unregistered, 1)
+	var dependents []string
+	isDependent := func(other *bean) {
+		if other != b && other.lifecycle == BeanInitialized {
+			for _, dep := range other.dependencies {
+				if dep == b {
+					dependents = append(dependents, other.name)
+					break
+				}
+			}
+		}
+	}
+	for _, list := range t.core {
+		for _, other := range list {
+			isDependent(other)
+		}
+	}
+	for _, list := range t.registry.core {
+		for _, other := range list {
+			isDependent(other)
+		}
+	}
+	t.extendedMu.Lock()
+	children := append([]*container(nil), t.extended...)
+	t.extendedMu.Unlock()
+	for _, child := range children {
+		child.forEachLiveBean(isDependent)
+	}
+	if len(dependents) > 0 {
+		atomic.StoreInt32(&b.unregistered, 0)
+		return nil, fmt.Errorf("bean '%s' is injected into '%s', unregister dependents first", name, strings.Join(dependents, "', '"))
+	}
+	t.registry.remove(b)
+	return b, nil
+}
+
+func (t *runtimeRegistry) remove(b *bean) {
+	classPtr := b.beanDef.classPtr
+	if list := withoutBean(t.core[classPtr], b); len(list) == 0 {
+		delete(t.core, classPtr)
+	} else {
+		t.core[classPtr] = list
+	}
+	for _, name := range append([]string{b.name}, b.aliases...) {
+		if list := withoutBean(t.names[name], b); len(list) == 0 {
+			delete(t.names, name)
+		} else {
+			t.names[name] = list
+		}
+	}
+}
+
+/**
+Returns the copy of the list without the bean, lists shared with readers are never changed in place
+*/
+
+func withoutBean(list []*bean, b *bean) []*bean {
+	var out []*bean
+	for _, e := range list {
+		if e != b {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+/**
+Visits beans of the container, registered beans and beans of the live extended containers
+*/
+
+func (t *container) forEachLiveBean(cb func(b *bean)) {
+	for _, list := range t.core {
+		for _, b := range list {
+			cb(b)
+		}
+	}
+	t.registryMu.RLock()
+	var registered []*bean
+	for _, list := range t.registry.core {
+		registered = append(registered, list...)
+	}
+	t.registryMu.RUnlock()
+	for _, b := range registered {
+		cb(b)
+	}
+	t.extendedMu.Lock()
+	children := append([]*container(nil), t.extended...)
+	t.extendedMu.Unlock()
+	for _, child := range children {
+		child.forEachLiveBean(cb)
+	}
+}
+
+/**
+Returns the matcher of values referencing the bean object or its proxies
+*/
+
+func beanRefs(b *bean) func(v reflect.Value) bool {
+	refs := map[any]struct{}{b.obj: {}}
+	for _, proxy := range b.proxies {
+		refs[proxy] = struct{}{}
+	}
+	return func(v reflect.Value) bool {
+		if v.Kind() == reflect.Interface {
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Ptr || v.IsNil() || !v.CanInterface() {
+			return false
+		}
+		_, ok := refs[v.Interface()]
+		return ok
+	}
+}
+
+func fieldReferences(field reflect.Value, def *injectionDef, matches func(v reflect.Value) bool) bool {
+	switch {
+	case def.isSlice:
+		for i := 0; i < field.Len(); i++ {
+			if matches(field.Index(i)) {
+				return true
+			}
+		}
+	case def.isMap:
+		iter := field.MapRange()
+		for iter.Next() {
+			if matches(iter.Value()) {
+				return true
+			}
+		}
+	default:
+		return matches(field)
+	}
+	return false
+}
+
+/**
+Records beans injected by Register in to the not lazy fields, Inject does not track dependencies
+*/
+
+func (t *container) recordDependencies(b *bean) {
+	value := b.valuePtr.Elem()
+	for _, def := range b.beanDef.fields {
+		if def.lazy || def.getter {
+			continue
+		}
+		deep := t.getBean(def.fieldType)
+		if len(deep) == 0 {
+			continue
+		}
+		field := def.field(value)
+		for _, impl := range def.filterBeans(levelBeans(deep, def.level)) {
+			if impl != b && impl.obj != nil && fieldReferences(field, def, beanRefs(impl)) {
+				b.dependencies = append(b.dependencies, impl)
+			}
+		}
+	}
+}
+
+/**
+Sets to nil lazy fields of the bean referencing the removed bean, removes it from lazy slices and maps,
+fields are changed under the constructor mutex of the holder like in the construction and Reload
+*/
+
+func releaseLazy(holder, removed *bean) {
+	if holder.beanDef == nil || holder.obj == nil || holder.valuePtr.Kind() != reflect.Ptr {
+		return
+	}
+	holder.ctorMu.Lock()
+	defer holder.ctorMu.Unlock()
+	matches := beanRefs(removed)
+	value := holder.valuePtr.Elem()
+	for _, def := range holder.beanDef.fields {
+		if !def.lazy || def.getter {
+			continue
+		}
+		field := def.field(value)
+		if !field.CanSet() || !fieldReferences(field, def, matches) {
+			continue
+		}
+		switch {
+		case def.isSlice:
+			kept := reflect.MakeSlice(field.Type(), 0, field.Len())
+			for i := 0; i < field.Len(); i++ {
+				if !matches(field.Index(i)) {
+					kept = reflect.Append(kept, field.Index(i))
+				}
+			}
+			field.Set(kept)
+		case def.isMap:
+			for _, key := range field.MapKeys() {
+				if matches(field.MapIndex(key)) {
+					field.SetMapIndex(key, reflect.Value{})
+				}
+			}
+		default:
+			field.Set(reflect.Zero(field.Type()))
+		}
+	}
+}
+
+/**
+Rejects registrations, called at the beginning of Close
+*/
+
+func (t *container) closeRegistry() {
+	t.registryMu.Lock()
+	t.registry.closed = true
+	t.registryMu.Unlock()
+}
+
+/**
+Returns beans of the type registered by Register
+*/
+
+func (t *container) registeredByType(typ reflect.Type) []*bean {
+	t.registryMu.RLock()
+	defer t.registryMu.RUnlock()
+	return t.registry.core[typ]
+}
+
+func (t *container) registeredByName(name string) []*bean {
+	t.registryMu.RLock()
+	defer t.registryMu.RUnlock()
+	return t.registry.names[name]
+}
+
+func (t *container) registeredByInterface(ifaceType reflect.Type) []*bean {
+	t.registryMu.RLock()
+	defer t.registryMu.RUnlock()
+	var candidates []*bean
+	for _, list := range t.registry.core {
+		if len(list) > 0 && list[0].beanDef.implements(ifaceType) {
+			for _, b := range list {
+				if !b.concreteOnly {
+					candidates = append(candidates, b)
+				}
+			}
+		}
+	}
+	return candidates
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type registerPlugin interface {
+	PluginName() string
+}
+
+type registerClock struct {
+	now string
+}
+
+type registerAudit struct {
+	Clock       *registerClock `inject:""`
+	initialized bool
+	destroyed   *bool
+}
+
+func (t *registerAudit) PluginName() string { return "audit" }
+
+func (t *registerAudit) BeanName() string { return "auditPlugin" }
+
+func (t *registerAudit) PostConstruct() error {
+	t.initialized = true
+	return nil
+}
+
+func (t *registerAudit) Destroy() error {
+	*t.destroyed = true
+	return nil
+}
+
+type registerHost struct {
+	Plugins []registerPlugin `inject:""`
+}
+
+func TestRegister(t *testing.T) {
+
+	ctn, err := glue.New(&registerClock{now: "noon"})
+	require.NoError(t, err)
+
+	require.Empty(t, glue.GetBeans[registerPlugin](ctn))
+
+	destroyed := false
+	plugin := &registerAudit{destroyed: &destroyed}
+	b, err := ctn.Register(plugin)
+	require.NoError(t, err)
+	require.Equal(t, "auditPlugin", b.Name())
+	require.True(t, plugin.initialized)
+	require.Equal(t, "noon", plugin.Clock.now)
+
+	found, err := glue.GetBean[registerPlugin](ctn)
+	require.NoError(t, err)
+	require.Same(t, plugin, found)
+
+	list := ctn.Lookup("auditPlugin", 0)
+	require.Len(t, list, 1)
+	require.Same(t, plugin, list[0].Object())
+
+	child, err := ctn.Extend(&registerHost{})
+	require.NoError(t, err)
+	host, err := glue.GetBean[*registerHost](child)
+	require.NoError(t, err)
+	require.Len(t, host.Plugins, 1)
+	require.NoError(t, child.Close())
+
+	_, err = ctn.Register(&registerClockFactory{})
+	require.Error(t, err)
+
+	require.NoError(t, ctn.Close())
+	require.True(t, destroyed)
+
+	_, err = ctn.Register(&registerClock{})
+	require.True(t, errors.Is(err, glue.ErrContainerClosed))
+}
+
+type registerClockFactory struct{}
+
+func (t *registerClockFactory) Object() (any, error) { return &registerClock{}, nil }
+
+func (t *registerClockFactory) ObjectType() reflect.Type {
+	return reflect.TypeOf((*registerClock)(nil))
+}
+
+func (t *registerClockFactory) ObjectName() string { return "" }
+
+func (t *registerClockFactory) Singleton() bool { return true }
+
+type unregisterHost struct {
+	Plugin   registerPlugin                `inject:"lazy"`
+	Plugins  []registerPlugin              `inject:"lazy"`
+	Provider glue.Provider[registerPlugin] `inject:""`
+	Optional glue.Optional[*registerAudit] `inject:""`
+}
+
+type unregisterUser struct {
+	Plugin registerPlugin `inject:""`
+}
+
+func TestUnregister(t *testing.T) {
+
+	ctn, err := glue.New(&registerClock{})
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	destroyed := false
+	plugin := &registerAudit{destroyed: &destroyed}
+	_, err = ctn.Register(plugin)
+	require.NoError(t, err)
+
+	host := &unregisterHost{}
+	child, err := ctn.Extend(host)
+	require.NoError(t, err)
+	defer child.Close()
+	require.Same(t, plugin, host.Plugin)
+	require.Len(t, host.Plugins, 1)
+	p, err := host.Provider.Get()
+	require.NoError(t, err)
+	require.Same(t, plugin, p)
+
+	user, err := ctn.Register(&unregisterUser{})
+	require.NoError(t, err)
+	err = ctn.Unregister("auditPlugin")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unregister dependents first")
+	require.NoError(t, ctn.Unregister(user.Name()))
+
+	require.NoError(t, ctn.Unregister("auditPlugin"))
+	require.True(t, destroyed)
+	require.Nil(t, host.Plugin)
+	require.Empty(t, host.Plugins)
+	_, err = host.Provider.Get()
+	require.Error(t, err)
+	_, ok := host.Optional.Get()
+	require.False(t, ok)
+	require.Empty(t, glue.GetBeans[registerPlugin](ctn))
+	require.Empty(t, ctn.Lookup("auditPlugin", 0))
+
+	require.Error(t, ctn.Unregister("auditPlugin"))
+	clock := ctn.Bean(reflect.TypeOf((*registerClock)(nil)), 0)[0]
+	require.Error(t, ctn.Unregister(clock.Name()))
+}
+
+type unregisterRacingUser struct {
+	Plugin registerPlugin `inject:""`
+	Ctn    glue.Container `inject:""`
+}
+
+func (t *unregisterRacingUser) PostConstruct() error {
+	// the dependency is removed after the injection, before the registration completes
+	return t.Ctn.Unregister("auditPlugin")
+}
+
+func TestUnregisterDuringRegister(t *testing.T) {
+
+	ctn, err := glue.New(&registerClock{})
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	destroyed := false
+	_, err = ctn.Register(&registerAudit{destroyed: &destroyed})
+	require.NoError(t, err)
+
+	_, err = ctn.Register(&unregisterRacingUser{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "injected bean 'auditPlugin' is unregistered")
+	require.True(t, destroyed)
+	require.Empty(t, glue.GetBeans[*unregisterRacingUser](ctn))
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+func (t *container) Refresh(types ...reflect.Type) error {
+	return t.RefreshWithContext(context.Background(), types...)
+}
+
+func (t *container) RefreshWithContext(ctx context.Context, types ...reflect.Type) error {
+	var roots []*bean
+	for _, typ := range types {
+		var list []*bean
+		if candidates := t.getBean(typ); len(candidates) > 0 {
+			list = levelBeans(candidates, 1)
+		}
+		if len(list) == 0 {
+			return fmt.Errorf("bean '%v' not found in the container", typ)
+		}
+		for _, b := range list {
+			if b.beenFactory != nil {
+				return fmt.Errorf("bean '%s' was created by factory bean '%v' and can not be refreshed", b.name, b.beenFactory.factoryClassPtr)
+			}
+			roots = append(roots, b)
+		}
+	}
+
+	order := t.refreshOrder(roots)
+
+	for i := len(order) - 1; i >= 0; i-- {
+		b := order[i]
+		t.logf(LogInfo, "Refresh: destroy bean '%s' with type '%v'\n", b.name, b.beanDef.classPtr)
+		b.ctorMu.Lock()
+		err := t.reloadDestroy(ctx, b)
+		b.ctorMu.Unlock()
+		if err != nil {
+			return fmt.Errorf("refresh of bean '%s' failed: %w", b.name, err)
+		}
+	}
+
+	for _, b := range order {
+		t.logf(LogInfo, "Refresh: construct bean '%s' with type '%v'\n", b.name, b.beanDef.classPtr)
+		b.ctorMu.Lock()
+		err := t.reloadConstruct(ctx, b)
+		b.ctorMu.Unlock()
+		if err != nil {
+			return fmt.Errorf("refresh of bean '%s' failed: %w", b.name, err)
+		}
+	}
+	return nil
+}
+
+/**
+Returns roots and beans of the current container depending on them transitively, dependencies first
+*/
+
+func (t *container) refreshOrder(roots []*bean) []*bean {
+	dependents := make(map[*bean][]*bean)
+	t.forEachInjection(func(from, to *bean) {
+		dependents[to] = append(dependents[to], from)
+	})
+
+	affected := make(map[*bean]bool)
+	var visit func(b *bean)
+	visit = func(b *bean) {
+		if affected[b] || b.beenFactory != nil {
+			return
+		}
+		affected[b] = true
+		for _, d := range dependents[b] {
+			visit(d)
+		}
+	}
+	for _, b := range roots {
+		visit(b)
+	}
+
+	var order []*bean
+	visited := make(map[*bean]bool)
+	var sortDeps func(b *bean)
+	sortDeps = func(b *bean) {
+		if visited[b] {
+			return
+		}
+		visited[b] = true
+		for _, in := range b.injectedBeans {
+			if affected[in.to] {
+				sortDeps(in.to)
+			}
+		}
+		for _, dep := range b.dependencies {
+			if affected[dep] {
+				sortDeps(dep)
+			}
+		}
+		order = append(order, b)
+	}
+	for _, b := range roots {
+		sortDeps(b)
+	}
+	// dependents are not reachable from roots by dependencies
+	for _, beans := range t.core {
+		for _, b := range beans {
+			if affected[b] {
+				sortDeps(b)
+			}
+		}
+	}
+	return order
+}
+
+/**
+Visits dependencies, factory dependencies and lazy injections of beans in the current container
+*/
+
+func (t *container) forEachInjection(cb func(from, to *bean)) {
+	t.forEachDependency(cb)
+	for _, beans := range t.core {
+		for _, b := range beans {
+			for _, in := range b.injectedBeans {
+				if in.def.lazy {
+					cb(b, in.to)
+				}
+			}
+		}
+	}
+}
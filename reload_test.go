@@ -88,6 +88,8 @@ func (t *configBean) PostConstruct() error {
 }
 
 func TestReload_PropertyReResolution(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	b := &configBean{}
 	ctn, err := glue.New(
 		&glue.PropertySource{Map: map[string]any{"db.url": "pg://old"}},
@@ -153,6 +155,8 @@ func (t *contextAwareConfigBean) PostConstruct(ctx context.Context) error {
 }
 
 func TestReload_DefaultUsedWhenPropertyRemoved(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	b := &configBean{}
 	ctn, err := glue.New(
 		&glue.PropertySource{Map: map[string]any{"db.url": "pg://set"}},
@@ -194,3 +198,95 @@ type injectHolder struct {
 }
 
 func (t *injectHolder) PostConstruct() error { return nil }
+
+// --- refresh by type with dependents ---
+
+type refreshEvents struct {
+	list []string
+}
+
+type refreshBase struct {
+	Events *refreshEvents `inject:""`
+	URL    string         `value:"refresh.url,default=localhost"`
+}
+
+func (t *refreshBase) PostConstruct() error {
+	t.Events.list = append(t.Events.list, "construct base "+t.URL)
+	return nil
+}
+
+func (t *refreshBase) Destroy() error {
+	t.Events.list = append(t.Events.list, "destroy base")
+	return nil
+}
+
+type refreshService struct {
+	Events *refreshEvents `inject:""`
+	Base   *refreshBase   `inject:""`
+}
+
+func (t *refreshService) PostConstruct() error {
+	t.Events.list = append(t.Events.list, "construct service")
+	return nil
+}
+
+func (t *refreshService) Destroy() error {
+	t.Events.list = append(t.Events.list, "destroy service")
+	return nil
+}
+
+type refreshHandler struct {
+	Events  *refreshEvents  `inject:""`
+	Service *refreshService `inject:""`
+}
+
+func (t *refreshHandler) PostConstruct() error {
+	t.Events.list = append(t.Events.list, "construct handler")
+	return nil
+}
+
+func (t *refreshHandler) Destroy() error {
+	t.Events.list = append(t.Events.list, "destroy handler")
+	return nil
+}
+
+func TestRefreshByType(t *testing.T) {
+
+	events := &refreshEvents{}
+	ctn, err := glue.New(
+		events,
+		&refreshHandler{},
+		&refreshService{},
+		&refreshBase{},
+		glue.MapPropertySource{"refresh.url": "first"},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	events.list = nil
+	ctn.Properties().Set("refresh.url", "second")
+
+	err = ctn.Refresh(reflect.TypeOf((*refreshBase)(nil)))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"destroy handler",
+		"destroy service",
+		"destroy base",
+		"construct base second",
+		"construct service",
+		"construct handler",
+	}, events.list)
+
+	events.list = nil
+	err = ctn.Refresh(reflect.TypeOf((*refreshService)(nil)))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"destroy handler",
+		"destroy service",
+		"construct service",
+		"construct handler",
+	}, events.list)
+
+	err = ctn.Refresh(reflect.TypeOf((*configBean)(nil)))
+	require.Error(t, err)
+}
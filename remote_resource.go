@@ -0,0 +1,290 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultRemoteTimeout is the timeout of one request of RemoteResourceSource without Timeout.
+var DefaultRemoteTimeout = 10 * time.Second
+
+// DefaultRemoteRetryBackoff is the delay before the first retry, doubled for each next one.
+var DefaultRemoteRetryBackoff = 200 * time.Millisecond
+
+var RemoteResourceSourceClass = reflect.TypeOf((*RemoteResourceSource)(nil))
+
+/**
+RemoteResourceSource adds resources downloaded from the "http://", "https://" or "s3://bucket/prefix" URL
+as the ResourceSource with the same name, so they are available by Container.Resource and PropertySource:
+
+	&glue.RemoteResourceSource{Name: "remote", URL: "https://config.example.com/app", AssetNames: []string{"app.yaml"}},
+	&glue.PropertySource{File: "remote:app.yaml"},
+
+Downloaded content is cached for CacheTTL and revalidated by ETag or Last-Modified after it,
+the cached content is returned if the revalidation fails.
+*/
+
+type RemoteResourceSource struct {
+	Name       string
+	URL        string
+	AssetNames []string
+
+	Timeout      time.Duration // timeout of one request, DefaultRemoteTimeout if zero
+	Retries      int           // retries of failed requests, network errors and 429, 5xx statuses are retried
+	RetryBackoff time.Duration // DefaultRemoteRetryBackoff if zero
+	CacheTTL     time.Duration // zero revalidates the content on every Open
+
+	Header http.Header
+	Client *http.Client
+
+	/*
+		S3Region selects the regional endpoint of "s3://" URLs, S3Endpoint replaces it with the path-style endpoint,
+		for example "http://localhost:9000" for MinIO.
+	*/
+	S3Region   string
+	S3Endpoint string
+
+	/*
+		Sign is called for every request, for example to add S3 signature or the bearer token
+	*/
+	Sign func(req *http.Request) error
+
+	/*
+		Checksums, Manifest and Integrity verify downloaded resources like in ResourceSource, the Manifest is
+		downloaded from the URL of the source. Every Open verifies the content it returns, also after revalidation.
+	*/
+	Checksums map[string]string
+	Manifest  string
+	Integrity IntegrityMode
+}
+
+/**
+The http.FileSystem of RemoteResourceSource, caches files by name
+*/
+
+type remoteFileSystem struct {
+	source  *RemoteResourceSource
+	baseURL string
+	client  *http.Client
+
+	mu    sync.Mutex
+	cache map[string]*remoteEntry
+}
+
+type remoteEntry struct {
+	content      []byte
+	etag         string
+	lastModified string
+	modTime      time.Time
+	fetched      time.Time
+}
+
+func newRemoteFileSystem(source *RemoteResourceSource) (*remoteFileSystem, error) {
+	baseURL, err := remoteBaseURL(source)
+	if err != nil {
+		return nil, err
+	}
+	client := source.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &remoteFileSystem{
+		source:  source,
+		baseURL: baseURL,
+		client:  client,
+		cache:   make(map[string]*remoteEntry),
+	}, nil
+}
+
+/**
+Returns the http(s) base URL of the source ending with slash, "s3://" URLs are mapped to the S3 endpoint
+*/
+
+func remoteBaseURL(source *RemoteResourceSource) (string, error) {
+	u, err := url.Parse(source.URL)
+	if err != nil {
+		return "", fmt.Errorf("remote resource source '%s': %w", source.Name, err)
+	}
+	prefix := strings.Trim(u.Path, "/")
+	switch u.Scheme {
+	case "http", "https":
+		base := u.Scheme + "://" + u.Host
+		if prefix != "" {
+			base += "/" + prefix
+		}
+		return base + "/", nil
+	case "s3":
+		if u.Host == "" {
+			return "", fmt.Errorf("remote resource source '%s': bucket is empty in '%s'", source.Name, source.URL)
+		}
+		var base string
+		switch {
+		case source.S3Endpoint != "":
+			base = strings.TrimSuffix(source.S3Endpoint, "/") + "/" + u.Host
+		case source.S3Region != "":
+			base = "https://" + u.Host + ".s3." + source.S3Region + ".amazonaws.com"
+		default:
+			base = "https://" + u.Host + ".s3.amazonaws.com"
+		}
+		if prefix != "" {
+			base += "/" + prefix
+		}
+		return base + "/", nil
+	default:
+		return "", fmt.Errorf("remote resource source '%s': unsupported scheme '%s', expected http, https or s3", source.Name, u.Scheme)
+	}
+}
+
+func (t *remoteFileSystem) Open(name string) (http.File, error) {
+	name = strings.TrimPrefix(name, "/")
+	t.mu.Lock()
+	entry := t.cache[name]
+	t.mu.Unlock()
+	if entry != nil && t.source.CacheTTL > 0 && time.Since(entry.fetched) < t.source.CacheTTL {
+		return entry.file(name), nil
+	}
+
+	next, err := t.fetch(name, entry)
+	if err != nil {
+		if entry != nil {
+			return entry.file(name), nil
+		}
+		return nil, err
+	}
+	t.mu.Lock()
+	t.cache[name] = next
+	t.mu.Unlock()
+	return next.file(name), nil
+}
+
+/**
+Downloads the file with retries, returns the revalidated previous entry on 304 Not Modified
+*/
+
+func (t *remoteFileSystem) fetch(name string, prev *remoteEntry) (*remoteEntry, error) {
+	backoff := t.source.RetryBackoff
+	if backoff <= 0 {
+		backoff = DefaultRemoteRetryBackoff
+	}
+	var lastErr error
+	for attempt := 0; attempt <= t.source.Retries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		entry, retry, err := t.request(name, prev)
+		if err == nil {
+			return entry, nil
+		}
+		lastErr = err
+		if !retry {
+			break
+		}
+	}
+	return nil, lastErr
+}
+
+func (t *remoteFileSystem) request(name string, prev *remoteEntry) (entry *remoteEntry, retry bool, err error) {
+	timeout := t.source.Timeout
+	if timeout <= 0 {
+		timeout = DefaultRemoteTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	target := t.baseURL + name
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	for key, values := range t.source.Header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if prev != nil {
+		if prev.etag != "" {
+			req.Header.Set("If-None-Match", prev.etag)
+		}
+		if prev.lastModified != "" {
+			req.Header.Set("If-Modified-Since", prev.lastModified)
+		}
+	}
+	if t.source.Sign != nil {
+		if err := t.source.Sign(req); err != nil {
+			return nil, false, fmt.Errorf("sign request of '%s': %w", target, err)
+		}
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, true, fmt.Errorf("get '%s': %w", target, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && prev != nil:
+		revalidated := *prev
+		revalidated.fetched = time.Now()
+		return &revalidated, false, nil
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, false, fmt.Errorf("get '%s': %w", target, os.ErrNotExist)
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return nil, true, fmt.Errorf("get '%s': status %s", target, resp.Status)
+	case resp.StatusCode != http.StatusOK:
+		return nil, false, fmt.Errorf("get '%s': status %s", target, resp.Status)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, fmt.Errorf("read '%s': %w", target, err)
+	}
+	entry = &remoteEntry{
+		content:      content,
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+		fetched:      time.Now(),
+	}
+	if entry.lastModified != "" {
+		entry.modTime, _ = http.ParseTime(entry.lastModified)
+	}
+	return entry, false, nil
+}
+
+func (t *remoteEntry) file(name string) http.File {
+	return &renderedFile{Reader: bytes.NewReader(t.content), name: path.Base(name), size: int64(len(t.content)), modTime: t.modTime}
+}
+
+/**
+Converts the remote source to ResourceSource registered by the container
+*/
+
+func (t *RemoteResourceSource) resourceSource() (*ResourceSource, error) {
+	fs, err := newRemoteFileSystem(t)
+	if err != nil {
+		return nil, err
+	}
+	return &ResourceSource{
+		Name:       t.Name,
+		AssetNames: t.AssetNames,
+		AssetFiles: fs,
+		Checksums:  t.Checksums,
+		Manifest:   t.Manifest,
+		Integrity:  t.Integrity,
+	}, nil
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+func readResource(t *testing.T, ctn glue.Container, path string) string {
+	res, ok := ctn.Resource(path)
+	require.True(t, ok)
+	file, err := res.Open()
+	require.NoError(t, err)
+	defer file.Close()
+	content, err := ioutil.ReadAll(file)
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestRemoteResourceSource(t *testing.T) {
+
+	var requests, notModified, failures int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		require.Equal(t, "secret", r.Header.Get("X-Token"))
+		switch r.URL.Path {
+		case "/config/app.properties":
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				atomic.AddInt32(&notModified, 1)
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			w.Write([]byte("app.name = remote\n"))
+		case "/config/flaky.txt":
+			if atomic.AddInt32(&failures, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte("ok"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	ctn, err := glue.New(
+		&glue.RemoteResourceSource{
+			Name:         "remote",
+			URL:          server.URL + "/config/",
+			AssetNames:   []string{"app.properties", "flaky.txt", "missing.txt"},
+			Retries:      2,
+			RetryBackoff: time.Millisecond,
+			Header:       http.Header{"X-Token": []string{"secret"}},
+		},
+		&glue.PropertySource{File: "remote:app.properties"},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, "remote", ctn.Properties().GetString("app.name", ""))
+
+	// revalidated by ETag
+	require.Equal(t, "app.name = remote\n", readResource(t, ctn, "remote:app.properties"))
+	require.Equal(t, int32(1), atomic.LoadInt32(&notModified))
+
+	require.Equal(t, "ok", readResource(t, ctn, "remote:flaky.txt"))
+	require.Equal(t, int32(3), atomic.LoadInt32(&failures))
+
+	res, ok := ctn.Resource("remote:missing.txt")
+	require.True(t, ok)
+	_, err = res.Open()
+	require.Error(t, err)
+}
+
+func TestRemoteResourceCache(t *testing.T) {
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		require.Equal(t, "/bucket/assets/logo.txt", r.URL.Path)
+		w.Write([]byte("logo"))
+	}))
+	defer server.Close()
+
+	ctn, err := glue.New(
+		&glue.RemoteResourceSource{
+			Name:       "s3",
+			URL:        "s3://bucket/assets",
+			S3Endpoint: server.URL,
+			AssetNames: []string{"logo.txt"},
+			CacheTTL:   time.Hour,
+		},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, "logo", readResource(t, ctn, "s3:logo.txt"))
+	require.Equal(t, "logo", readResource(t, ctn, "s3:logo.txt"))
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	_, err = glue.New(&glue.RemoteResourceSource{Name: "ftp", URL: "ftp://host/x"})
+	require.Error(t, err)
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ReportSchema is the version of the document produced by Container.Report, changed only on incompatible changes.
+const ReportSchema = "glue.report/v1"
+
+type ReportFormat string
+
+const (
+	ReportJSON ReportFormat = "json"
+	ReportYAML ReportFormat = "yaml"
+)
+
+/**
+ContainerReport is the machine-readable dump of the container returned by Container.ContainerReport,
+values of properties matching mask patterns are masked.
+*/
+
+type ContainerReport struct {
+	Schema     string            `json:"schema" yaml:"schema"`
+	Labels     Labels            `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Profiles   []string          `json:"profiles,omitempty" yaml:"profiles,omitempty"`
+	HasParent  bool              `json:"hasParent" yaml:"hasParent"`
+	Beans      []BeanInfo        `json:"beans" yaml:"beans"`
+	Properties []PropertyReport  `json:"properties,omitempty" yaml:"properties,omitempty"`
+	Resources  []ResourceReport  `json:"resources,omitempty" yaml:"resources,omitempty"`
+	Children   []ChildReport     `json:"children,omitempty" yaml:"children,omitempty"`
+	Extended   []ContainerReport `json:"extended,omitempty" yaml:"extended,omitempty"` // live containers created by Extend
+}
+
+type PropertyReport struct {
+	Key    string `json:"key" yaml:"key"`
+	Value  string `json:"value" yaml:"value"`
+	Origin string `json:"origin,omitempty" yaml:"origin,omitempty"`
+}
+
+type ResourceReport struct {
+	Source string   `json:"source" yaml:"source"`
+	Names  []string `json:"names" yaml:"names"`
+}
+
+/**
+ChildReport is the child container declared by glue.Child, Created is true if it was already requested.
+*/
+
+type ChildReport struct {
+	Name    string `json:"name" yaml:"name"`
+	Created bool   `json:"created" yaml:"created"`
+}
+
+func (t *container) ContainerReport() *ContainerReport {
+	report := &ContainerReport{
+		Schema:    ReportSchema,
+		Labels:    t.Labels(),
+		HasParent: t.parent != nil,
+	}
+	if t.profiles != nil {
+		report.Profiles = t.profiles.List()
+	}
+	for _, info := range t.Beans() {
+		if info.Origin != OriginChild {
+			report.Beans = append(report.Beans, info)
+		}
+	}
+
+	keys := t.properties.Keys()
+	sort.Strings(keys)
+	for _, key := range keys {
+		entry, ok := t.properties.GetEntry(key)
+		if !ok {
+			continue
+		}
+		report.Properties = append(report.Properties, PropertyReport{
+			Key:    key,
+			Value:  t.properties.MaskValue(key, entry.Value),
+			Origin: entry.Origin,
+		})
+	}
+
+	for source, src := range t.resourceSources.sources {
+		r := ResourceReport{Source: source}
+		for name := range src.resources {
+			r.Names = append(r.Names, name)
+		}
+		sort.Strings(r.Names)
+		report.Resources = append(report.Resources, r)
+	}
+	sort.Slice(report.Resources, func(i, j int) bool {
+		return report.Resources[i].Source < report.Resources[j].Source
+	})
+
+	for _, child := range t.children {
+		r := ChildReport{Name: child.ChildName()}
+		if cc, ok := child.(*childContext); ok {
+			r.Created = cc.ctx != nil
+		}
+		report.Children = append(report.Children, r)
+	}
+
+	t.extendedMu.Lock()
+	extended := append([]*container(nil), t.extended...)
+	t.extendedMu.Unlock()
+	for _, c := range extended {
+		report.Extended = append(report.Extended, *c.ContainerReport())
+	}
+	return report
+}
+
+func (t *container) Report(format ReportFormat) ([]byte, error) {
+	return t.ContainerReport().Encode(format)
+}
+
+/**
+Encode renders the report as indented JSON or YAML document.
+*/
+
+func (t *ContainerReport) Encode(format ReportFormat) ([]byte, error) {
+	switch ReportFormat(strings.ToLower(string(format))) {
+	case ReportJSON:
+		return json.MarshalIndent(t, "", "  ")
+	case ReportYAML, "yml":
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(t); err != nil {
+			return nil, err
+		}
+		if err := enc.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unknown report format '%s', expected json or yaml", format)
+	}
+}
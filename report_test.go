@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+	"gopkg.in/yaml.v3"
+)
+
+type dumpRepository struct {
+	Password string `value:"db.password"`
+}
+
+type dumpService struct {
+	Repository *dumpRepository `inject:""`
+}
+
+func TestContainerReport(t *testing.T) {
+
+	props := glue.NewProperties()
+	props.SetMaskPatterns("*.password")
+
+	ctn, err := glue.NewWithOptions(
+		glue.WithProperties(props),
+		glue.WithLabels(glue.Labels{"app": "billing"}),
+		glue.WithBeans(
+			&dumpService{},
+			&dumpRepository{},
+			glue.MapPropertySource{"db.password": "secret", "db.url": "postgres://db"},
+			glue.ResourceSource{
+				Name:       "resources",
+				AssetNames: []string{"b.txt", "a.txt"},
+				AssetFiles: oneFile{name: "a.txt", content: "a"},
+			},
+			glue.Child("admin"),
+		),
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	child, err := ctn.Extend(&struct{ Name string }{})
+	require.NoError(t, err)
+	defer child.Close()
+
+	data, err := ctn.Report(glue.ReportJSON)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "secret")
+
+	var report glue.ContainerReport
+	require.NoError(t, json.Unmarshal(data, &report))
+	require.Equal(t, glue.ReportSchema, report.Schema)
+	require.Equal(t, "billing", report.Labels["app"])
+	require.False(t, report.HasParent)
+	values := make(map[string]string)
+	for _, p := range report.Properties {
+		values[p.Key] = p.Value
+	}
+	require.Equal(t, glue.DefaultMask, values["db.password"])
+	require.Equal(t, "postgres://db", values["db.url"])
+	require.Equal(t, []glue.ResourceReport{{Source: "resources", Names: []string{"a.txt", "b.txt"}}}, report.Resources)
+	require.Equal(t, []glue.ChildReport{{Name: "admin"}}, report.Children)
+	require.Len(t, report.Extended, 1)
+	require.True(t, report.Extended[0].HasParent)
+
+	var types []string
+	for _, b := range report.Beans {
+		require.NotEqual(t, glue.OriginChild, b.Origin)
+		types = append(types, b.Type)
+	}
+	require.Contains(t, types, "*glue_test.dumpService")
+
+	data, err = ctn.Report(glue.ReportYAML)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "secret")
+	var doc map[string]any
+	require.NoError(t, yaml.Unmarshal(data, &doc))
+	require.Equal(t, glue.ReportSchema, doc["schema"])
+
+	_, err = ctn.Report("xml")
+	require.Error(t, err)
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"reflect"
+	"strings"
+)
+
+var bytesType = reflect.TypeOf([]byte(nil))
+
+/**
+Field with the tag resource:"source:path[,optional]", the path may contain ${...} placeholders.
+Fields of type Resource receive the handle, []byte and string fields receive the content.
+*/
+
+type resourceInjectionDef struct {
+	class     reflect.Type
+	fieldNum  int
+	index     []int
+	fieldName string
+	fieldType reflect.Type
+	path      string
+	optional  bool
+}
+
+func parseResourceTag(class reflect.Type, fieldNum int, field reflect.StructField, tag string) (*resourceInjectionDef, error) {
+	def := &resourceInjectionDef{
+		class:     class,
+		fieldNum:  fieldNum,
+		fieldName: field.Name,
+		fieldType: field.Type,
+	}
+	for i, pair := range strings.Split(tag, ",") {
+		p := strings.TrimSpace(pair)
+		if i == 0 {
+			def.path = p
+			continue
+		}
+		switch p {
+		case "optional":
+			def.optional = true
+		default:
+			return nil, fmt.Errorf("unknown option '%s'", p)
+		}
+	}
+	if def.path == "" {
+		return nil, errors.New("empty resource path")
+	}
+	switch field.Type {
+	case ResourceClass, bytesType, reflect.TypeOf(""):
+	default:
+		return nil, fmt.Errorf("unsupported type '%v', expected glue.Resource, []byte or string", field.Type)
+	}
+	return def, nil
+}
+
+func (t *resourceInjectionDef) field(value reflect.Value) reflect.Value {
+	if t.index != nil {
+		return value.FieldByIndex(t.index)
+	}
+	return value.Field(t.fieldNum)
+}
+
+func (t *container) injectResource(value *reflect.Value, def *resourceInjectionDef) error {
+	field := def.field(*value)
+	if !field.CanSet() {
+		return fmt.Errorf("field '%s' in class '%v' is not public", def.fieldName, def.class)
+	}
+	path, err := t.properties.ResolveText(def.path)
+	if err != nil {
+		return fmt.Errorf("resource '%s' of field '%s' in class '%v' resolution error: %w", def.path, def.fieldName, def.class, err)
+	}
+	var res Resource
+	if strings.HasPrefix(path, "file:") {
+		res = fileResource(path[len("file:"):])
+	} else if r, ok := t.Resource(path); ok {
+		res = r
+	} else if def.optional {
+		return nil
+	} else {
+		return fmt.Errorf("resource '%s' of field '%s' in class '%v' was not found", path, def.fieldName, def.class)
+	}
+	if def.fieldType == ResourceClass {
+		field.Set(reflect.ValueOf(&res).Elem())
+		return nil
+	}
+	file, err := res.Open()
+	if err != nil {
+		if def.optional && errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("i/o error with resource '%s' of field '%s' in class '%v': %w", path, def.fieldName, def.class, err)
+	}
+	content, err := io.ReadAll(file)
+	file.Close()
+	if err != nil {
+		return fmt.Errorf("i/o error with resource '%s' of field '%s' in class '%v': %w", path, def.fieldName, def.class, err)
+	}
+	if def.fieldType == bytesType {
+		field.SetBytes(content)
+	} else {
+		field.SetString(string(content))
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"io/ioutil"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type schemaMigrator struct {
+	Schema   string        `resource:"resources:schema.sql"`
+	Seed     []byte        `resource:"resources:${db.seed}"`
+	Handle   glue.Resource `resource:"resources:schema.sql"`
+	Override string        `resource:"resources:override.sql,optional"`
+}
+
+type schemaMissing struct {
+	Schema string `resource:"resources:missing.sql"`
+}
+
+type schemaWrongType struct {
+	Schema int `resource:"resources:schema.sql"`
+}
+
+func TestResourceInjection(t *testing.T) {
+
+	assets := &glue.ResourceSource{Name: "resources", FS: fstest.MapFS{
+		"schema.sql": {Data: []byte("create table users;")},
+		"seed.sql":   {Data: []byte("insert into users;")},
+	}}
+
+	m := &schemaMigrator{}
+	ctn, err := glue.New(assets, glue.MapPropertySource{"db.seed": "seed.sql"}, m)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, "create table users;", m.Schema)
+	require.Equal(t, []byte("insert into users;"), m.Seed)
+	require.Empty(t, m.Override)
+
+	require.NotNil(t, m.Handle)
+	file, err := m.Handle.Open()
+	require.NoError(t, err)
+	content, err := ioutil.ReadAll(file)
+	file.Close()
+	require.NoError(t, err)
+	require.Equal(t, m.Schema, string(content))
+
+	_, err = glue.New(assets, &schemaMissing{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "resources:missing.sql")
+
+	_, err = glue.New(assets, &schemaWrongType{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unsupported type")
+}
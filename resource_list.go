@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+type namedResource struct {
+	Resource
+	path string
+}
+
+func (t namedResource) Path() string {
+	return t.path
+}
+
+func (t *container) Resources(prefix string) []Resource {
+	idx := strings.IndexByte(prefix, ':')
+	if idx == -1 {
+		return nil
+	}
+	source := prefix[:idx]
+	found := t.resourcesByPrefix(source, prefix[idx+1:])
+	names := sortedKeys(found)
+	list := make([]Resource, 0, len(names))
+	for _, name := range names {
+		list = append(list, namedResource{Resource: found[name], path: source + ":" + name})
+	}
+	return list
+}
+
+/**
+Returns resources of the source by name with the prefix, resources of this container hide ones of parents
+*/
+
+func (t *container) resourcesByPrefix(source, prefix string) map[string]Resource {
+	found := make(map[string]Resource)
+	for current := t; current != nil; current = current.parent {
+		src, ok := current.resourceSources.sources[source]
+		if !ok {
+			continue
+		}
+		for name, res := range src.resources {
+			if _, ok := found[name]; !ok && strings.HasPrefix(name, prefix) {
+				found[name] = res
+			}
+		}
+	}
+	return found
+}
+
+/**
+Returns the directory resource if any resource of the source is in it
+*/
+
+func (t *container) resourceDir(source, dir string) (Resource, bool) {
+	found := t.resourcesByPrefix(source, dir)
+	if len(found) == 0 {
+		return nil, false
+	}
+	return &dirResource{dir: dir, entries: found}, true
+}
+
+/**
+Directory of resources merged across resource sources, Readdir lists files and subdirectories
+*/
+
+type dirResource struct {
+	dir     string
+	entries map[string]Resource
+}
+
+func (t *dirResource) Open() (http.File, error) {
+	files := make(map[string]fs.FileInfo)
+	for name, res := range t.entries {
+		rest := strings.TrimPrefix(name, t.dir)
+		if i := strings.IndexByte(rest, '/'); i != -1 {
+			sub := rest[:i]
+			files[sub] = &dirInfo{name: sub}
+			continue
+		}
+		info, err := resourceInfo(rest, res)
+		if err != nil {
+			return nil, err
+		}
+		files[rest] = info
+	}
+	list := make([]fs.FileInfo, 0, len(files))
+	for _, name := range sortedKeys(files) {
+		list = append(list, files[name])
+	}
+	return &dirFile{info: dirInfo{name: path.Base("/" + strings.TrimSuffix(t.dir, "/"))}, entries: list}, nil
+}
+
+func resourceInfo(name string, res Resource) (fs.FileInfo, error) {
+	file, err := res.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return &renderedFile{name: name, size: info.Size(), modTime: info.ModTime()}, nil
+}
+
+type dirFile struct {
+	info    dirInfo
+	entries []fs.FileInfo
+	pos     int
+}
+
+func (t *dirFile) Read(p []byte) (int, error) {
+	return 0, errors.New("resource is a directory")
+}
+
+func (t *dirFile) Seek(offset int64, whence int) (int64, error) {
+	return 0, errors.New("resource is a directory")
+}
+
+func (t *dirFile) Close() error {
+	return nil
+}
+
+func (t *dirFile) Readdir(count int) ([]fs.FileInfo, error) {
+	rest := t.entries[t.pos:]
+	if count <= 0 {
+		t.pos = len(t.entries)
+		return rest, nil
+	}
+	if len(rest) == 0 {
+		return nil, io.EOF
+	}
+	if count > len(rest) {
+		count = len(rest)
+	}
+	t.pos += count
+	return rest[:count], nil
+}
+
+func (t *dirFile) Stat() (fs.FileInfo, error) {
+	return &t.info, nil
+}
+
+type dirInfo struct {
+	name string
+}
+
+func (t *dirInfo) Name() string {
+	return t.name
+}
+
+func (t *dirInfo) Size() int64 {
+	return 0
+}
+
+func (t *dirInfo) Mode() fs.FileMode {
+	return fs.ModeDir | 0555
+}
+
+func (t *dirInfo) ModTime() time.Time {
+	return time.Time{}
+}
+
+func (t *dirInfo) IsDir() bool {
+	return true
+}
+
+func (t *dirInfo) Sys() any {
+	return nil
+}
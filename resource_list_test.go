@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"io"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+func resourcePaths(list []glue.Resource) []string {
+	var paths []string
+	for _, res := range list {
+		paths = append(paths, res.(glue.NamedResource).Path())
+	}
+	return paths
+}
+
+func TestResourcesByPrefix(t *testing.T) {
+
+	parent, err := glue.New(
+		&glue.ResourceSource{Name: "resources", FS: fstest.MapFS{
+			"migrations/001_init.sql":  {Data: []byte("create")},
+			"migrations/002_users.sql": {Data: []byte("users")},
+		}},
+		&glue.ResourceSource{Name: "resources", FS: fstest.MapFS{
+			"migrations/003_orders.sql":  {Data: []byte("orders")},
+			"migrations/seed/users.csv":  {Data: []byte("id,name")},
+			"templates/welcome.html.tpl": {Data: []byte("hi")},
+		}},
+	)
+	require.NoError(t, err)
+	defer parent.Close()
+
+	child, err := parent.Extend(
+		&glue.ResourceSource{Name: "resources", FS: fstest.MapFS{
+			"migrations/004_tenant.sql": {Data: []byte("tenant")},
+		}},
+	)
+	require.NoError(t, err)
+	defer child.Close()
+
+	require.Equal(t, []string{
+		"resources:migrations/001_init.sql",
+		"resources:migrations/002_users.sql",
+		"resources:migrations/003_orders.sql",
+		"resources:migrations/004_tenant.sql",
+		"resources:migrations/seed/users.csv",
+	}, resourcePaths(child.Resources("resources:migrations/")))
+	require.Len(t, parent.Resources("resources:migrations/"), 4)
+	require.Len(t, child.Resources("resources:"), 6)
+	require.Empty(t, child.Resources("assets:"))
+	require.Empty(t, child.Resources("migrations/"))
+
+	dir, ok := child.Resource("resources:migrations/")
+	require.True(t, ok)
+	file, err := dir.Open()
+	require.NoError(t, err)
+	defer file.Close()
+
+	info, err := file.Stat()
+	require.NoError(t, err)
+	require.True(t, info.IsDir())
+	require.Equal(t, "migrations", info.Name())
+
+	entries, err := file.Readdir(3)
+	require.NoError(t, err)
+	require.Equal(t, "001_init.sql", entries[0].Name())
+	require.Equal(t, int64(len("create")), entries[0].Size())
+	rest, err := file.Readdir(3)
+	require.NoError(t, err)
+	require.Len(t, rest, 2)
+	require.Equal(t, "seed", rest[1].Name())
+	require.True(t, rest[1].IsDir())
+	_, err = file.Readdir(1)
+	require.Equal(t, io.EOF, err)
+
+	_, ok = child.Resource("resources:unknown/")
+	require.False(t, ok)
+}
@@ -9,9 +9,13 @@ import (
 	"errors"
 	"github.com/stretchr/testify/require"
 	"go.arpabet.com/glue"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"testing/fstest"
 )
 
 type fileSystemStub struct {
@@ -126,3 +130,99 @@ func TestResourceParent(t *testing.T) {
 	}
 
 }
+
+func TestResourceFS(t *testing.T) {
+
+	assets := fstest.MapFS{
+		"app.properties":   {Data: []byte("app.name = demo\n")},
+		"static/index.txt": {Data: []byte("hello")},
+	}
+
+	ctx, err := glue.New(
+		&glue.ResourceSource{Name: "resources", FS: assets},
+		glue.PropertySource{File: "resources:app.properties"},
+	)
+	require.NoError(t, err)
+	defer ctx.Close()
+
+	require.Equal(t, "demo", ctx.Properties().GetString("app.name", ""))
+
+	res, ok := ctx.Resource("resources:static/index.txt")
+	require.True(t, ok)
+	file, err := res.Open()
+	require.NoError(t, err)
+	defer file.Close()
+	content, err := ioutil.ReadAll(file)
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(content))
+
+	_, ok = ctx.Resource("resources:static")
+	require.False(t, ok)
+}
+
+func TestResourceDir(t *testing.T) {
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "tenants"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.yaml"), []byte("app:\n  name: demo\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("skip"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tenants", "acme.yaml"), []byte("tenant: acme\n"), 0644))
+
+	ctx, err := glue.New(
+		&glue.ResourceSource{Name: "configs", Dir: dir, Glob: "*.yaml"},
+		glue.PropertySource{File: "configs:app.yaml"},
+	)
+	require.NoError(t, err)
+	defer ctx.Close()
+
+	require.Equal(t, "demo", ctx.Properties().GetString("app.name", ""))
+	_, ok := ctx.Resource("configs:notes.txt")
+	require.False(t, ok)
+	_, ok = ctx.Resource("configs:tenants/acme.yaml")
+	require.False(t, ok)
+
+	recursive, err := glue.New(
+		&glue.ResourceSource{Name: "configs", Dir: dir, Glob: "*.yaml", Recursive: true},
+	)
+	require.NoError(t, err)
+	defer recursive.Close()
+
+	_, ok = recursive.Resource("configs:tenants/acme.yaml")
+	require.True(t, ok)
+
+	_, err = glue.New(&glue.ResourceSource{Name: "configs", Dir: filepath.Join(dir, "missing")})
+	require.Error(t, err)
+}
+
+func TestPropertySourcePaths(t *testing.T) {
+
+	skipUnsupported(t, glue.FeatureFileProperties)
+
+	dir := t.TempDir()
+	override := filepath.Join(dir, "override.properties")
+	require.NoError(t, os.WriteFile(override, []byte("db.pool = 20\n"), 0644))
+
+	defaults := fstest.MapFS{
+		"defaults.properties": {Data: []byte("db.url = localhost\ndb.pool = 5\n")},
+	}
+
+	ctx, err := glue.New(
+		&glue.ResourceSource{Name: "resources", FS: defaults},
+		&glue.PropertySource{Paths: []string{
+			"resources:defaults.properties",
+			"file:" + override,
+			"optional:file:" + filepath.Join(dir, "missing.properties"),
+			"optional:resources:missing.properties",
+		}},
+	)
+	require.NoError(t, err)
+	defer ctx.Close()
+
+	require.Equal(t, "localhost", ctx.Properties().GetString("db.url", ""))
+	require.Equal(t, 20, ctx.Properties().GetInt("db.pool", 0))
+
+	_, err = glue.New(
+		&glue.PropertySource{Paths: []string{"file:" + filepath.Join(dir, "missing.properties")}},
+	)
+	require.Error(t, err)
+}
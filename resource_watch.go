@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// ResourceWatchIntervalProperty is the polling interval of resources watched by Container.WatchResource.
+var ResourceWatchIntervalProperty = "glue.resource.watch.interval"
+
+// DefaultResourceWatchInterval is used when ResourceWatchIntervalProperty is not set.
+var DefaultResourceWatchInterval = time.Second
+
+/**
+Version of the watched resource, the content is hashed only if the modification time or size changed
+*/
+
+type resourceVersion struct {
+	modTime time.Time
+	size    int64
+	sum     uint64
+}
+
+/**
+Resource of the OS filesystem referenced by "file:path"
+*/
+
+type fileResource string
+
+func (t fileResource) Open() (http.File, error) {
+	return os.Open(string(t))
+}
+
+func (t *container) WatchResource(path string, cb func(Resource)) (func(), error) {
+	if cb == nil {
+		return nil, errors.New("watch resource callback is nil")
+	}
+	if !hasResourceWatch {
+		return nil, requireFeature(FeatureResourceWatch)
+	}
+	t.registryMu.RLock()
+	closed := t.registry.closed
+	t.registryMu.RUnlock()
+	if closed {
+		return nil, ErrContainerClosed
+	}
+	var res Resource
+	if strings.HasPrefix(path, "file:") {
+		res = fileResource(path[len("file:"):])
+	} else if r, ok := t.Resource(path); ok {
+		res = r
+	} else {
+		return nil, fmt.Errorf("watched resource '%s' was not found", path)
+	}
+	current, err := readResourceVersion(res, resourceVersion{})
+	if err != nil {
+		return nil, fmt.Errorf("watch resource '%s': %w", path, err)
+	}
+
+	interval := t.properties.GetDuration(ResourceWatchIntervalProperty, DefaultResourceWatchInterval)
+	if interval <= 0 {
+		interval = DefaultResourceWatchInterval
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				next, err := readResourceVersion(res, current)
+				if err != nil {
+					// the file may be replaced right now, the previous version is kept
+					t.logf(LogDebug, "Watched resource '%s' is unavailable: %v\n", path, err)
+					continue
+				}
+				if next.sum == current.sum {
+					current = next
+					continue
+				}
+				current = next
+				t.logf(LogInfo, "Watched resource '%s' changed\n", path)
+				if ctx.Err() == nil {
+					cb(res)
+				}
+			}
+		}
+	}()
+
+	// callbacks are finished before beans are destroyed
+	t.OnClose(func() {
+		cancel()
+		<-done
+	})
+	return cancel, nil
+}
+
+/**
+Returns the version of the resource, the previous sum is reused if the modification time and size are the same
+*/
+
+func readResourceVersion(res Resource, prev resourceVersion) (resourceVersion, error) {
+	file, err := res.Open()
+	if err != nil {
+		return resourceVersion{}, err
+	}
+	defer file.Close()
+	var v resourceVersion
+	if info, err := file.Stat(); err == nil {
+		v.modTime, v.size = info.ModTime(), info.Size()
+		if !v.modTime.IsZero() && v.modTime.Equal(prev.modTime) && v.size == prev.size {
+			v.sum = prev.sum
+			return v, nil
+		}
+	}
+	h := fnv.New64a()
+	if _, err := io.Copy(h, file); err != nil {
+		return resourceVersion{}, err
+	}
+	v.sum = h.Sum64()
+	return v, nil
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+func TestWatchResource(t *testing.T) {
+	if !glue.FeatureSupported(glue.FeatureResourceWatch) {
+		t.Skip("resource watch is not available")
+	}
+
+	rules := filepath.Join(t.TempDir(), "rules.json")
+	require.NoError(t, os.WriteFile(rules, []byte(`{"limit": 1}`), 0644))
+
+	ctn, err := glue.New(glue.MapPropertySource{glue.ResourceWatchIntervalProperty: "10ms"})
+	require.NoError(t, err)
+
+	changes := make(chan string, 10)
+	stop, err := ctn.WatchResource("file:"+rules, func(r glue.Resource) {
+		file, err := r.Open()
+		require.NoError(t, err)
+		defer file.Close()
+		content, err := ioutil.ReadAll(file)
+		require.NoError(t, err)
+		changes <- string(content)
+	})
+	require.NoError(t, err)
+	require.NotNil(t, stop)
+
+	select {
+	case c := <-changes:
+		t.Fatalf("unexpected change %s", c)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.NoError(t, os.WriteFile(rules, []byte(`{"limit": 100}`), 0644))
+	select {
+	case c := <-changes:
+		require.Equal(t, `{"limit": 100}`, c)
+	case <-time.After(2 * time.Second):
+		t.Fatal("change was not detected")
+	}
+
+	require.NoError(t, ctn.Close())
+	require.NoError(t, os.WriteFile(rules, []byte(`{"limit": 1000}`), 0644))
+	select {
+	case c := <-changes:
+		t.Fatalf("change %s after close", c)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	_, err = ctn.WatchResource("file:"+rules, func(glue.Resource) {})
+	require.ErrorIs(t, err, glue.ErrContainerClosed)
+
+	other, err := glue.New()
+	require.NoError(t, err)
+	defer other.Close()
+	_, err = other.WatchResource("resources:unknown.json", func(glue.Resource) {})
+	require.Error(t, err)
+}
@@ -2,7 +2,10 @@ package glue
 
 import (
 	"fmt"
+	"io/fs"
 	"net/http"
+	"os"
+	"path"
 )
 
 /*
@@ -10,6 +13,7 @@ Resource types and helpers. Resources are immutable after container init.
 */
 type resourceCache struct {
 	sources map[string]*resourceSource
+	logf    func(level LogLevel, format string, v ...any)
 }
 
 func ctorResourceCache() resourceCache {
@@ -34,35 +38,125 @@ func (t resource) Open() (http.File, error) {
 	return t.source.Open(t.name)
 }
 
-func newResourceSource(source *ResourceSource) *resourceSource {
+func newResourceSource(names []string, files http.FileSystem) *resourceSource {
 	t := &resourceSource{
 		resources: make(map[string]Resource),
 	}
-	for _, name := range source.AssetNames {
-		t.resources[name] = resource{name: name, source: source.AssetFiles}
+	for _, name := range names {
+		t.resources[name] = resource{name: name, source: files}
 	}
 	return t
 }
 
-func (t *resourceSource) merge(other *ResourceSource) error {
-	for _, name := range other.AssetNames {
+func (t *resourceSource) merge(other *ResourceSource, resources map[string]Resource) error {
+	for name, res := range resources {
 		if _, ok := t.resources[name]; ok {
 			return fmt.Errorf("resource '%s' already exist in container for resource source '%s'", name, other.Name)
 		}
-		t.resources[name] = resource{name: name, source: other.AssetFiles}
+		t.resources[name] = res
 	}
 	return nil
 }
 
 func (t *resourceCache) addResourceSource(other *ResourceSource) error {
+	names, files, err := resourceAssets(other)
+	if err != nil {
+		return err
+	}
+	checksums, err := resourceChecksums(other, files)
+	if err != nil {
+		return err
+	}
+	resources := make(map[string]Resource, len(names))
+	for _, name := range names {
+		var res Resource = resource{name: name, source: files}
+		if sum, ok := checksums[name]; ok {
+			res = &verifiedResource{
+				Resource: res,
+				path:     other.Name + ":" + name,
+				sum:      sum,
+				mode:     other.Integrity,
+				logf:     t.logf,
+			}
+		}
+		resources[name] = res
+	}
+	if other.VerifyOnStart {
+		if err := verifyResources(other, resources, checksums); err != nil {
+			return err
+		}
+	}
 	if rc, ok := t.sources[other.Name]; ok {
-		return rc.merge(other)
+		return rc.merge(other, resources)
 	} else {
-		t.sources[other.Name] = newResourceSource(other)
+		t.sources[other.Name] = &resourceSource{resources: resources}
 		return nil
 	}
 }
 
+func (t *resourceCache) addResource(source, name string, res Resource) error {
+	rc, ok := t.sources[source]
+	if !ok {
+		rc = newResourceSource(nil, nil)
+		t.sources[source] = rc
+	}
+	if _, ok := rc.resources[name]; ok {
+		return fmt.Errorf("resource '%s' already exist in container for resource source '%s'", name, source)
+	}
+	rc.resources[name] = res
+	return nil
+}
+
+/**
+Returns asset names and the file system of the source, names of io/fs.FS or Dir are discovered by fs.WalkDir if not given
+*/
+
+func resourceAssets(source *ResourceSource) ([]string, http.FileSystem, error) {
+	if source.Archive != "" {
+		return archiveAssets(source)
+	}
+	fsys, recursive := source.FS, true
+	if source.Dir != "" {
+		if fsys != nil {
+			return nil, nil, fmt.Errorf("resource source '%s' has both FS and Dir", source.Name)
+		}
+		fsys, recursive = os.DirFS(source.Dir), source.Recursive
+	}
+	if fsys == nil {
+		return source.AssetNames, source.AssetFiles, nil
+	}
+	if source.Glob != "" {
+		if _, err := path.Match(source.Glob, ""); err != nil {
+			return nil, nil, fmt.Errorf("resource source '%s' glob '%s': %w", source.Name, source.Glob, err)
+		}
+	}
+	names := source.AssetNames
+	if len(names) == 0 {
+		err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if name != "." && !recursive {
+					return fs.SkipDir
+				}
+				return nil
+			}
+			if source.Glob != "" {
+				if ok, _ := path.Match(source.Glob, d.Name()); !ok {
+					return nil
+				}
+			}
+			names = append(names, name)
+			return nil
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("walk resource source '%s': %w", source.Name, err)
+		}
+	}
+	return names, http.FS(fsys), nil
+}
+
 func (t *resourceCache) findResource(source, name string) (Resource, bool) {
 	if src, ok := t.sources[source]; ok {
 		resource, ok := src.resources[name]
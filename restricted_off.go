@@ -0,0 +1,11 @@
+//go:build !glue_restricted
+
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+// restrictedBuild is true in builds with the 'glue_restricted' tag, so the compiler drops code of unavailable features.
+const restrictedBuild = false
@@ -0,0 +1,18 @@
+//go:build glue_restricted
+
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import "fmt"
+
+// restrictedBuild is true in builds with the 'glue_restricted' tag, so the compiler drops code of unavailable features.
+const restrictedBuild = true
+
+// openPlugin is not linked in builds with the 'glue_restricted' tag, the binary does not depend on the plugin package.
+func openPlugin(file, symbol string) ([]any, error) {
+	return nil, fmt.Errorf("open plugin '%s': %w", file, requireFeature(FeaturePlugins))
+}
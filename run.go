@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// DefaultShutdownTimeout is the drain timeout of Run if property 'glue.shutdown.timeout' is not defined.
+var DefaultShutdownTimeout = 30 * time.Second
+
+// ShutdownTimeoutProperty bounds stopping of runnables and servers by Run before the container is closed.
+var ShutdownTimeoutProperty = "glue.shutdown.timeout"
+
+/**
+Run starts Runnable and Server beans of the container, blocks until SIGINT or SIGTERM, then stops them
+within the drain timeout and closes the container. Runnable returning the error or Server failing also stops the application,
+the error is returned. The second signal during shutdown terminates the process by the default Go behavior.
+
+	ctn, err := glue.New(beans...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := glue.Run(ctn); err != nil {
+		log.Fatal(err)
+	}
+*/
+
+func Run(ctn Container) error {
+	return RunWithContext(context.Background(), ctn)
+}
+
+/**
+RunWithContext is the same as Run, cancellation of the context starts the shutdown as the signal does.
+*/
+
+func RunWithContext(ctx context.Context, ctn Container) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	logf := func(level LogLevel, format string, v ...any) {
+		if c, ok := ctn.(*container); ok {
+			c.logf(level, format, v...)
+		}
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		failures = make(chan error, 1)
+		servers  []Server
+	)
+	fail := func(err error) {
+		select {
+		case failures <- err:
+		default:
+		}
+	}
+	for _, b := range ctn.Bean(RunnableClass, 1) {
+		runnable := b.Object().(Runnable)
+		name := b.Name()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := runRecover(func() error { return runnable.Run(runCtx) }); err != nil && runCtx.Err() == nil {
+				fail(fmt.Errorf("runnable '%s': %w", name, err))
+			}
+		}()
+	}
+	for _, b := range ctn.Bean(ServerClass, 1) {
+		server := b.Object().(Server)
+		name := b.Name()
+		servers = append(servers, server)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := runRecover(server.Serve); err != nil && !errors.Is(err, http.ErrServerClosed) && runCtx.Err() == nil {
+				fail(fmt.Errorf("server '%s': %w", name, err))
+			}
+		}()
+	}
+	logf(LogInfo, "Run started, waiting for the signal\n")
+
+	var listErr []error
+	select {
+	case <-ctx.Done():
+		logf(LogInfo, "Shutdown, %v\n", ctx.Err())
+	case err := <-failures:
+		logf(LogError, "Shutdown on error, %v\n", err)
+		listErr = append(listErr, err)
+	}
+	// the next signal terminates the process
+	stop()
+
+	timeout := ctn.Properties().GetDuration(ShutdownTimeoutProperty, DefaultShutdownTimeout)
+	drainCtx, drainCancel := context.WithTimeout(context.Background(), timeout)
+	defer drainCancel()
+
+	cancel()
+	for _, server := range servers {
+		if err := server.Shutdown(drainCtx); err != nil {
+			listErr = append(listErr, fmt.Errorf("server shutdown: %w", err))
+		}
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-drainCtx.Done():
+		listErr = append(listErr, fmt.Errorf("runnables and servers did not stop in %v", timeout))
+	}
+
+	if err := ctn.CloseWithContext(drainCtx); err != nil {
+		listErr = append(listErr, err)
+	}
+	return multipleErr(listErr)
+}
+
+func runRecover(fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered with error: %v", r)
+		}
+	}()
+	return fn()
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type runTrace struct {
+	mu    sync.Mutex
+	trace []string
+}
+
+func (t *runTrace) add(s string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.trace = append(t.trace, s)
+}
+
+type consumerRunnable struct {
+	Trace   *runTrace `inject:""`
+	started chan struct{}
+}
+
+func (t *consumerRunnable) Run(ctx context.Context) error {
+	close(t.started)
+	<-ctx.Done()
+	t.Trace.add("consumer stopped")
+	return nil
+}
+
+type fakeServer struct {
+	Trace  *runTrace `inject:""`
+	closed chan struct{}
+}
+
+func (t *fakeServer) Serve() error {
+	<-t.closed
+	return http.ErrServerClosed
+}
+
+func (t *fakeServer) Shutdown(ctx context.Context) error {
+	t.Trace.add("server shutdown")
+	close(t.closed)
+	return nil
+}
+
+func (t *fakeServer) Destroy() error {
+	t.Trace.add("server destroyed")
+	return nil
+}
+
+type failingRunnable struct{}
+
+func (t *failingRunnable) Run(ctx context.Context) error {
+	return errors.New("queue is gone")
+}
+
+func TestRun(t *testing.T) {
+
+	trace := &runTrace{}
+	consumer := &consumerRunnable{started: make(chan struct{})}
+	ctn, err := glue.New(trace, consumer, &fakeServer{closed: make(chan struct{})})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-consumer.started
+		cancel()
+	}()
+	require.NoError(t, glue.RunWithContext(ctx, ctn))
+
+	require.ElementsMatch(t, []string{"consumer stopped", "server shutdown", "server destroyed"}, trace.trace)
+	require.Equal(t, "server destroyed", trace.trace[2])
+}
+
+func TestRunFailure(t *testing.T) {
+
+	ctn, err := glue.New(
+		glue.PropertySource{Map: map[string]any{"glue.shutdown.timeout": "1s"}},
+		&failingRunnable{},
+	)
+	require.NoError(t, err)
+
+	start := time.Now()
+	err = glue.Run(ctn)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "queue is gone")
+	require.Less(t, time.Since(start), time.Second)
+}
@@ -0,0 +1,417 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+var ErrSandboxTimeout = errors.New("sandboxed call timeout")
+
+var ErrSandboxPanic = errors.New("sandboxed call panic")
+
+var ErrSandboxRestricted = errors.New("operation is not allowed in sandbox")
+
+/**
+Guards applied to the sandboxed bean
+*/
+
+type SandboxOptions struct {
+
+	/**
+	Maximum duration of the method call, zero means no timeout.
+	The call that exceeded the timeout keeps running in background.
+	*/
+	CallTimeout time.Duration
+
+	/**
+	Called when the method of the sandboxed bean panics or exceeds the timeout
+	*/
+	OnFailure func(bean string, method string, err error)
+}
+
+type SandboxOption func(*SandboxOptions)
+
+func WithCallTimeout(timeout time.Duration) SandboxOption {
+	return func(opts *SandboxOptions) {
+		opts.CallTimeout = timeout
+	}
+}
+
+func WithSandboxFailureHandler(onFailure func(bean string, method string, err error)) SandboxOption {
+	return func(opts *SandboxOptions) {
+		opts.OnFailure = onFailure
+	}
+}
+
+/**
+Sandbox registers the untrusted bean, for example loaded from the plugin, with guards:
+  - calls through injected interfaces having registered proxy (see RegisterProxy) recover panics and respect CallTimeout
+  - injected glue.Container does not allow Close and Extend
+
+Failed calls return zero values and the error as the last result if the method returns error.
+*/
+
+func Sandbox(obj any, options ...SandboxOption) any {
+	opts := &SandboxOptions{}
+	for _, opt := range options {
+		if opt != nil {
+			opt(opts)
+		}
+	}
+	return wrapBean(obj, func(b *bean) {
+		b.sandbox = opts
+	})
+}
+
+func (t *container) applySandbox() error {
+	for _, beans := range t.core {
+		for _, b := range beans {
+			if b.sandbox == nil || b.obj == nil {
+				continue
+			}
+			if !hasSandbox {
+				return fmt.Errorf("sandbox of bean '%s': %w", b.name, requireFeature(FeatureSandbox))
+			}
+
+			t.restrictContainerFields(b)
+
+			for _, ifaceType := range proxyInterfaces(reflect.TypeOf(b.obj)) {
+				factory, _ := lookupProxy(ifaceType)
+				current := b.proxied(ifaceType)
+				proxy := factory(current, sandboxInterceptor(beanGraphName(b), reflect.TypeOf(b.obj), b.sandbox))
+				t.logf(LogInfo, "Sandbox bean '%s' behind %v proxy\n", b.name, ifaceType)
+				t.replaceInjectedFields(ifaceType, current, proxy)
+				b.setProxy(ifaceType, proxy)
+			}
+		}
+	}
+	return nil
+}
+
+/**
+Replaces injected interface fields, slice and map elements of the exact type that point to oldObj
+*/
+
+func (t *container) replaceInjectedFields(ifaceType reflect.Type, oldObj, newObj any) {
+	oldVal := reflect.ValueOf(oldObj)
+	for _, beans := range t.core {
+		for _, b := range beans {
+			if b.beanDef == nil || !b.valuePtr.IsValid() || b.valuePtr.Kind() != reflect.Ptr || b.valuePtr.IsNil() {
+				continue
+			}
+			structVal := b.valuePtr.Elem()
+			for _, f := range b.beanDef.fields {
+				if f.fieldType != ifaceType {
+					continue
+				}
+				field := b.injectedField(structVal, f)
+				if !field.CanSet() {
+					continue
+				}
+				replaced := false
+				switch {
+				case f.isSlice:
+					for i := 0; i < field.Len(); i++ {
+						if elem := field.Index(i); sameObject(elem, oldVal) {
+							elem.Set(reflect.ValueOf(newObj))
+							replaced = true
+						}
+					}
+				case f.isMap:
+					iter := field.MapRange()
+					for iter.Next() {
+						if elem := iter.Value(); sameObject(elem, oldVal) {
+							field.SetMapIndex(iter.Key(), reflect.ValueOf(newObj))
+							replaced = true
+						}
+					}
+				case sameObject(field, oldVal):
+					field.Set(reflect.ValueOf(newObj))
+					replaced = true
+				}
+				if replaced {
+					b.updateSetterField(f.fieldNum)
+				}
+			}
+		}
+	}
+}
+
+/**
+Returns true if the interface value holds the pointer oldVal, values of other kinds are never replaced
+*/
+
+func sameObject(iface reflect.Value, oldVal reflect.Value) bool {
+	if iface.IsNil() || oldVal.Kind() != reflect.Ptr {
+		return false
+	}
+	elem := iface.Elem()
+	return elem.Kind() == reflect.Ptr && elem.Pointer() == oldVal.Pointer()
+}
+
+/**
+Wraps Container fields of the bean, injected ones including fields of embedded structs and setter shadows,
+setters are called again with the wrapped container
+*/
+
+func (t *container) restrictContainerFields(b *bean) {
+	if !b.valuePtr.IsValid() || b.valuePtr.Kind() != reflect.Ptr || b.valuePtr.Elem().Kind() != reflect.Struct {
+		return
+	}
+	structVal := b.valuePtr.Elem()
+	if b.beanDef != nil {
+		for _, f := range b.beanDef.fields {
+			if f.fieldType != ContainerClass || f.isSlice || f.isMap {
+				continue
+			}
+			if restrictContainerValue(b.injectedField(structVal, f)) {
+				b.updateSetterField(f.fieldNum)
+			}
+		}
+	}
+	restrictStructFields(structVal)
+}
+
+func restrictStructFields(structVal reflect.Value) {
+	for i := 0; i < structVal.NumField(); i++ {
+		restrictContainerValue(structVal.Field(i))
+	}
+}
+
+func restrictContainerValue(field reflect.Value) bool {
+	if field.Type() != ContainerClass || !field.CanSet() || field.IsNil() {
+		return false
+	}
+	if _, restricted := field.Interface().(*sandboxContainer); restricted {
+		return false
+	}
+	field.Set(reflect.ValueOf(restrictContainer(field.Interface().(Container))))
+	return true
+}
+
+func restrictContainer(ctn Container) Container {
+	if _, restricted := ctn.(*sandboxContainer); restricted {
+		return ctn
+	}
+	return &sandboxContainer{Container: ctn}
+}
+
+func sandboxInterceptor(beanName string, targetType reflect.Type, opts *SandboxOptions) MethodInterceptor {
+	return func(method string, args []reflect.Value, next func([]reflect.Value) []reflect.Value) []reflect.Value {
+
+		type result struct {
+			out []reflect.Value
+			err error
+		}
+
+		call := func() (r result) {
+			defer func() {
+				if rec := recover(); rec != nil {
+					r.err = fmt.Errorf("%w: bean '%s' method '%s': %v", ErrSandboxPanic, beanName, method, rec)
+				}
+			}()
+			return result{out: next(args)}
+		}
+
+		var r result
+		if opts.CallTimeout <= 0 {
+			r = call()
+		} else {
+			ch := make(chan result, 1)
+			go func() {
+				ch <- call()
+			}()
+			timer := time.NewTimer(opts.CallTimeout)
+			select {
+			case r = <-ch:
+			case <-timer.C:
+				r.err = fmt.Errorf("%w: bean '%s' method '%s' exceeded %v", ErrSandboxTimeout, beanName, method, opts.CallTimeout)
+			}
+			timer.Stop()
+		}
+
+		if r.err == nil {
+			return r.out
+		}
+		if opts.OnFailure != nil {
+			opts.OnFailure(beanName, method, r.err)
+		}
+		return sandboxFailure(targetType, method, r.err)
+	}
+}
+
+/**
+Returns zero results of the method and the error as the last result if the method returns error
+*/
+
+func sandboxFailure(targetType reflect.Type, method string, err error) []reflect.Value {
+	m, ok := targetType.MethodByName(method)
+	if !ok {
+		return nil
+	}
+	n := m.Type.NumOut()
+	out := make([]reflect.Value, n)
+	for i := 0; i < n; i++ {
+		out[i] = reflect.Zero(m.Type.Out(i))
+	}
+	if n > 0 && m.Type.Out(n-1) == errorType {
+		out[n-1] = reflect.ValueOf(&err).Elem()
+	}
+	return out
+}
+
+/**
+Container view given to sandboxed beans
+*/
+
+type sandboxContainer struct {
+	Container
+}
+
+/**
+Bean of the container found by the sandboxed bean, the object is the restricted view
+*/
+
+type sandboxBean struct {
+	Bean
+	ctn Container
+}
+
+func (t *sandboxBean) Object() any {
+	return t.ctn
+}
+
+func restrictBeans(list []Bean) []Bean {
+	for i, b := range list {
+		if ctn, ok := b.Object().(Container); ok {
+			if _, restricted := b.(*sandboxBean); !restricted {
+				list[i] = &sandboxBean{Bean: b, ctn: restrictContainer(ctn)}
+			}
+		}
+	}
+	return list
+}
+
+func (t *sandboxContainer) Parent() (Container, bool) {
+	parent, ok := t.Container.Parent()
+	if !ok {
+		return nil, false
+	}
+	return restrictContainer(parent), true
+}
+
+func (t *sandboxContainer) Bean(typ reflect.Type, level int) []Bean {
+	return restrictBeans(t.Container.Bean(typ, level))
+}
+
+func (t *sandboxContainer) Single(typ reflect.Type) (Bean, error) {
+	b, err := t.Container.Single(typ)
+	if err != nil {
+		return nil, err
+	}
+	return restrictBeans([]Bean{b})[0], nil
+}
+
+func (t *sandboxContainer) Lookup(name string, level int) []Bean {
+	return restrictBeans(t.Container.Lookup(name, level))
+}
+
+func (t *sandboxContainer) Inject(obj any) error {
+	if err := t.Container.Inject(obj); err != nil {
+		return err
+	}
+	value := reflect.ValueOf(obj)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	if bd, err := cachedBeanDef(value.Type()); err == nil {
+		for _, f := range bd.fields {
+			if f.fieldType == ContainerClass && !f.isSlice && !f.isMap {
+				restrictContainerValue(f.field(value.Elem()))
+			}
+		}
+	}
+	restrictStructFields(value.Elem())
+	return nil
+}
+
+func (t *sandboxContainer) Extend(scan ...any) (Container, error) {
+	return nil, fmt.Errorf("%w: Extend", ErrSandboxRestricted)
+}
+
+func (t *sandboxContainer) ExtendWithOptions(options ...ContainerOption) (Container, error) {
+	return nil, fmt.Errorf("%w: ExtendWithOptions", ErrSandboxRestricted)
+}
+
+func (t *sandboxContainer) ExtendWithContext(ctx context.Context, scan ...any) (Container, error) {
+	return nil, fmt.Errorf("%w: ExtendWithContext", ErrSandboxRestricted)
+}
+
+func (t *sandboxContainer) CloneWith(props map[string]string) (Container, error) {
+	return nil, fmt.Errorf("%w: CloneWith", ErrSandboxRestricted)
+}
+
+func (t *sandboxContainer) Children() []ChildContainer {
+	// child containers are created on demand, so they are not visible in sandbox
+	return nil
+}
+
+func (t *sandboxContainer) Find(path string) (ChildContainer, error) {
+	return nil, fmt.Errorf("%w: Find", ErrSandboxRestricted)
+}
+
+func (t *sandboxContainer) Register(obj any) (Bean, error) {
+	return nil, fmt.Errorf("%w: Register", ErrSandboxRestricted)
+}
+
+func (t *sandboxContainer) RegisterWithContext(ctx context.Context, obj any) (Bean, error) {
+	return nil, fmt.Errorf("%w: RegisterWithContext", ErrSandboxRestricted)
+}
+
+func (t *sandboxContainer) Unregister(name string) error {
+	return fmt.Errorf("%w: Unregister", ErrSandboxRestricted)
+}
+
+func (t *sandboxContainer) UnregisterWithContext(ctx context.Context, name string) error {
+	return fmt.Errorf("%w: UnregisterWithContext", ErrSandboxRestricted)
+}
+
+func (t *sandboxContainer) Refresh(types ...reflect.Type) error {
+	return fmt.Errorf("%w: Refresh", ErrSandboxRestricted)
+}
+
+func (t *sandboxContainer) RefreshWithContext(ctx context.Context, types ...reflect.Type) error {
+	return fmt.Errorf("%w: RefreshWithContext", ErrSandboxRestricted)
+}
+
+func (t *sandboxContainer) Reload(bean Bean) error {
+	return fmt.Errorf("%w: Reload", ErrSandboxRestricted)
+}
+
+func (t *sandboxContainer) ReloadWithContext(ctx context.Context, bean Bean) error {
+	return fmt.Errorf("%w: ReloadWithContext", ErrSandboxRestricted)
+}
+
+func (t *sandboxContainer) OnClose(fn func()) {
+	// cleanup of the container is decided by its owner
+}
+
+func (t *sandboxContainer) Seal() {
+	// sealing is decided by the owner of the container
+}
+
+func (t *sandboxContainer) Close() error {
+	return fmt.Errorf("%w: Close", ErrSandboxRestricted)
+}
+
+func (t *sandboxContainer) CloseWithContext(ctx context.Context) error {
+	return fmt.Errorf("%w: CloseWithContext", ErrSandboxRestricted)
+}
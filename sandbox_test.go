@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type PluginHandler interface {
+	Handle(input string) (string, error)
+	Name() string
+}
+
+// hand-written equivalent of the gluegen proxy
+type pluginHandlerProxy struct {
+	DoHandle func(input string) (string, error)
+	DoName   func() string
+}
+
+func (p *pluginHandlerProxy) Handle(input string) (string, error) {
+	return p.DoHandle(input)
+}
+
+func (p *pluginHandlerProxy) Name() string {
+	return p.DoName()
+}
+
+func init() {
+	glue.RegisterProxy(reflect.TypeOf((*PluginHandler)(nil)).Elem(), func(target any, interceptor glue.MethodInterceptor) any {
+		impl := target.(PluginHandler)
+		proxy := &pluginHandlerProxy{DoHandle: impl.Handle, DoName: impl.Name}
+		v := reflect.ValueOf(proxy).Elem()
+		for _, name := range []string{"Handle", "Name"} {
+			field := v.FieldByName("Do" + name)
+			orig := reflect.ValueOf(field.Interface())
+			method := name
+			field.Set(reflect.MakeFunc(field.Type(), func(args []reflect.Value) []reflect.Value {
+				return interceptor(method, args, orig.Call)
+			}))
+		}
+		return proxy
+	})
+}
+
+type untrustedPlugin struct {
+	Ctn glue.Container `inject:""`
+}
+
+func (t *untrustedPlugin) Handle(input string) (string, error) {
+	switch input {
+	case "panic":
+		panic("plugin bug")
+	case "slow":
+		time.Sleep(time.Second)
+	}
+	return "handled " + input, nil
+}
+
+func (t *untrustedPlugin) Name() string {
+	panic("no name")
+}
+
+type pluginHost struct {
+	Handler PluginHandler `inject:""`
+}
+
+func TestSandboxedBean(t *testing.T) {
+
+	skipUnsupported(t, glue.FeatureSandbox)
+
+	plugin := &untrustedPlugin{}
+	host := &pluginHost{}
+	var failures []string
+
+	ctn, err := glue.New(
+		glue.Sandbox(plugin,
+			glue.WithCallTimeout(50*time.Millisecond),
+			glue.WithSandboxFailureHandler(func(bean, method string, err error) {
+				failures = append(failures, method)
+			}),
+		),
+		host,
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.NotSame(t, plugin, host.Handler)
+
+	out, err := host.Handler.Handle("ok")
+	require.NoError(t, err)
+	require.Equal(t, "handled ok", out)
+
+	out, err = host.Handler.Handle("panic")
+	require.True(t, errors.Is(err, glue.ErrSandboxPanic))
+	require.Contains(t, err.Error(), "plugin bug")
+	require.Equal(t, "", out)
+
+	_, err = host.Handler.Handle("slow")
+	require.True(t, errors.Is(err, glue.ErrSandboxTimeout))
+
+	require.Equal(t, "", host.Handler.Name())
+	require.Equal(t, []string{"Handle", "Handle", "Name"}, failures)
+
+	// restricted container
+	require.True(t, errors.Is(plugin.Ctn.Close(), glue.ErrSandboxRestricted))
+	_, err = plugin.Ctn.Extend()
+	require.True(t, errors.Is(err, glue.ErrSandboxRestricted))
+	_, err = plugin.Ctn.ExtendWithContext(context.Background())
+	require.True(t, errors.Is(err, glue.ErrSandboxRestricted))
+	_, err = plugin.Ctn.Register(&pluginHost{})
+	require.True(t, errors.Is(err, glue.ErrSandboxRestricted))
+	require.True(t, errors.Is(plugin.Ctn.Unregister("host"), glue.ErrSandboxRestricted))
+	require.True(t, errors.Is(plugin.Ctn.Refresh(), glue.ErrSandboxRestricted))
+	_, err = plugin.Ctn.Find("child")
+	require.True(t, errors.Is(err, glue.ErrSandboxRestricted))
+	require.True(t, errors.Is(plugin.Ctn.RefreshWithContext(context.Background()), glue.ErrSandboxRestricted))
+	hostBean, err := ctn.Single(reflect.TypeOf(host))
+	require.NoError(t, err)
+	require.True(t, errors.Is(plugin.Ctn.Reload(hostBean), glue.ErrSandboxRestricted))
+	require.True(t, errors.Is(plugin.Ctn.ReloadWithContext(context.Background(), hostBean), glue.ErrSandboxRestricted))
+	plugin.Ctn.Seal()
+	require.False(t, ctn.Sealed())
+	require.Equal(t, 1, len(plugin.Ctn.Bean(reflect.TypeOf(host), glue.DefaultSearchLevel)))
+
+	// the container found by the sandboxed bean is restricted as well
+	found, err := plugin.Ctn.Single(glue.ContainerClass)
+	require.NoError(t, err)
+	require.True(t, errors.Is(found.Object().(glue.Container).Close(), glue.ErrSandboxRestricted))
+	for _, b := range plugin.Ctn.Bean(glue.ContainerClass, glue.DefaultSearchLevel) {
+		require.True(t, errors.Is(b.Object().(glue.Container).Close(), glue.ErrSandboxRestricted))
+	}
+	injected := &struct {
+		Ctn glue.Container `inject:""`
+	}{}
+	require.NoError(t, plugin.Ctn.Inject(injected))
+	require.True(t, errors.Is(injected.Ctn.Close(), glue.ErrSandboxRestricted))
+}
+
+type sandboxDeps struct {
+	Ctn glue.Container `inject:""`
+}
+
+type sandboxedInjections struct {
+	sandboxDeps
+	ctn    glue.Container `inject:""`
+	closed bool
+}
+
+func (t *sandboxedInjections) SetCtn(ctn glue.Container) {
+	t.ctn = ctn
+}
+
+func TestSandboxedInjections(t *testing.T) {
+
+	skipUnsupported(t, glue.FeatureSandbox)
+	skipUnsupported(t, glue.FeatureSetters)
+
+	parent, err := glue.New()
+	require.NoError(t, err)
+	defer parent.Close()
+
+	plugin := &sandboxedInjections{}
+	ctn, err := parent.Extend(glue.Sandbox(plugin))
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	// embedded struct and setter receive the restricted container
+	require.True(t, errors.Is(plugin.Ctn.Close(), glue.ErrSandboxRestricted))
+	require.True(t, errors.Is(plugin.ctn.Close(), glue.ErrSandboxRestricted))
+
+	// OnClose does not hook the real container
+	plugin.ctn.OnClose(func() { plugin.closed = true })
+
+	// parent is restricted too
+	p, ok := plugin.ctn.Parent()
+	require.True(t, ok)
+	require.True(t, errors.Is(p.Close(), glue.ErrSandboxRestricted))
+	_, err = p.Extend()
+	require.True(t, errors.Is(err, glue.ErrSandboxRestricted))
+
+	require.NoError(t, ctn.Close())
+	require.False(t, plugin.closed)
+}
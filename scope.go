@@ -7,6 +7,8 @@ package glue
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"reflect"
 	"sync"
 )
@@ -16,6 +18,7 @@ type requestScope struct {
 	instances   map[reflect.Type]any
 	disposables []any
 	closeOnce   sync.Once
+	container   *container // nil if the scope is not bound to the container
 }
 
 // NewRequestScope creates a new empty request scope.
@@ -38,10 +41,75 @@ func RequestScopeFromContext(ctx context.Context) (RequestScope, bool) {
 	return scope, ok
 }
 
+func (t *container) NewRequestScope() RequestScope {
+	return &requestScope{
+		instances: make(map[reflect.Type]any),
+		container: t,
+	}
+}
+
+/**
+GetScopedBean returns the request scoped bean of the type from the scope of the context,
+e.g. session, err := glue.GetScopedBean[*Session](r.Context()) in the handler behind RequestScopeHandler.
+*/
+
+func GetScopedBean[T any](ctx context.Context) (T, error) {
+	var zero T
+	typ := beanType[T]()
+	scope, ok := RequestScopeFromContext(ctx)
+	if !ok {
+		return zero, fmt.Errorf("no RequestScope found in context for scoped bean '%v'", typ)
+	}
+	obj, err := scope.Resolve(ctx, typ)
+	if err != nil {
+		return zero, err
+	}
+	value, ok := obj.(T)
+	if !ok {
+		return zero, fmt.Errorf("scoped bean '%s' of type '%T' cannot be converted to '%s'", typ, obj, typ)
+	}
+	return value, nil
+}
+
+/**
+RequestScopeHandler is the HTTP middleware that creates the request scope of the container for every request,
+puts it in the request context and closes it when the handler returns.
+*/
+
+func RequestScopeHandler(ctn Container, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scope := ctn.NewRequestScope()
+		defer scope.CloseWithContext(r.Context())
+		next.ServeHTTP(w, r.WithContext(WithRequestScope(r.Context(), scope)))
+	})
+}
+
+func (rs *requestScope) Resolve(ctx context.Context, typ reflect.Type) (any, error) {
+	if rs.container == nil {
+		return nil, fmt.Errorf("request scope is not bound to the container, create it by Container.NewRequestScope to resolve '%v'", typ)
+	}
+	candidates := orderBeans(levelBeans(rs.container.getBean(typ), DefaultSearchLevel))
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("scoped bean '%v' not found", typ)
+	}
+	if len(candidates) > 1 {
+		return nil, fmt.Errorf("scoped bean '%v' is ambiguous", typ)
+	}
+	if _, ok := RequestScopeFromContext(ctx); !ok {
+		ctx = WithRequestScope(ctx, rs)
+	}
+	return rs.getOrCreate(typ, func() (any, error) {
+		return createScopedInstance(ctx, rs.container, candidates[0])
+	})
+}
+
 // getOrCreate returns the cached instance for the given type, or calls create to make one.
 func (rs *requestScope) getOrCreate(typ reflect.Type, create func() (any, error)) (any, error) {
 	rs.mu.Lock()
 	defer rs.mu.Unlock()
+	if rs.instances == nil {
+		rs.instances = make(map[reflect.Type]any)
+	}
 	if inst, ok := rs.instances[typ]; ok {
 		return inst, nil
 	}
@@ -70,22 +138,29 @@ func (rs *requestScope) CloseWithContext(ctx context.Context) (err error) {
 	var listErr []error
 
 	rs.closeOnce.Do(func() {
-		rs.mu.Lock()
-		disposables := append([]any(nil), rs.disposables...)
-		rs.mu.Unlock()
-
-		for i := len(disposables) - 1; i >= 0; i-- {
-			if dis, ok := disposables[i].(ContextDisposableBean); ok {
-				if e := dis.Destroy(ctx); e != nil {
-					listErr = append(listErr, e)
-				}
-			} else if dis, ok := disposables[i].(DisposableBean); ok {
-				if e := dis.Destroy(); e != nil {
-					listErr = append(listErr, e)
-				}
-			}
-		}
+		listErr = rs.destroy(ctx)
 	})
 
 	return multipleErr(listErr)
 }
+
+func (rs *requestScope) destroy(ctx context.Context) []error {
+	var listErr []error
+
+	rs.mu.Lock()
+	disposables := append([]any(nil), rs.disposables...)
+	rs.mu.Unlock()
+
+	for i := len(disposables) - 1; i >= 0; i-- {
+		if dis, ok := disposables[i].(ContextDisposableBean); ok {
+			if e := dis.Destroy(ctx); e != nil {
+				listErr = append(listErr, e)
+			}
+		} else if dis, ok := disposables[i].(DisposableBean); ok {
+			if e := dis.Destroy(); e != nil {
+				listErr = append(listErr, e)
+			}
+		}
+	}
+	return listErr
+}
@@ -0,0 +1,333 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+/**
+Pre-analyzed scope bean: parsed definition and container candidates of every injected field,
+resolved once per type since beans of the container never change after creation
+*/
+
+type scopeTemplate struct {
+	bd     *beanDef
+	fields []scopeField
+}
+
+type scopeField struct {
+	def *injectionDef
+
+	/**
+	Container candidates with levels shifted by one, the scope is the first level
+	*/
+	deep []beanlist
+
+	/**
+	Provider function of scope=prototype and scope=request fields, shared by all scopes
+	*/
+	provider reflect.Value
+}
+
+/**
+Only internal buffers are pooled, the scope itself is visible to callers and may outlive Close
+*/
+
+var scopeBufferPool = sync.Pool{
+	New: func() any {
+		return &scopeBuffers{}
+	},
+}
+
+type scopeBuffers struct {
+	beans       []bean
+	disposables []any
+	instances   map[reflect.Type]any
+}
+
+type scopedContext struct {
+	requestScope
+
+	/**
+	Scope-local beans in the scan order, the slice is taken from pooled buffers
+	*/
+	beans []bean
+
+	buffers *scopeBuffers
+}
+
+func (t *container) NewScope(scan ...any) (ScopedContext, error) {
+	return t.NewScopeWithContext(context.Background(), scan...)
+}
+
+func (t *container) NewScopeWithContext(ctx context.Context, scan ...any) (ScopedContext, error) {
+	buffers := scopeBufferPool.Get().(*scopeBuffers)
+	scope := &scopedContext{buffers: buffers}
+	scope.container = t
+	scope.disposables, scope.instances = buffers.disposables, buffers.instances
+	if cap(buffers.beans) < len(scan) {
+		buffers.beans = make([]bean, len(scan))
+	}
+	scope.beans = buffers.beans[:len(scan)]
+
+	for i, obj := range scan {
+		if err := scope.initBean(&scope.beans[i], obj); err != nil {
+			scope.release()
+			return nil, err
+		}
+	}
+
+	for i := range scope.beans {
+		if err := scope.injectBean(ctx, &scope.beans[i]); err != nil {
+			scope.release()
+			return nil, err
+		}
+	}
+
+	ctx = WithRequestScope(ctx, scope)
+	for i := range scope.beans {
+		b := &scope.beans[i]
+		if err := callPostConstruct(ctx, b.obj); err != nil {
+			// destroys beans constructed before
+			scope.CloseWithContext(ctx)
+			return nil, fmt.Errorf("scope bean '%v' %w", b.beanDef.classPtr, err)
+		}
+		scope.addDisposable(b.obj)
+	}
+	return scope, nil
+}
+
+func (t *scopedContext) initBean(b *bean, obj any) error {
+	if obj == nil {
+		return errors.New("null scope beans are not allowed")
+	}
+	classPtr := reflect.TypeOf(obj)
+	if classPtr.Kind() != reflect.Ptr || classPtr.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("scope bean '%v' must be pointer to struct", classPtr)
+	}
+	tpl, err := t.container.scopeTemplate(classPtr)
+	if err != nil {
+		return err
+	}
+	valuePtr := reflect.ValueOf(obj)
+	tpl.bd.applyStubs(valuePtr.Elem())
+
+	name := classPtr.String()
+	if namedBean, ok := obj.(NamedBean); ok {
+		name = namedBean.BeanName()
+	}
+	*b = bean{
+		name:      name,
+		obj:       obj,
+		valuePtr:  valuePtr,
+		beanDef:   tpl.bd,
+		lifecycle: BeanInitialized,
+	}
+	return nil
+}
+
+/**
+Scope-local beans take precedence over beans of the container, the same way as beans of the child container
+*/
+
+func (t *scopedContext) injectBean(ctx context.Context, b *bean) error {
+	tpl, err := t.container.scopeTemplate(b.beanDef.classPtr)
+	if err != nil {
+		return err
+	}
+	value := b.valuePtr.Elem()
+	for _, f := range tpl.fields {
+		if f.def.getter {
+			if err := t.container.bindGetter(value, f.def, nil); err != nil {
+				return err
+			}
+			continue
+		}
+		if f.provider.IsValid() {
+			if err := f.setProvider(value); err != nil {
+				return err
+			}
+			continue
+		}
+		deep := f.deep
+		if local := t.localBeans(f.def.fieldType, b); len(local) > 0 {
+			deep = append([]beanlist{{level: 1, list: local}}, f.deep...)
+		}
+		if len(deep) == 0 {
+			if f.def.optional {
+				continue
+			}
+			return fmt.Errorf("implementation not found for field '%s' with type '%v'", f.def.fieldName, f.def.fieldType)
+		}
+		if err := f.def.inject(ctx, t.container, &value, deep); err != nil {
+			return err
+		}
+	}
+	for _, inject := range tpl.bd.properties {
+		if err := inject.inject(&value, t.container.properties, &t.container.valueDefaults); err != nil {
+			return err
+		}
+	}
+	for _, def := range tpl.bd.resources {
+		if err := t.container.injectResource(&value, def); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *scopedContext) localBeans(typ reflect.Type, self *bean) []*bean {
+	var list []*bean
+	for i := range t.beans {
+		b := &t.beans[i]
+		if b == self {
+			continue
+		}
+		classPtr := b.beanDef.classPtr
+		if classPtr == typ || (typ.Kind() == reflect.Interface && classPtr.Implements(typ)) {
+			list = append(list, b)
+		}
+	}
+	return list
+}
+
+func (t *container) scopeTemplate(classPtr reflect.Type) (*scopeTemplate, error) {
+	if tpl, ok := t.scopeTemplates.Load(classPtr); ok {
+		return tpl.(*scopeTemplate), nil
+	}
+	bd, err := cachedBeanDef(classPtr)
+	if err != nil {
+		return nil, err
+	}
+	tpl := &scopeTemplate{bd: bd, fields: make([]scopeField, len(bd.fields))}
+	for i, def := range bd.fields {
+		f := scopeField{def: def}
+		if def.scope != ScopeSingleton {
+			if err := f.initProvider(t); err != nil {
+				return nil, err
+			}
+		} else {
+			for _, entry := range t.getBean(def.fieldType) {
+				f.deep = append(f.deep, beanlist{level: entry.level + 1, list: entry.list})
+			}
+		}
+		tpl.fields[i] = f
+	}
+	actual, _ := t.scopeTemplates.LoadOrStore(classPtr, tpl)
+	return actual.(*scopeTemplate), nil
+}
+
+func (t *scopeField) initProvider(ctn *container) error {
+	var list []*bean
+	if deep := ctn.getBean(t.def.scopeReturnType); len(deep) > 0 {
+		list = t.def.filterBeans(orderBeans(levelBeans(deep, t.def.level)))
+	}
+	if len(list) == 0 {
+		if t.def.optional {
+			return nil
+		}
+		return t.def.missing(nil)
+	}
+	impl, err := selectSingleCandidate(t.def.fieldName, t.def.class, list)
+	if err != nil {
+		return err
+	}
+	t.provider = reflect.New(t.def.fieldType).Elem()
+	t.def.injectScopeProvider(t.provider, impl, ctn)
+	return nil
+}
+
+func (t *scopeField) setProvider(value reflect.Value) error {
+	field := t.def.field(value)
+	if field.CanSet() {
+		field.Set(t.provider)
+		return nil
+	}
+	if !hasSetters {
+		return requireFeature(FeatureSetters)
+	}
+	set, ok := findSetter(value.Addr(), t.def.fieldName, field.Type())
+	if !ok {
+		return notPublicErr(t.def.fieldName, t.def.class)
+	}
+	return set(t.provider)
+}
+
+func callPostConstruct(ctx context.Context, obj any) error {
+	if init, ok := obj.(ContextInitializingBean); ok {
+		if err := init.PostConstruct(ctx); err != nil {
+			return fmt.Errorf("PostConstruct(ctx) failed: %w", err)
+		}
+	} else if init, ok := obj.(InitializingBean); ok {
+		if err := init.PostConstruct(); err != nil {
+			return fmt.Errorf("PostConstruct failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (t *scopedContext) Container() Container {
+	return t.container
+}
+
+func (t *scopedContext) Context(parent context.Context) context.Context {
+	return WithRequestScope(parent, t)
+}
+
+func (t *scopedContext) Close() error {
+	return t.CloseWithContext(context.Background())
+}
+
+/**
+Destroys scope-local beans and request scoped instances in reverse order and returns buffers of the scope to the pool
+*/
+
+func (t *scopedContext) CloseWithContext(ctx context.Context) error {
+	var listErr []error
+	closed := false
+	t.closeOnce.Do(func() {
+		closed = true
+		listErr = t.destroy(ctx)
+	})
+	if closed {
+		t.release()
+	}
+	return multipleErr(listErr)
+}
+
+/**
+Clears references and returns allocated slices and the map to the pool, the scope does not refer to them anymore,
+so the stale scope never sees beans of the next one
+*/
+
+func (t *scopedContext) release() {
+	t.mu.Lock()
+	beans, disposables, instances := t.beans, t.disposables, t.instances
+	t.beans, t.disposables, t.instances = nil, nil, nil
+	t.mu.Unlock()
+
+	buffers := t.buffers
+	if buffers == nil {
+		return
+	}
+	t.buffers = nil
+	for i := range beans {
+		beans[i] = bean{}
+	}
+	for i := range disposables {
+		disposables[i] = nil
+	}
+	for typ := range instances {
+		delete(instances, typ)
+	}
+	buffers.beans, buffers.disposables, buffers.instances = beans[:0], disposables[:0], instances
+	scopeBufferPool.Put(buffers)
+}
@@ -8,6 +8,8 @@ package glue_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"sync/atomic"
 	"testing"
@@ -51,6 +53,8 @@ type protoConsumer struct {
 }
 
 func TestPrototypeScope(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	atomic.StoreInt32(&protoWorkerIDSeq, 0)
 
 	consumer := &protoConsumer{}
@@ -81,6 +85,8 @@ type protoConsumerWithCtx struct {
 }
 
 func TestPrototypeScopeWithContext(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	atomic.StoreInt32(&protoWorkerIDSeq, 0)
 
 	consumer := &protoConsumerWithCtx{}
@@ -163,6 +169,8 @@ type requestScopedLifecycleConsumer struct {
 }
 
 func TestRequestScope(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	atomic.StoreInt32(&requestSessionSeq, 0)
 
 	consumer := &requestConsumer{}
@@ -200,7 +208,45 @@ func TestRequestScope(t *testing.T) {
 	require.NotSame(t, s1a, s2)
 }
 
+func TestRequestScopeFromContext(t *testing.T) {
+
+	skipUnsupported(t, glue.FeatureProviders)
+
+	consumer := &requestConsumer{}
+	ctn, err := glue.New(&requestSessionFactory{}, consumer)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	var sessions []*requestSession
+	handler := glue.RequestScopeHandler(ctn, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, ok := glue.RequestScopeFromContext(r.Context())
+		require.True(t, ok)
+
+		first, err := glue.GetScopedBean[*requestSession](r.Context())
+		require.NoError(t, err)
+		second, err := consumer.GetSession(r.Context())
+		require.NoError(t, err)
+		require.Same(t, first, second)
+		sessions = append(sessions, first)
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	require.Equal(t, 2, len(sessions))
+	require.NotSame(t, sessions[0], sessions[1])
+
+	_, err = glue.GetScopedBean[*requestSession](context.Background())
+	require.Error(t, err)
+
+	unbound := glue.WithRequestScope(context.Background(), glue.NewRequestScope())
+	_, err = glue.GetScopedBean[*requestSession](unbound)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not bound to the container")
+}
+
 func TestRequestScopeNoContext(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	atomic.StoreInt32(&requestSessionSeq, 0)
 
 	consumer := &requestConsumer{}
@@ -218,6 +264,8 @@ func TestRequestScopeNoContext(t *testing.T) {
 }
 
 func TestRequestScopeCloseWithContext(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	consumer := &requestScopedLifecycleConsumer{}
 	ctn, err := glue.New(
 		&requestScopedLifecycleBean{},
@@ -342,6 +390,8 @@ type ifaceProtoConsumer struct {
 }
 
 func TestPrototypeScopeInterface(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	atomic.StoreInt32(&workerSeq, 0)
 
 	consumer := &ifaceProtoConsumer{}
@@ -389,6 +439,8 @@ type handlerConsumer struct {
 }
 
 func TestPrototypeScopeNonFactory_FieldInjectionAndContextInit(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	cfg := &sharedConfig{Value: "test-config"}
 	consumer := &handlerConsumer{}
 
@@ -449,6 +501,8 @@ type requestLoggerConsumer struct {
 }
 
 func TestRequestScopeClassicalBean(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	cfg := &sharedConfig{Value: "prod"}
 	consumer := &requestLoggerConsumer{}
 
@@ -535,6 +589,8 @@ type ctxSessionConsumer struct {
 }
 
 func TestRequestScopeWithContextFactoryBean(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	consumer := &ctxSessionConsumer{}
 	ctn, err := glue.New(
 		&ctxSessionFactory{},
@@ -573,3 +629,142 @@ func TestRequestScopeWithContextFactoryBean(t *testing.T) {
 	require.NotSame(t, s1, s2)
 	require.Equal(t, "trace-xyz", s2.TraceID)
 }
+
+// --- NewScope tests ---
+
+type scopeRepo struct {
+	destroyed bool
+}
+
+func (t *scopeRepo) Destroy() error {
+	t.destroyed = true
+	return nil
+}
+
+type scopeTx struct {
+	Repo      *scopeRepo `inject:""`
+	destroyed int
+}
+
+func (t *scopeTx) Destroy() error {
+	t.destroyed++
+	return nil
+}
+
+type scopeHandler struct {
+	Repo       *scopeRepo                                     `inject:""`
+	Tx         *scopeTx                                       `inject:""`
+	GetSession func(context.Context) (*requestSession, error) `inject:"scope=request"`
+	Limit      int                                            `value:"scope.limit,default=10"`
+
+	session *requestSession
+}
+
+func (t *scopeHandler) PostConstruct(ctx context.Context) (err error) {
+	t.session, err = t.GetSession(ctx)
+	return
+}
+
+func TestNewScope(t *testing.T) {
+
+	repo := &scopeRepo{}
+	ctn, err := glue.New(
+		repo,
+		&requestSessionFactory{},
+		glue.MapPropertySource{"scope.limit": "5"},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	for i := 0; i < 3; i++ {
+		tx := &scopeTx{}
+		handler := &scopeHandler{}
+		scope, err := ctn.NewScope(handler, tx)
+		require.NoError(t, err)
+
+		require.Same(t, repo, handler.Repo)
+		require.Same(t, repo, tx.Repo)
+		require.Same(t, tx, handler.Tx)
+		require.Equal(t, 5, handler.Limit)
+		require.NotNil(t, handler.session)
+
+		// request scoped instance is cached in the scope
+		session, err := glue.GetScopedBean[*requestSession](scope.Context(context.Background()))
+		require.NoError(t, err)
+		require.Same(t, handler.session, session)
+
+		require.NoError(t, scope.Close())
+		require.Equal(t, 1, tx.destroyed)
+	}
+	require.False(t, repo.destroyed)
+
+	_, err = ctn.NewScope(scopeTx{})
+	require.Error(t, err)
+
+	_, err = ctn.NewScope(&scopeHandler{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Tx")
+}
+
+func TestNewScopeStaleHandle(t *testing.T) {
+
+	ctn, err := glue.New(&scopeRepo{}, &requestSessionFactory{})
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	stale, err := ctn.NewScope(&scopeTx{})
+	require.NoError(t, err)
+	staleCtx := stale.Context(context.Background())
+	require.NoError(t, stale.Close())
+
+	tx := &scopeTx{}
+	next, err := ctn.NewScope(&scopeHandler{}, tx)
+	require.NoError(t, err)
+	session, err := glue.GetScopedBean[*requestSession](next.Context(context.Background()))
+	require.NoError(t, err)
+
+	// the closed scope does not see or close beans of the next one
+	other, err := glue.GetScopedBean[*requestSession](staleCtx)
+	require.NoError(t, err)
+	require.NotSame(t, session, other)
+	require.NoError(t, stale.Close())
+	require.Equal(t, 0, tx.destroyed)
+
+	require.NoError(t, next.Close())
+	require.Equal(t, 1, tx.destroyed)
+}
+
+type scopeOptionalRepo struct {
+	Repo *scopeRepo `inject:"optional"`
+}
+
+func TestNewScopeAfterRegister(t *testing.T) {
+
+	ctn, err := glue.New()
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	handler := &scopeOptionalRepo{}
+	scope, err := ctn.NewScope(handler)
+	require.NoError(t, err)
+	require.Nil(t, handler.Repo)
+	require.NoError(t, scope.Close())
+
+	repo := &scopeRepo{}
+	b, err := ctn.Register(repo)
+	require.NoError(t, err)
+
+	handler = &scopeOptionalRepo{}
+	scope, err = ctn.NewScope(handler)
+	require.NoError(t, err)
+	require.Same(t, repo, handler.Repo)
+	require.NoError(t, scope.Close())
+
+	require.NoError(t, ctn.Unregister(b.Name()))
+
+	handler = &scopeOptionalRepo{}
+	scope, err = ctn.NewScope(handler)
+	require.NoError(t, err)
+	require.Nil(t, handler.Repo)
+	require.NoError(t, scope.Close())
+}
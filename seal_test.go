@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type sealedService struct {
+	Name string `value:"service.name,default=unknown"`
+}
+
+type sealedPlugin struct{}
+
+func TestSealRejectsMutation(t *testing.T) {
+	ctn, err := glue.New(&sealedService{}, glue.Child("tenant", &sealedPlugin{}))
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	b, err := ctn.Register(&sealedPlugin{})
+	require.NoError(t, err)
+	require.False(t, ctn.Sealed())
+
+	ctn.Seal()
+	require.True(t, ctn.Sealed())
+
+	_, err = ctn.Register(&sealedPlugin{})
+	require.ErrorIs(t, err, glue.ErrContainerSealed)
+	require.ErrorIs(t, ctn.Unregister(b.Name()), glue.ErrContainerSealed)
+
+	_, err = ctn.Extend(&sealedPlugin{})
+	require.ErrorIs(t, err, glue.ErrContainerSealed)
+	_, err = ctn.ExtendWithOptions(glue.WithBeans(&sealedPlugin{}))
+	require.ErrorIs(t, err, glue.ErrContainerSealed)
+
+	// declared children are still created on demand
+	child, err := ctn.Find("tenant")
+	require.NoError(t, err)
+	tenant, err := child.Object()
+	require.NoError(t, err)
+	require.Len(t, tenant.Bean(reflect.TypeOf(&sealedPlugin{}), 1), 1)
+}
+
+func TestWithSeal(t *testing.T) {
+	ctn, err := glue.NewWithOptions(
+		glue.WithBeans(&sealedService{}, glue.MapPropertySource{"service.name": "orders"}),
+		glue.WithSeal(),
+		glue.WithPropertyFreeze(glue.FreezeStrict),
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.True(t, ctn.Sealed())
+	_, err = ctn.Register(&sealedPlugin{})
+	require.ErrorIs(t, err, glue.ErrContainerSealed)
+
+	ctn.Properties().Set("service.name", "payments")
+	require.Equal(t, "orders", ctn.Properties().GetString("service.name", ""))
+}
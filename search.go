@@ -11,7 +11,11 @@ func (t *container) searchByNameRecursive(name string) []beanlist {
 	var candidates []beanlist
 	level := 1
 	for ctx := t; ctx != nil; ctx = ctx.parent {
-		if list, ok := ctx.localNames[name]; ok && len(list) > 0 {
+		list := ctx.localNames[name]
+		if registered := ctx.registeredByName(name); len(registered) > 0 {
+			list = append(list[:len(list):len(list)], registered...)
+		}
+		if list = t.visible(ctx, list); len(list) > 0 {
 			candidates = append(candidates, beanlist{level: level, list: list})
 		}
 		level++
@@ -23,7 +27,15 @@ func (t *container) findObjectRecursive(requiredType reflect.Type) []beanlist {
 	var candidates []beanlist
 	level := 1
 	for ctx := t; ctx != nil; ctx = ctx.parent {
-		if direct, ok := ctx.core[requiredType]; ok {
+		direct, ok := ctx.core[requiredType]
+		if registered := ctx.registeredByType(requiredType); len(registered) > 0 {
+			direct, ok = append(direct[:len(direct):len(direct)], registered...), true
+		}
+		if ctx != t && ok {
+			direct = t.visible(ctx, direct)
+			ok = len(direct) > 0
+		}
+		if ok {
 			candidates = append(candidates, beanlist{level: level, list: direct})
 		}
 		level++
@@ -36,15 +48,14 @@ func (t *container) searchAndCacheInterfaceCandidatesRecursive(ifaceType reflect
 	level := 1
 	for ctx := t; ctx != nil; ctx = ctx.parent {
 		// first lookup in the interface cache
-		if list, ok := ctx.ifaceCache.find(ifaceType); !ok {
+		list, ok := ctx.ifaceCache.find(ifaceType)
+		if !ok {
 			list = ctx.searchInterfaceCandidates(ifaceType)
-			if len(list) > 0 {
-				candidates = append(candidates, beanlist{level: level, list: list})
-			}
 			// cache result
 			// even empty list, so we would not come here again
 			ctx.ifaceCache.store(ifaceType, list)
-		} else if len(list) > 0 {
+		}
+		if list = t.visible(ctx, list); len(list) > 0 {
 			candidates = append(candidates, beanlist{level: level, list: list})
 		}
 		level++
@@ -57,8 +68,13 @@ func (t *container) searchInterfaceCandidates(ifaceType reflect.Type) []*bean {
 	// go through all beans in core, since this interface is new for us
 	for _, list := range t.core {
 		if len(list) > 0 && list[0].beanDef.implements(ifaceType) {
-			candidates = append(candidates, list...)
+			for _, b := range list {
+				if !b.concreteOnly {
+					candidates = append(candidates, b)
+				}
+			}
 		}
 	}
-	return candidates
+	candidates = append(candidates, t.registeredByInterface(ifaceType)...)
+	return t.selectWired(ifaceType, candidates)
 }
@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+/**
+Unexported field with 'inject' tag receives the value through the setter,
+the value is injected in to the shadow and passed to the setter before PostConstruct
+*/
+
+type setterField struct {
+	name  string
+	value reflect.Value
+	set   func(reflect.Value) error
+}
+
+/**
+Returns the setter of the field: exported method Set<Field> with the single parameter of the field type
+returning nothing or error, otherwise InjectSetter of the bean
+*/
+
+func findSetter(ptr reflect.Value, fieldName string, fieldType reflect.Type) (func(reflect.Value) error, bool) {
+	r, size := utf8.DecodeRuneInString(fieldName)
+	methodName := "Set" + string(unicode.ToUpper(r)) + fieldName[size:]
+	if m := ptr.MethodByName(methodName); m.IsValid() {
+		mt := m.Type()
+		if mt.NumIn() == 1 && fieldType.AssignableTo(mt.In(0)) && (mt.NumOut() == 0 || mt.NumOut() == 1 && mt.Out(0) == errorType) {
+			return func(value reflect.Value) error {
+				out := m.Call([]reflect.Value{value})
+				if len(out) == 1 && !out[0].IsNil() {
+					return out[0].Interface().(error)
+				}
+				return nil
+			}, true
+		}
+	}
+	if setter, ok := ptr.Interface().(InjectSetter); ok {
+		return func(value reflect.Value) error {
+			return setter.SetInjected(fieldName, value.Interface())
+		}, true
+	}
+	return nil, false
+}
+
+func notPublicErr(fieldName string, class reflect.Type) error {
+	return fmt.Errorf("field '%s' in class '%v' is not public, export it, add the setter method or implement glue.InjectSetter", fieldName, class)
+}
+
+/**
+Returns the settable shadow of the unexported field, created on the first call
+*/
+
+func (t *bean) setterField(structVal reflect.Value, def *injectionDef) (reflect.Value, error) {
+	if sf, ok := t.setterFields[def.fieldNum]; ok {
+		return sf.value, nil
+	}
+	if !hasSetters {
+		return reflect.Value{}, requireFeature(FeatureSetters)
+	}
+	if !structVal.CanAddr() {
+		return reflect.Value{}, notPublicErr(def.fieldName, def.class)
+	}
+	field := def.field(structVal)
+	set, ok := findSetter(structVal.Addr(), def.fieldName, field.Type())
+	if !ok {
+		return reflect.Value{}, notPublicErr(def.fieldName, def.class)
+	}
+	if t.setterFields == nil {
+		t.setterFields = make(map[int]*setterField)
+	}
+	sf := &setterField{name: def.fieldName, value: reflect.New(field.Type()).Elem(), set: set}
+	t.setterFields[def.fieldNum] = sf
+	return sf.value, nil
+}
+
+/**
+Passes injected values of unexported fields to setters in the field order
+*/
+
+func (t *bean) applySetters() error {
+	if len(t.setterFields) == 0 {
+		return nil
+	}
+	nums := make([]int, 0, len(t.setterFields))
+	for num := range t.setterFields {
+		nums = append(nums, num)
+	}
+	sort.Ints(nums)
+	for _, num := range nums {
+		sf := t.setterFields[num]
+		if err := sf.set(sf.value); err != nil {
+			return fmt.Errorf("setter of field '%s' in bean '%s' failed: %w", sf.name, t.name, err)
+		}
+	}
+	return nil
+}
+
+/**
+Returns the field of the bean or the shadow of the unexported field
+*/
+
+func (t *bean) injectedField(structVal reflect.Value, def *injectionDef) reflect.Value {
+	if sf, ok := t.setterFields[def.fieldNum]; ok {
+		return sf.value
+	}
+	return def.field(structVal)
+}
+
+/**
+Calls the setter again after the shadow value was replaced by decorators or proxies
+*/
+
+func (t *bean) updateSetterField(fieldNum int) {
+	if sf, ok := t.setterFields[fieldNum]; ok {
+		sf.set(sf.value)
+	}
+}
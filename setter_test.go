@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type setterRepo struct {
+	name string
+}
+
+type setterService struct {
+	repo *setterRepo `inject:""`
+
+	repoAtConstruct *setterRepo
+}
+
+func (t *setterService) SetRepo(repo *setterRepo) {
+	t.repo = repo
+}
+
+func (t *setterService) PostConstruct() error {
+	t.repoAtConstruct = t.repo
+	return nil
+}
+
+type injectSetterService struct {
+	repo  *setterRepo   `inject:""`
+	repos []*setterRepo `inject:""`
+}
+
+func (t *injectSetterService) SetInjected(field string, value any) error {
+	switch field {
+	case "repo":
+		t.repo = value.(*setterRepo)
+	case "repos":
+		t.repos = value.([]*setterRepo)
+	default:
+		return errors.New("unknown field " + field)
+	}
+	return nil
+}
+
+type failingSetterService struct {
+	repo *setterRepo `inject:""`
+}
+
+func (t *failingSetterService) SetRepo(repo *setterRepo) error {
+	return errors.New("rejected")
+}
+
+type missingSetterService struct {
+	repo *setterRepo `inject:""`
+}
+
+func TestInjectUnexportedFields(t *testing.T) {
+
+	skipUnsupported(t, glue.FeatureSetters)
+
+	repo := &setterRepo{name: "users"}
+	service := &setterService{}
+	other := &injectSetterService{}
+
+	ctn, err := glue.New(repo, service, other)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Same(t, repo, service.repo)
+	require.Same(t, repo, service.repoAtConstruct)
+	require.Same(t, repo, other.repo)
+	require.Equal(t, []*setterRepo{repo}, other.repos)
+
+	late := &setterService{}
+	require.NoError(t, ctn.Inject(late))
+	require.Same(t, repo, late.repo)
+
+	_, err = glue.New(repo, &failingSetterService{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "rejected")
+
+	_, err = glue.New(repo, &missingSetterService{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not public")
+}
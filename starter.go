@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"sort"
+)
+
+/**
+Starts Starter beans of the container in phase order after all beans are initialized,
+started ones are recorded to be stopped on close even if the next one fails
+*/
+
+func (t *container) startStarters() error {
+	var starters []Starter
+	for _, b := range t.Bean(StarterClass, 1) {
+		if s, ok := b.Object().(Starter); ok {
+			starters = append(starters, s)
+		}
+	}
+	sort.SliceStable(starters, func(i, j int) bool {
+		return starters[i].Phase() < starters[j].Phase()
+	})
+	for _, s := range starters {
+		t.logf(LogInfo, "Start %T in phase %d\n", s, s.Phase())
+		if err := s.Start(); err != nil {
+			return fmt.Errorf("start '%T' in phase %d failed: %w", s, s.Phase(), err)
+		}
+		t.started = append(t.started, s)
+	}
+	return nil
+}
+
+/**
+Stops started beans in reverse order, so the higher phases stop first
+*/
+
+func (t *container) stopStarters() []error {
+	var listErr []error
+	for i := len(t.started) - 1; i >= 0; i-- {
+		s := t.started[i]
+		t.logf(LogInfo, "Stop %T in phase %d\n", s, s.Phase())
+		if err := s.Stop(); err != nil {
+			listErr = append(listErr, fmt.Errorf("stop '%T' in phase %d failed: %w", s, s.Phase(), err))
+		}
+	}
+	t.started = nil
+	return listErr
+}
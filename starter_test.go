@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type starterLog struct {
+	events []string
+}
+
+type phasedStarter struct {
+	Log       *starterLog `inject:""`
+	name      string
+	phase     int
+	failStart bool
+}
+
+func (t *phasedStarter) BeanName() string { return t.name }
+
+func (t *phasedStarter) PostConstruct() error {
+	t.Log.events = append(t.Log.events, "init "+t.name)
+	return nil
+}
+
+func (t *phasedStarter) Start() error {
+	if t.failStart {
+		return errors.New("port in use")
+	}
+	t.Log.events = append(t.Log.events, "start "+t.name)
+	return nil
+}
+
+func (t *phasedStarter) Stop() error {
+	t.Log.events = append(t.Log.events, "stop "+t.name)
+	return nil
+}
+
+func (t *phasedStarter) Destroy() error {
+	t.Log.events = append(t.Log.events, "destroy "+t.name)
+	return nil
+}
+
+func (t *phasedStarter) Phase() int { return t.phase }
+
+func TestStarterPhases(t *testing.T) {
+
+	log := &starterLog{}
+	ctn, err := glue.New(
+		log,
+		&phasedStarter{name: "server", phase: 10},
+		&phasedStarter{name: "consumer", phase: 0},
+		&phasedStarter{name: "scheduler", phase: 10},
+	)
+	require.NoError(t, err)
+
+	// all beans are initialized before the first start
+	require.Len(t, log.events, 6)
+	require.Equal(t, []string{"start consumer", "start server", "start scheduler"}, log.events[3:])
+
+	log.events = nil
+	require.NoError(t, ctn.Close())
+	require.Equal(t, []string{"stop scheduler", "stop server", "stop consumer"}, log.events[:3])
+	require.Len(t, log.events, 6)
+}
+
+func TestStarterFailure(t *testing.T) {
+
+	log := &starterLog{}
+	_, err := glue.New(
+		log,
+		&phasedStarter{name: "consumer", phase: 0},
+		&phasedStarter{name: "server", phase: 1, failStart: true},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "port in use")
+	require.Contains(t, log.events, "stop consumer")
+	require.NotContains(t, log.events, "stop server")
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+/**
+Number of the slowest beans included in the startup timeout report
+*/
+
+var StartupReportSize = 5
+
+var ErrStartupTimeout = errors.New("container startup timeout")
+
+/**
+Runs container creation in background and aborts with the report if it takes longer than StartupTimeout.
+The late container, if ever created, is closed in background.
+*/
+
+func createContainerWithTimeout(parent *container, options ContainerOptions) (*container, error) {
+
+	timeout := options.StartupTimeout
+	options.StartupTimeout = 0
+	// preflight checks and PostConstruct(ctx) see the deadline
+	parentCtx := options.Context
+	ctx, cancel := context.WithTimeout(parentCtx, timeout)
+	defer cancel()
+	options.Context = ctx
+	if options.History == nil {
+		options.History = NewHistory(DefaultHistorySize)
+	}
+	history := options.History
+
+	type result struct {
+		c   *container
+		err error
+	}
+
+	ch := make(chan result, 1)
+	go func() {
+		c, err := createContainer(parent, options)
+		ch <- result{c: c, err: err}
+	}()
+
+	// the deadline of the context is the only source of the timeout, factories failing on it report the timeout too
+	var r result
+	select {
+	case r = <-ch:
+	case <-ctx.Done():
+		if parentCtx.Err() != nil {
+			// cancelled by the caller, creation fails with its error
+			r = <-ch
+			break
+		}
+		go func() {
+			if r := <-ch; r.c != nil {
+				r.c.closeWithTimeout(DefaultCloseTimeout)
+			}
+		}()
+		return nil, startupTimeoutErr(timeout, history)
+	}
+	if r.err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) && parentCtx.Err() == nil {
+		return nil, startupTimeoutErr(timeout, history)
+	}
+	if r.c != nil {
+		r.c.template.Context = parentCtx
+		r.c.template.StartupTimeout = timeout
+	}
+	return r.c, r.err
+}
+
+func startupTimeoutErr(timeout time.Duration, history *History) error {
+	return fmt.Errorf("%w: exceeded %v, %s", ErrStartupTimeout, timeout, startupReport(history.Events(), time.Now()))
+}
+
+func startupReport(events []HistoryEvent, now time.Time) string {
+
+	type beanTiming struct {
+		name     string
+		start    time.Time
+		duration time.Duration
+		pending  bool
+	}
+
+	// keyed by the bean id, beans with the same name are timed separately
+	timings := make(map[uint64]*beanTiming)
+	var order []*beanTiming
+	for _, e := range events {
+		if e.Bean == "" {
+			continue
+		}
+		switch e.To {
+		case BeanConstructing:
+			bt := &beanTiming{name: e.Bean, start: e.Time, pending: true}
+			timings[e.BeanID] = bt
+			order = append(order, bt)
+		case BeanInitialized:
+			if bt, ok := timings[e.BeanID]; ok && bt.pending {
+				bt.duration = e.Time.Sub(bt.start)
+				bt.pending = false
+			}
+		}
+	}
+
+	var pending []string
+	for _, bt := range order {
+		if bt.pending {
+			bt.duration = now.Sub(bt.start)
+			pending = append(pending, bt.name)
+		}
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return order[i].duration > order[j].duration
+	})
+	if len(order) > StartupReportSize {
+		order = order[:StartupReportSize]
+	}
+
+	var slowest []string
+	for _, bt := range order {
+		if bt.pending {
+			slowest = append(slowest, fmt.Sprintf("%s %v (pending)", bt.name, bt.duration))
+		} else {
+			slowest = append(slowest, fmt.Sprintf("%s %v", bt.name, bt.duration))
+		}
+	}
+
+	return fmt.Sprintf("pending beans [%s], slowest beans [%s]", strings.Join(pending, ", "), strings.Join(slowest, ", "))
+}
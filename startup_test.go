@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type stuckBean struct {
+	release chan struct{}
+	closed  chan struct{}
+}
+
+func (t *stuckBean) PostConstruct() error {
+	<-t.release
+	return nil
+}
+
+func (t *stuckBean) Destroy() error {
+	close(t.closed)
+	return nil
+}
+
+type fastBean struct {
+}
+
+func (t *fastBean) PostConstruct() error {
+	return nil
+}
+
+func TestStartupTimeout(t *testing.T) {
+
+	stuck := &stuckBean{release: make(chan struct{}), closed: make(chan struct{})}
+
+	_, err := glue.NewWithOptions(
+		glue.WithStartupTimeout(50*time.Millisecond),
+		glue.WithBeans(&fastBean{}, stuck),
+	)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, glue.ErrStartupTimeout))
+	require.Contains(t, err.Error(), "pending beans [*glue_test.stuckBean]")
+	require.Contains(t, err.Error(), "*glue_test.stuckBean")
+
+	// late container is closed in background
+	close(stuck.release)
+	select {
+	case <-stuck.closed:
+	case <-time.After(time.Second):
+		t.Fatal("late container was not closed")
+	}
+}
+
+func TestStartupTimeoutNotExceeded(t *testing.T) {
+
+	ctn, err := glue.NewWithOptions(
+		glue.WithStartupTimeout(time.Second),
+		glue.WithBeans(&fastBean{}),
+	)
+	require.NoError(t, err)
+	require.NoError(t, ctn.Close())
+}
+
+type sameNameService struct {
+	Worker *sameNameWorker `inject:""`
+}
+
+func (t *sameNameService) BeanName() string { return "worker" }
+
+func (t *sameNameService) PostConstruct() error {
+	return nil
+}
+
+type sameNameWorker struct {
+}
+
+func (t *sameNameWorker) BeanName() string { return "worker" }
+
+func (t *sameNameWorker) PostConstruct() error {
+	return nil
+}
+
+func TestStartupTimeoutSameNames(t *testing.T) {
+
+	stuck := &stuckBean{release: make(chan struct{}), closed: make(chan struct{})}
+	defer close(stuck.release)
+
+	// the worker is constructed while the service with the same name is constructing
+	_, err := glue.NewWithOptions(
+		glue.WithStartupTimeout(50*time.Millisecond),
+		glue.WithBeans(&sameNameService{}, &sameNameWorker{}, stuck),
+	)
+	require.True(t, errors.Is(err, glue.ErrStartupTimeout))
+	require.Contains(t, err.Error(), "pending beans [*glue_test.stuckBean]")
+}
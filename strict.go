@@ -0,0 +1,239 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+/**
+Strict in the scan list fails the container creation on dead wiring and silent misconfiguration,
+e.g. glue.New(&glue.Strict{Allow: []string{"auditLog"}}, ...):
+  - the bean never injected in to other beans of the container, unless it is named by NamedBean, glue.Facet or glue.Alias,
+    or the container uses it by the role, e.g. Runnable, Server, FactoryBean, listeners and post processors
+  - the struct tag key looking like the typo of 'inject' or 'value', e.g. `injct:""` or `vaue:"port"`
+*/
+
+type Strict struct {
+	/*
+		Allow lists names of beans allowed to be unused, e.g. beans looked up at runtime
+	*/
+	Allow []string
+}
+
+/**
+StrictError lists all violations of Strict found in the container.
+*/
+
+type StrictError struct {
+	Unused []string // names of beans never injected
+	Tags   []string // fields with misspelled tag keys
+}
+
+func (t *StrictError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("strict mode found %d violations:", len(t.Unused)+len(t.Tags)))
+	for _, name := range t.Unused {
+		sb.WriteString(fmt.Sprintf("\n    unused bean '%s'", name))
+	}
+	for _, tag := range t.Tags {
+		sb.WriteString("\n    ")
+		sb.WriteString(tag)
+	}
+	return sb.String()
+}
+
+// known tag keys of the container checked for typos in strict mode
+var strictTagKeys = []string{"inject", "value", "resource"}
+
+// beans implementing these interfaces are used by the container itself
+var strictRoles = []reflect.Type{
+	NamedBeanClass,
+	ScannerClass,
+	ChildContainerClass,
+	FactoryBeanClass,
+	ContextFactoryBeanClass,
+	CollectionFactoryBeanClass,
+	TypeFactoryClass,
+	RunnableClass,
+	ServerClass,
+	StarterClass,
+	HealthCheckerClass,
+	BeanPostProcessorClass,
+	DecoratorClass,
+	PreflightCheckClass,
+	ConfigMigrationClass,
+	RefreshableSourceClass,
+	EventListenerClass,
+	LifecycleListenerClass,
+	PropertyChangeListenerClass,
+	PropertyAuditorClass,
+	reflect.TypeOf((*PropertyResolver)(nil)).Elem(),
+}
+
+/**
+Checks wiring of the current container after injection, before beans are constructed
+*/
+
+func (t *container) checkStrict() error {
+	if t.strict == nil {
+		return nil
+	}
+	allowed := make(map[string]bool)
+	for _, name := range t.strict.Allow {
+		allowed[name] = true
+	}
+
+	// beans are registered under several types
+	seen := make(map[*bean]bool)
+	used := make(map[*bean]bool)
+	var beans []*bean
+	for _, list := range t.core {
+		for _, b := range list {
+			if seen[b] {
+				continue
+			}
+			seen[b] = true
+			beans = append(beans, b)
+			for _, in := range b.injectedBeans {
+				used[in.to] = true
+			}
+		}
+	}
+
+	err := &StrictError{}
+	checked := make(map[reflect.Type]bool)
+	for _, b := range beans {
+		if b.beanDef == nil {
+			continue
+		}
+		if class := b.beanDef.classPtr; !checked[class] {
+			checked[class] = true
+			err.Tags = append(err.Tags, misspelledTags(class)...)
+		}
+		if used[b] || b.obj == t || b.beenFactory != nil || b.qualifier != "" || len(b.aliases) > 0 || allowed[b.name] || hasStrictRole(b) {
+			continue
+		}
+		err.Unused = append(err.Unused, beanGraphName(b))
+	}
+	if len(err.Unused) == 0 && len(err.Tags) == 0 {
+		return nil
+	}
+	sort.Strings(err.Unused)
+	sort.Strings(err.Tags)
+	return err
+}
+
+func hasStrictRole(b *bean) bool {
+	class := b.beanDef.classPtr
+	if class.Kind() == reflect.Ptr && class.Elem().PkgPath() == gluePkgPath {
+		// sources and handlers of the container
+		return true
+	}
+	for _, role := range strictRoles {
+		if class.Implements(role) {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+Returns fields of the struct and its embedded structs with tag keys close to known ones
+*/
+
+func misspelledTags(class reflect.Type) []string {
+	for class.Kind() == reflect.Ptr {
+		class = class.Elem()
+	}
+	if class.Kind() != reflect.Struct {
+		return nil
+	}
+	var list []string
+	for i := 0; i < class.NumField(); i++ {
+		field := class.Field(i)
+		for _, key := range tagKeys(field.Tag) {
+			for _, known := range strictTagKeys {
+				if key != known && editDistance(key, known) <= 2 {
+					list = append(list, fmt.Sprintf("field '%s' of '%v' has tag '%s', did you mean '%s'?", field.Name, class, key, known))
+				}
+			}
+		}
+		if field.Anonymous {
+			list = append(list, misspelledTags(field.Type)...)
+		}
+	}
+	return list
+}
+
+/**
+Returns keys of the struct tag in the conventional format key:"value" key2:"value2"
+*/
+
+func tagKeys(tag reflect.StructTag) []string {
+	var keys []string
+	s := string(tag)
+	for s != "" {
+		s = strings.TrimLeft(s, " ")
+		i := strings.Index(s, ":\"")
+		if i <= 0 {
+			break
+		}
+		keys = append(keys, s[:i])
+		s = s[i+2:]
+		// skip the quoted value
+		j := 0
+		for j < len(s) && s[j] != '"' {
+			if s[j] == '\\' {
+				j++
+			}
+			j++
+		}
+		if j >= len(s) {
+			break
+		}
+		s = s[j+1:]
+	}
+	return keys
+}
+
+/**
+Levenshtein distance with adjacent transpositions
+*/
+
+func editDistance(a, b string) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := 0; j <= len(b); j++ {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = minInt(d[i-1][j]+1, minInt(d[i][j-1]+1, d[i-1][j-1]+cost))
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = minInt(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type strictRepo struct{}
+
+type strictService struct {
+	Repo *strictRepo `inject:""`
+}
+
+type strictWorker struct {
+	Service *strictService `inject:""`
+}
+
+func (t *strictWorker) Run(ctx context.Context) error {
+	return nil
+}
+
+type strictLeftover struct{}
+
+type strictTypo struct {
+	Repo *strictRepo `injct:""`
+	Port int         `vaue:"port" json:"port"`
+}
+
+func TestStrict(t *testing.T) {
+
+	ctn, err := glue.New(glue.Strict{}, &strictRepo{}, &strictService{}, &strictWorker{})
+	require.NoError(t, err)
+	ctn.Close()
+
+	_, err = glue.New(&glue.Strict{}, &strictRepo{}, &strictService{}, &strictWorker{}, &strictLeftover{})
+	var strict *glue.StrictError
+	require.True(t, errors.As(err, &strict), err)
+	require.Equal(t, []string{"*glue_test.strictLeftover"}, strict.Unused)
+	require.Empty(t, strict.Tags)
+
+	ctn, err = glue.New(&glue.Strict{Allow: []string{"*glue_test.strictLeftover"}}, &strictRepo{}, &strictService{}, &strictWorker{}, &strictLeftover{})
+	require.NoError(t, err)
+	ctn.Close()
+
+	ctn, err = glue.New(&strictRepo{}, &strictService{}, &strictWorker{}, &strictLeftover{})
+	require.NoError(t, err)
+	ctn.Close()
+}
+
+func TestStrictTags(t *testing.T) {
+
+	_, err := glue.New(&glue.Strict{}, &strictRepo{}, &strictService{}, &strictWorker{}, glue.Alias(&strictTypo{}, "typo"))
+	var strict *glue.StrictError
+	require.True(t, errors.As(err, &strict), err)
+	require.Empty(t, strict.Unused)
+	require.Equal(t, []string{
+		"field 'Port' of 'glue_test.strictTypo' has tag 'vaue', did you mean 'value'?",
+		"field 'Repo' of 'glue_test.strictTypo' has tag 'injct', did you mean 'inject'?",
+	}, strict.Tags)
+	require.Contains(t, err.Error(), "strict mode found 2 violations")
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"reflect"
+	"text/template"
+	"time"
+)
+
+var TemplateResourceClass = reflect.TypeOf((*TemplateResource)(nil))
+
+/**
+TemplateResource exposes the resource rendered by text/template as "<Source>:<Name>", for example
+
+	&glue.TemplateResource{Source: "generated", Name: "nginx.conf", Template: "resources:nginx.conf.tmpl"}
+
+The template is rendered on every Open with TemplateData, so it sees current values of properties.
+Functions 'property' and 'bean' return the property value and the object of the bean found by name.
+*/
+
+type TemplateResource struct {
+	Source   string
+	Name     string
+	Template string           // resource path of the template
+	Funcs    template.FuncMap // additional functions, override built-in ones
+}
+
+/**
+TemplateData is the data given to templates of TemplateResource.
+*/
+
+type TemplateData struct {
+	Properties map[string]string
+}
+
+type templateResource struct {
+	def  *TemplateResource
+	tmpl *template.Template
+	ctn  *container
+}
+
+func (t *container) addTemplateResource(def *TemplateResource) error {
+	if def.Source == "" || def.Name == "" {
+		return fmt.Errorf("template resource '%s:%s' must have source and name", def.Source, def.Name)
+	}
+	res, ok := t.Resource(def.Template)
+	if !ok {
+		return fmt.Errorf("template '%s' of resource '%s:%s' was not found", def.Template, def.Source, def.Name)
+	}
+	file, err := res.Open()
+	if err != nil {
+		return fmt.Errorf("i/o error with template '%s': %w", def.Template, err)
+	}
+	var text bytes.Buffer
+	_, err = text.ReadFrom(file)
+	file.Close()
+	if err != nil {
+		return fmt.Errorf("i/o error with template '%s': %w", def.Template, err)
+	}
+	r := &templateResource{def: def, ctn: t}
+	funcs := template.FuncMap{
+		"property": r.property,
+		"bean":     r.bean,
+	}
+	for name, fn := range def.Funcs {
+		funcs[name] = fn
+	}
+	r.tmpl, err = template.New(def.Template).Funcs(funcs).Parse(text.String())
+	if err != nil {
+		return fmt.Errorf("parse template '%s': %w", def.Template, err)
+	}
+	return t.resourceSources.addResource(def.Source, def.Name, r)
+}
+
+func (t *templateResource) property(key string, def ...string) string {
+	value := ""
+	if len(def) > 0 {
+		value = def[0]
+	}
+	return t.ctn.properties.GetString(key, value)
+}
+
+func (t *templateResource) bean(name string) (any, error) {
+	list := t.ctn.Lookup(name, 0)
+	if len(list) == 0 {
+		return nil, fmt.Errorf("bean '%s' was not found", name)
+	}
+	return list[0].Object(), nil
+}
+
+func (t *templateResource) Open() (http.File, error) {
+	data := TemplateData{Properties: make(map[string]string)}
+	for _, key := range t.ctn.properties.Keys() {
+		data.Properties[key] = t.ctn.properties.GetString(key, "")
+	}
+	var out bytes.Buffer
+	if err := t.tmpl.Execute(&out, data); err != nil {
+		return nil, fmt.Errorf("render template resource '%s:%s': %w", t.def.Source, t.def.Name, err)
+	}
+	return &renderedFile{Reader: bytes.NewReader(out.Bytes()), name: path.Base(t.def.Name), size: int64(out.Len()), modTime: time.Now()}, nil
+}
+
+/**
+In-memory http.File of the rendered template or downloaded content
+*/
+
+type renderedFile struct {
+	*bytes.Reader
+	name    string
+	size    int64
+	modTime time.Time
+}
+
+func (t *renderedFile) Close() error {
+	return nil
+}
+
+func (t *renderedFile) Readdir(count int) ([]fs.FileInfo, error) {
+	return nil, os.ErrInvalid
+}
+
+func (t *renderedFile) Stat() (fs.FileInfo, error) {
+	return t, nil
+}
+
+func (t *renderedFile) Name() string {
+	return t.name
+}
+
+func (t *renderedFile) Size() int64 {
+	return t.size
+}
+
+func (t *renderedFile) Mode() fs.FileMode {
+	return 0444
+}
+
+func (t *renderedFile) ModTime() time.Time {
+	return t.modTime
+}
+
+func (t *renderedFile) IsDir() bool {
+	return false
+}
+
+func (t *renderedFile) Sys() any {
+	return nil
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"text/template"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type templateUpstream struct {
+	Hosts []string
+}
+
+func (t *templateUpstream) BeanName() string { return "upstream" }
+
+const nginxTemplate = `listen {{ property "server.port" "80" }};
+{{- range (bean "upstream").Hosts }}
+server {{ . }};
+{{- end }}
+name {{ index .Properties "app.name" | upper }};`
+
+func TestTemplateResource(t *testing.T) {
+
+	templates := fstest.MapFS{
+		"nginx.conf.tmpl": {Data: []byte(nginxTemplate)},
+	}
+
+	ctn, err := glue.New(
+		&glue.TemplateResource{
+			Source:   "generated",
+			Name:     "nginx.conf",
+			Template: "resources:nginx.conf.tmpl",
+			Funcs:    template.FuncMap{"upper": strings.ToUpper},
+		},
+		&glue.ResourceSource{Name: "resources", FS: templates},
+		glue.MapPropertySource{"server.port": "8080", "app.name": "shop"},
+		&templateUpstream{Hosts: []string{"10.0.0.1", "10.0.0.2"}},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	render := func() string {
+		res, ok := ctn.Resource("generated:nginx.conf")
+		require.True(t, ok)
+		file, err := res.Open()
+		require.NoError(t, err)
+		defer file.Close()
+		content, err := ioutil.ReadAll(file)
+		require.NoError(t, err)
+		return string(content)
+	}
+
+	require.Equal(t, "listen 8080;\nserver 10.0.0.1;\nserver 10.0.0.2;\nname SHOP;", render())
+
+	ctn.Properties().Set("server.port", "9090")
+	require.True(t, strings.HasPrefix(render(), "listen 9090;"))
+}
+
+func TestTemplateResourceErrors(t *testing.T) {
+
+	_, err := glue.New(
+		&glue.TemplateResource{Source: "generated", Name: "a.conf", Template: "resources:missing.tmpl"},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "missing.tmpl")
+
+	_, err = glue.New(
+		&glue.TemplateResource{Source: "generated", Name: "a.conf", Template: "resources:a.tmpl"},
+		&glue.ResourceSource{Name: "resources", FS: fstest.MapFS{"a.tmpl": {Data: []byte("{{ .Broken ")}}},
+	)
+	require.Error(t, err)
+
+	ctn, err := glue.New(
+		&glue.TemplateResource{Source: "generated", Name: "a.conf", Template: "resources:a.tmpl"},
+		&glue.ResourceSource{Name: "resources", FS: fstest.MapFS{"a.tmpl": {Data: []byte(`{{ bean "unknown" }}`)}}},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+	res, ok := ctn.Resource("generated:a.conf")
+	require.True(t, ok)
+	_, err = res.Open()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "bean 'unknown' was not found")
+}
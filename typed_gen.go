@@ -59,6 +59,79 @@ func GetBeans[T any](c Container) []T {
 	return list
 }
 
+/*
+BeanOf returns the single bean assignable to T. Unlike GetBean, which fails on several candidates,
+it selects the primary bean among them the same way as single-valued injection does.
+*/
+
+func BeanOf[T any](c Container) (T, error) {
+	var zero T
+	typ := beanType[T]()
+	beans := c.Bean(typ, DefaultSearchLevel)
+	if len(beans) == 0 {
+		return zero, fmt.Errorf("bean '%s' not found", typ)
+	}
+	candidate := beans[0]
+	if len(beans) > 1 {
+		var primary []Bean
+		var names []string
+		for _, b := range beans {
+			if impl, ok := b.(*bean); ok && impl.primary {
+				primary = append(primary, b)
+			}
+			names = append(names, b.Name())
+		}
+		if len(primary) != 1 {
+			return zero, fmt.Errorf("bean '%s' is ambiguous, candidates %v, mark one of them as primary", typ, names)
+		}
+		candidate = primary[0]
+	}
+	obj := candidate.Object()
+	if obj == nil {
+		return zero, fmt.Errorf("bean '%s' is not initialized", typ)
+	}
+	value, ok := obj.(T)
+	if !ok {
+		return zero, fmt.Errorf("bean '%s' of type '%T' cannot be converted to '%s'", typ, obj, typ)
+	}
+	return value, nil
+}
+
+/*
+MustBeanOf returns the only bean assignable to T, panics with the list of candidates if zero or many beans match.
+*/
+
+func MustBeanOf[T any](c Container) T {
+	typ := beanType[T]()
+	b, err := c.Single(typ)
+	if err != nil {
+		panic(err)
+	}
+	value, ok := b.Object().(T)
+	if !ok {
+		panic(fmt.Errorf("bean '%s' of type '%T' cannot be converted to '%s'", typ, b.Object(), typ))
+	}
+	return value
+}
+
+/*
+OnEvent returns the EventListener bean that receives only events of type E.
+*/
+
+func OnEvent[E any](fn func(E)) EventListener {
+	return &eventFuncListener[E]{fn: fn}
+}
+
+type eventFuncListener[E any] struct {
+	fn func(E)
+}
+
+func (t *eventFuncListener[E]) OnEvent(event any) {
+	if e, ok := event.(E); ok {
+		t.fn(e)
+	}
+}
+
 func GetProperty[T any](c Container, key string) (T, error) {
 	var zero T
 	props := c.Properties()
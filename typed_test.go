@@ -9,6 +9,7 @@ package glue_test
 
 import (
 	"context"
+	"reflect"
 	"sync/atomic"
 	"testing"
 
@@ -66,6 +67,8 @@ func TestPropertyHelpers(t *testing.T) {
 }
 
 func TestFactories(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	type singletonPayload struct {
 		Value int32
 	}
@@ -163,6 +166,8 @@ func TestContextFactories(t *testing.T) {
 }
 
 func TestContextFactoryRequestScope(t *testing.T) {
+	skipUnsupported(t, glue.FeatureProviders)
+
 	type ctxSession struct {
 		TraceID string
 		Seq     int32
@@ -206,3 +211,58 @@ func TestContextFactoryRequestScope(t *testing.T) {
 	require.NotSame(t, s1, s3)
 	require.Equal(t, "trace-def", s3.TraceID)
 }
+
+type otherServiceImpl struct{}
+
+func (otherServiceImpl) Do() string { return "other" }
+
+func TestBeanOf(t *testing.T) {
+	ctx, err := glue.New(&serviceImpl{}, glue.Primary(&otherServiceImpl{}))
+	require.NoError(t, err)
+	defer ctx.Close()
+
+	value, err := glue.BeanOf[service](ctx)
+	require.NoError(t, err)
+	require.Equal(t, "other", value.Do())
+
+	_, err = glue.GetBean[service](ctx)
+	require.Error(t, err)
+
+	impl, err := glue.BeanOf[*serviceImpl](ctx)
+	require.NoError(t, err)
+	require.Equal(t, "ok", impl.Do())
+
+	require.Equal(t, 2, len(glue.GetBeans[service](ctx)))
+
+	ctx2, err := glue.New(&serviceImpl{}, &otherServiceImpl{})
+	require.NoError(t, err)
+	defer ctx2.Close()
+
+	_, err = glue.BeanOf[service](ctx2)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "ambiguous")
+
+	_, err = glue.BeanOf[context.Context](ctx2)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not found")
+}
+
+func TestSingleBean(t *testing.T) {
+	ctx, err := glue.New(&serviceImpl{}, glue.Primary(&otherServiceImpl{}))
+	require.NoError(t, err)
+	defer ctx.Close()
+
+	b, err := ctx.Single(reflect.TypeOf(&serviceImpl{}))
+	require.NoError(t, err)
+	require.Equal(t, "ok", b.Object().(service).Do())
+
+	_, err = ctx.Single(reflect.TypeOf((*service)(nil)).Elem())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "2 candidates")
+	require.Contains(t, err.Error(), "(*glue_test.serviceImpl)")
+	require.Contains(t, err.Error(), "(*glue_test.otherServiceImpl)")
+
+	require.Equal(t, "ok", glue.MustBeanOf[*serviceImpl](ctx).Do())
+	require.Panics(t, func() { glue.MustBeanOf[service](ctx) })
+	require.Panics(t, func() { glue.MustBeanOf[context.Context](ctx) })
+}
@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+/**
+BeanUsage is the number of injection points and runtime lookups of the bean, see Container.UsageReport.
+*/
+
+type BeanUsage struct {
+	Name       string
+	Type       reflect.Type
+	Injections int
+	Lookups    int
+}
+
+func (t BeanUsage) Used() bool {
+	return t.Injections > 0 || t.Lookups > 0
+}
+
+func (t BeanUsage) String() string {
+	return fmt.Sprintf("%s (%v) injections=%d lookups=%d", t.Name, t.Type, t.Injections, t.Lookups)
+}
+
+/**
+TypeUsage is the number of injection points and runtime lookups requesting the interface or pointer type,
+Candidates are names of beans of the container matching the type.
+*/
+
+type TypeUsage struct {
+	Type       reflect.Type
+	Injections int
+	Lookups    int
+	Candidates []string
+}
+
+func (t TypeUsage) String() string {
+	return fmt.Sprintf("%v injections=%d lookups=%d candidates=[%s]", t.Type, t.Injections, t.Lookups, strings.Join(t.Candidates, ", "))
+}
+
+/**
+UsageReport lists beans of the container sorted by name and requested types sorted by type name.
+*/
+
+type UsageReport struct {
+	Beans []BeanUsage
+	Types []TypeUsage
+}
+
+/**
+Unused returns beans never injected or looked up, candidates for removal from the wiring list.
+Beans working only as listeners, runners or post processors are reported as well.
+*/
+
+func (t UsageReport) Unused() []BeanUsage {
+	var list []BeanUsage
+	for _, b := range t.Beans {
+		if !b.Used() {
+			list = append(list, b)
+		}
+	}
+	return list
+}
+
+func (t UsageReport) String() string {
+	var out strings.Builder
+	out.WriteString("Beans:\n")
+	for _, b := range t.Beans {
+		fmt.Fprintf(&out, "  %s\n", b)
+	}
+	out.WriteString("Types:\n")
+	for _, typ := range t.Types {
+		fmt.Fprintf(&out, "  %s\n", typ)
+	}
+	return out.String()
+}
+
+type typeUsage struct {
+	injections uint64
+	lookups    uint64
+}
+
+func (t *container) typeUsage(typ reflect.Type) *typeUsage {
+	if v, ok := t.usage.Load(typ); ok {
+		return v.(*typeUsage)
+	}
+	v, _ := t.usage.LoadOrStore(typ, &typeUsage{})
+	return v.(*typeUsage)
+}
+
+/**
+Counts the runtime lookup of beans, typ is nil for the lookup by name
+*/
+
+func (t *container) recordLookup(typ reflect.Type, list []*bean) {
+	if typ != nil {
+		atomic.AddUint64(&t.typeUsage(typ).lookups, 1)
+	}
+	for _, b := range list {
+		atomic.AddUint64(&b.lookups, 1)
+	}
+}
+
+/**
+Counts beans injected by Inject in to the field
+*/
+
+func (t *container) recordInjection(def *injectionDef, field reflect.Value, deep []beanlist) {
+	atomic.AddUint64(&t.typeUsage(def.fieldType).injections, 1)
+	for _, impl := range def.filterBeans(levelBeans(deep, def.level)) {
+		if impl.obj != nil && fieldReferences(field, def, beanRefs(impl)) {
+			atomic.AddUint64(&impl.injections, 1)
+		}
+	}
+}
+
+func (t *container) UsageReport() UsageReport {
+	own := make(map[*bean]*BeanUsage)
+	var beans []*bean
+	for _, list := range t.core {
+		beans = append(beans, list...)
+	}
+	t.registryMu.RLock()
+	for _, list := range t.registry.core {
+		beans = append(beans, list...)
+	}
+	t.registryMu.RUnlock()
+	for _, b := range beans {
+		if b.obj == t || b.name == "" {
+			continue
+		}
+		typ := reflect.TypeOf(b.obj)
+		if b.beanDef != nil {
+			typ = b.beanDef.classPtr
+		}
+		own[b] = &BeanUsage{
+			Name:       b.name,
+			Type:       typ,
+			Injections: int(atomic.LoadUint64(&b.injections)),
+			Lookups:    int(atomic.LoadUint64(&b.lookups)),
+		}
+	}
+
+	types := make(map[reflect.Type]*TypeUsage)
+	typeOf := func(typ reflect.Type) *TypeUsage {
+		u, ok := types[typ]
+		if !ok {
+			u = &TypeUsage{Type: typ}
+			types[typ] = u
+		}
+		return u
+	}
+	t.forEachLiveBean(func(holder *bean) {
+		points := make(map[*injectionDef]bool)
+		for _, in := range holder.injectedBeans {
+			if u, ok := own[in.to]; ok {
+				u.Injections++
+			}
+			if !points[in.def] {
+				points[in.def] = true
+				typeOf(in.def.fieldType).Injections++
+			}
+		}
+	})
+	t.usage.Range(func(key, value any) bool {
+		u, counters := typeOf(key.(reflect.Type)), value.(*typeUsage)
+		u.Injections += int(atomic.LoadUint64(&counters.injections))
+		u.Lookups += int(atomic.LoadUint64(&counters.lookups))
+		return true
+	})
+
+	var report UsageReport
+	for _, u := range own {
+		report.Beans = append(report.Beans, *u)
+	}
+	sort.Slice(report.Beans, func(i, j int) bool {
+		if report.Beans[i].Name != report.Beans[j].Name {
+			return report.Beans[i].Name < report.Beans[j].Name
+		}
+		return report.Beans[i].Type.String() < report.Beans[j].Type.String()
+	})
+	for typ, u := range types {
+		for _, candidates := range t.getBean(typ) {
+			if candidates.level == 1 {
+				for _, b := range candidates.list {
+					u.Candidates = append(u.Candidates, b.name)
+				}
+			}
+		}
+		report.Types = append(report.Types, *u)
+	}
+	sort.Slice(report.Types, func(i, j int) bool {
+		return report.Types[i].Type.String() < report.Types[j].Type.String()
+	})
+	return report
+}
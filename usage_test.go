@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type usageStore interface {
+	Load(key string) string
+}
+
+type usageMemStore struct{}
+
+func (t *usageMemStore) Load(key string) string { return key }
+
+type usageLegacyStore struct{}
+
+type usageService struct {
+	Store usageStore `inject:""`
+}
+
+type usageClient struct {
+	Service *usageService `inject:""`
+}
+
+func TestUsageReport(t *testing.T) {
+
+	ctn, err := glue.New(
+		&usageMemStore{},
+		&usageLegacyStore{},
+		&usageService{},
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	_, err = glue.GetBean[usageStore](ctn)
+	require.NoError(t, err)
+	require.NoError(t, ctn.Inject(&usageClient{}))
+
+	report := ctn.UsageReport()
+	usage := make(map[reflect.Type]glue.BeanUsage)
+	for _, b := range report.Beans {
+		usage[b.Type] = b
+	}
+	memStore := usage[reflect.TypeOf((*usageMemStore)(nil))]
+	require.Equal(t, 1, memStore.Injections)
+	require.Equal(t, 1, memStore.Lookups)
+	require.Equal(t, 1, usage[reflect.TypeOf((*usageService)(nil))].Injections)
+
+	unused := report.Unused()
+	require.Len(t, unused, 1)
+	require.Equal(t, reflect.TypeOf((*usageLegacyStore)(nil)), unused[0].Type)
+
+	var store glue.TypeUsage
+	for _, typ := range report.Types {
+		if typ.Type == reflect.TypeOf((*usageStore)(nil)).Elem() {
+			store = typ
+		}
+	}
+	require.Equal(t, 1, store.Injections)
+	require.Equal(t, 1, store.Lookups)
+	require.Equal(t, []string{"*glue_test.usageMemStore"}, store.Candidates)
+	require.Contains(t, report.String(), "usageLegacyStore")
+}
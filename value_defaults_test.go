@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type valueDefaultsBean struct {
+	Released time.Time              `value:"app.released"`
+	Expires  time.Time              `value:"app.expires,layout=2006-01-02 15:04"`
+	Hosts    []string               `value:"app.hosts"`
+	Missing  int                    `value:"app.missing"`
+	Lookup   func() (string, error) `value:"app.lookup"`
+}
+
+func TestValueDefaults(t *testing.T) {
+
+	skipUnsupported(t, glue.FeatureProviders)
+
+	b := &valueDefaultsBean{}
+	ctn, err := glue.NewWithOptions(
+		glue.WithValueDefaults(glue.ValueDefaults{
+			TimeLayout:   "2006-01-02",
+			Separator:    ",",
+			AllowMissing: true,
+		}),
+		glue.WithBeans(b, glue.MapPropertySource{
+			"app.released": "2026-03-01",
+			"app.expires":  "2026-04-01 10:30",
+			"app.hosts":    "a, b,c",
+		}),
+	)
+	require.NoError(t, err)
+	defer ctn.Close()
+
+	require.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), b.Released)
+	require.Equal(t, time.Date(2026, 4, 1, 10, 30, 0, 0, time.UTC), b.Expires)
+	require.Equal(t, []string{"a", "b", "c"}, b.Hosts)
+	require.Equal(t, 0, b.Missing)
+
+	s, err := b.Lookup()
+	require.NoError(t, err)
+	require.Equal(t, "", s)
+
+	child := &valueDefaultsBean{}
+	childCtn, err := ctn.Extend(child)
+	require.NoError(t, err)
+	defer childCtn.Close()
+	require.Equal(t, []string{"a", "b", "c"}, child.Hosts)
+
+	_, err = glue.New(&valueDefaultsBean{}, glue.MapPropertySource{
+		"app.released": "2026-03-01T00:00:00Z",
+		"app.expires":  "2026-04-01 10:30",
+		"app.hosts":    "a",
+	})
+	require.Error(t, err)
+}
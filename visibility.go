@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"reflect"
+)
+
+/**
+Exports in the scan list limits beans of the container visible to its children by types,
+a bean is visible if its type is in the list or it implements the listed interface.
+Without Exports all beans are visible, glue.Export() with no types hides all of them.
+*/
+
+type Exports struct {
+	Types []reflect.Type
+}
+
+/**
+Imports in the scan list of the child container limits beans of parents it sees by types,
+applied together with exports of parents.
+*/
+
+type Imports struct {
+	Types []reflect.Type
+}
+
+func Export(types ...reflect.Type) *Exports {
+	return &Exports{Types: append([]reflect.Type{}, types...)}
+}
+
+func Import(types ...reflect.Type) *Imports {
+	return &Imports{Types: append([]reflect.Type{}, types...)}
+}
+
+/**
+Returns beans of the owner container visible to this one, owner is this container or its parent
+*/
+
+func (t *container) visible(owner *container, list []*bean) []*bean {
+	if owner == t || len(list) == 0 {
+		return list
+	}
+	var filters [][]reflect.Type
+	if owner.exports != nil {
+		filters = append(filters, owner.exports.Types)
+	}
+	for c := t; c != nil && c != owner; c = c.parent {
+		if c.imports != nil {
+			filters = append(filters, c.imports.Types)
+		}
+	}
+	if len(filters) == 0 {
+		return list
+	}
+	var visible []*bean
+	for _, b := range list {
+		allowed := true
+		for _, types := range filters {
+			if !typesAllow(types, b) {
+				allowed = false
+				break
+			}
+		}
+		if allowed {
+			visible = append(visible, b)
+		}
+	}
+	return visible
+}
+
+func typesAllow(types []reflect.Type, b *bean) bool {
+	if b.beanDef == nil {
+		return false
+	}
+	class := b.beanDef.classPtr
+	for _, typ := range types {
+		if typ == class || (typ.Kind() == reflect.Interface && class.Implements(typ)) {
+			return true
+		}
+	}
+	return false
+}
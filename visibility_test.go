@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type ModuleClock interface {
+	Now() int64
+}
+
+type moduleClock struct{}
+
+func (t *moduleClock) Now() int64 { return 42 }
+
+type moduleSecrets struct{}
+
+type moduleMetrics struct{}
+
+type clockUser struct {
+	Clock ModuleClock `inject:""`
+}
+
+type secretsUser struct {
+	Secrets *moduleSecrets `inject:""`
+}
+
+type metricsUser struct {
+	Metrics *moduleMetrics `inject:""`
+}
+
+var moduleClockClass = reflect.TypeOf((*ModuleClock)(nil)).Elem()
+
+func TestExport(t *testing.T) {
+
+	parent, err := glue.New(
+		glue.Export(moduleClockClass, reflect.TypeOf((*moduleMetrics)(nil))),
+		&moduleClock{},
+		&moduleSecrets{},
+		&moduleMetrics{},
+	)
+	require.NoError(t, err)
+	defer parent.Close()
+
+	user := &clockUser{}
+	child, err := parent.Extend(user, &metricsUser{})
+	require.NoError(t, err)
+	require.Equal(t, int64(42), user.Clock.Now())
+	require.NoError(t, child.Close())
+
+	_, err = parent.Extend(&secretsUser{})
+	var missing *glue.MissingDependenciesError
+	require.True(t, errors.As(err, &missing), err)
+
+	child, err = parent.Extend()
+	require.NoError(t, err)
+	defer child.Close()
+	require.Len(t, child.Bean(moduleClockClass, glue.SearchCurrentAndAllParents), 1)
+	require.Empty(t, child.Bean(reflect.TypeOf((*moduleSecrets)(nil)), glue.SearchCurrentAndAllParents))
+	require.Len(t, parent.Bean(reflect.TypeOf((*moduleSecrets)(nil)), glue.SearchCurrent), 1)
+}
+
+func TestImport(t *testing.T) {
+
+	parent, err := glue.New(&moduleClock{}, &moduleSecrets{}, &moduleMetrics{})
+	require.NoError(t, err)
+	defer parent.Close()
+
+	child, err := parent.Extend(glue.Import(moduleClockClass), &clockUser{})
+	require.NoError(t, err)
+	defer child.Close()
+	require.Empty(t, child.Bean(reflect.TypeOf((*moduleMetrics)(nil)), glue.SearchCurrentAndAllParents))
+
+	// imports of the child apply to its children too
+	_, err = child.Extend(&metricsUser{})
+	require.Error(t, err)
+
+	_, err = parent.Extend(glue.Import(moduleClockClass), &metricsUser{})
+	require.Error(t, err)
+}
@@ -0,0 +1,224 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+/**
+Wiring is the declarative manifest interpreted by New, so the composition is changed by data
+instead of the Go wiring code, e.g. glue.New(wiring, app.Beans()) with the manifest:
+
+	disable: [legacyCache]
+	enable: [auditLog]
+	select:
+	  app.Cache: redisCache
+	profiles:
+	  redisCache: prod|staging
+
+Beans are named by NamedBean, aliases or the pointer type, e.g. '*app.redisCache'.
+Several manifests are applied in the scan order, the later one wins.
+*/
+
+type Wiring struct {
+
+	/*
+		Beans skipped by the scan
+	*/
+	Disable []string `yaml:"disable"`
+
+	/*
+		Beans registered even if they were disabled or have profiles in earlier manifests
+	*/
+	Enable []string `yaml:"enable"`
+
+	/*
+		Bean injected by the interface, keys are interface types, e.g. 'app.Cache'
+	*/
+	Select map[string]string `yaml:"select"`
+
+	/*
+		Profile expressions of beans, the bean is skipped if the expression is not active
+	*/
+	Profiles map[string]string `yaml:"profiles"`
+}
+
+/**
+ParseWiring reads the YAML manifest, unknown keys are errors.
+*/
+
+func ParseWiring(content []byte) (*Wiring, error) {
+	w := &Wiring{}
+	dec := yaml.NewDecoder(bytes.NewReader(content))
+	dec.KnownFields(true)
+	if err := dec.Decode(w); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid wiring manifest: %w", err)
+	}
+	return w, nil
+}
+
+/**
+LoadWiring reads the YAML manifest from the file.
+*/
+
+func LoadWiring(fileName string) (*Wiring, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	w, err := ParseWiring(content)
+	if err != nil {
+		return nil, fmt.Errorf("wiring file '%s': %w", fileName, err)
+	}
+	return w, nil
+}
+
+/**
+Manifests of the scan list merged in the scan order
+*/
+
+type wiringPlan struct {
+	enabled  map[string]bool
+	profiles map[string]string
+	selects  map[string]string
+	matched  map[string]bool
+}
+
+func collectWiring(active map[string]struct{}, scan []any) (*wiringPlan, error) {
+	var plan *wiringPlan
+	err := forEach(active, "", scan, func(pos string, obj any) error {
+		w, ok := unwrapBeanObj(obj).(*Wiring)
+		if !ok {
+			return nil
+		}
+		if plan == nil {
+			plan = &wiringPlan{
+				enabled:  make(map[string]bool),
+				profiles: make(map[string]string),
+				selects:  make(map[string]string),
+				matched:  make(map[string]bool),
+			}
+		}
+		for name, expr := range w.Profiles {
+			plan.profiles[name] = expr
+		}
+		for _, name := range w.Disable {
+			plan.enabled[name] = false
+		}
+		for _, name := range w.Enable {
+			plan.enabled[name] = true
+			delete(plan.profiles, name)
+		}
+		for iface, name := range w.Select {
+			plan.selects[iface] = name
+		}
+		return nil
+	})
+	return plan, err
+}
+
+/**
+Returns the reason if the scan item is excluded by the manifest
+*/
+
+func (t *wiringPlan) skip(active map[string]struct{}, item any) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+	for _, name := range scanNames(item) {
+		if enabled, ok := t.enabled[name]; ok {
+			t.matched[name] = true
+			if !enabled {
+				return fmt.Sprintf("disabled '%s'", name), true
+			}
+		}
+		if expr, ok := t.profiles[name]; ok {
+			t.matched[name] = true
+			if !isProfileActive(active, expr) {
+				return fmt.Sprintf("profile '%s' of '%s' is not active", expr, name), true
+			}
+		}
+	}
+	return "", false
+}
+
+/**
+Returns names of the manifest matched no scan item, sorted
+*/
+
+func (t *wiringPlan) unmatched() []string {
+	if t == nil {
+		return nil
+	}
+	var list []string
+	for name := range t.enabled {
+		if !t.matched[name] {
+			list = append(list, name)
+		}
+	}
+	for name := range t.profiles {
+		if _, ok := t.enabled[name]; !ok && !t.matched[name] {
+			list = append(list, name)
+		}
+	}
+	sort.Strings(list)
+	return list
+}
+
+/**
+Returns the bean name, aliases and the type of the scan item
+*/
+
+func scanNames(item any) []string {
+	obj, options := unwrapBean(item)
+	if obj == nil {
+		return nil
+	}
+	var names []string
+	if named, ok := obj.(NamedBean); ok {
+		names = append(names, named.BeanName())
+	}
+	if len(options) > 0 {
+		b := &bean{}
+		for _, opt := range options {
+			opt(b)
+		}
+		names = append(names, b.aliases...)
+	}
+	return append(names, reflect.TypeOf(obj).String())
+}
+
+/**
+Keeps only the bean selected by the manifest for the interface, all candidates if it is not found
+*/
+
+func (t *container) selectWired(ifaceType reflect.Type, candidates []*bean) []*bean {
+	name, ok := t.wiringSelect[ifaceType.String()]
+	if !ok || len(candidates) == 0 {
+		return candidates
+	}
+	for _, b := range candidates {
+		if b.name == name || b.beanDef.classPtr.String() == name {
+			return []*bean{b}
+		}
+		for _, alias := range b.aliases {
+			if alias == name {
+				return []*bean{b}
+			}
+		}
+	}
+	t.logf(LogInfo, "Wiring selection '%s' of '%v' matched no candidate\n", name, ifaceType)
+	return candidates
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.arpabet.com/glue"
+)
+
+type wiringStore interface {
+	Kind() string
+}
+
+type wiringMemoryStore struct{}
+
+func (t *wiringMemoryStore) Kind() string { return "memory" }
+
+type wiringRedisStore struct{}
+
+func (t *wiringRedisStore) Kind() string     { return "redis" }
+func (t *wiringRedisStore) BeanName() string { return "redisStore" }
+
+type wiringAudit struct{}
+
+type wiringService struct {
+	Store wiringStore  `inject:""`
+	Audit *wiringAudit `inject:"optional"`
+}
+
+func TestWiringManifest(t *testing.T) {
+
+	w, err := glue.ParseWiring([]byte(`
+disable: ['*glue_test.wiringAudit']
+select:
+  glue_test.wiringStore: redisStore
+profiles:
+  redisStore: prod
+`))
+	require.NoError(t, err)
+
+	service := &wiringService{}
+	ctn, err := glue.NewWithProfiles([]string{"prod"}, w, &wiringMemoryStore{}, &wiringRedisStore{}, &wiringAudit{}, service)
+	require.NoError(t, err)
+	require.Equal(t, "redis", service.Store.Kind())
+	require.Nil(t, service.Audit)
+	ctn.Close()
+
+	// the profile of the selected bean is not active, the selection falls back to all candidates
+	service = &wiringService{}
+	ctn, err = glue.NewWithProfiles([]string{"dev"}, w, &wiringMemoryStore{}, &wiringRedisStore{}, &wiringAudit{}, service)
+	require.NoError(t, err)
+	require.Equal(t, "memory", service.Store.Kind())
+	ctn.Close()
+
+	// the later manifest enables the bean
+	service = &wiringService{}
+	enable := &glue.Wiring{Enable: []string{"*glue_test.wiringAudit"}}
+	ctn, err = glue.New(w, enable, &wiringMemoryStore{}, &wiringAudit{}, service)
+	require.NoError(t, err)
+	require.NotNil(t, service.Audit)
+	ctn.Close()
+}
+
+func TestWiringFile(t *testing.T) {
+
+	fileName := filepath.Join(t.TempDir(), "wiring.yaml")
+	require.NoError(t, os.WriteFile(fileName, []byte("disable: [redisStore]\n"), 0644))
+
+	w, err := glue.LoadWiring(fileName)
+	require.NoError(t, err)
+	require.Equal(t, []string{"redisStore"}, w.Disable)
+
+	service := &wiringService{}
+	ctn, err := glue.New(w, &wiringMemoryStore{}, &wiringRedisStore{}, service)
+	require.NoError(t, err)
+	defer ctn.Close()
+	require.Equal(t, "memory", service.Store.Kind())
+
+	_, err = glue.ParseWiring([]byte("unknown: [a]\n"))
+	require.Error(t, err)
+
+	w, err = glue.ParseWiring(nil)
+	require.NoError(t, err)
+	require.Empty(t, w.Disable)
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2026 Karagatan LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package glue
+
+import (
+	"time"
+)
+
+/**
+Bean wrapper carries registration options for the object that can not declare them by implementing interfaces.
+Nested wrappers are flattened, so options of the outer wrapper are applied after the inner ones.
+*/
+
+type beanWrapper struct {
+	obj        any
+	options    []func(*bean)
+	conditions []beanCondition
+}
+
+/**
+Condition evaluated after property sources are loaded and all unconditional beans are scanned
+*/
+
+type beanCondition func(c *container) bool
+
+func wrapBean(obj any, option func(*bean)) *beanWrapper {
+	if w, ok := obj.(*beanWrapper); ok {
+		return &beanWrapper{
+			obj:        w.obj,
+			options:    append(append([]func(*bean){}, w.options...), option),
+			conditions: w.conditions,
+		}
+	}
+	return &beanWrapper{
+		obj:     obj,
+		options: []func(*bean){option},
+	}
+}
+
+func conditionalBean(obj any, condition beanCondition) *beanWrapper {
+	if w, ok := obj.(*beanWrapper); ok {
+		return &beanWrapper{
+			obj:        w.obj,
+			options:    w.options,
+			conditions: append(append([]beanCondition{}, w.conditions...), condition),
+		}
+	}
+	return &beanWrapper{
+		obj:        obj,
+		conditions: []beanCondition{condition},
+	}
+}
+
+func beanConditions(obj any) []beanCondition {
+	if w, ok := obj.(*beanWrapper); ok {
+		return w.conditions
+	}
+	return nil
+}
+
+func unwrapBean(obj any) (any, []func(*bean)) {
+	if w, ok := obj.(*beanWrapper); ok {
+		return w.obj, w.options
+	}
+	return obj, nil
+}
+
+func unwrapBeanObj(obj any) any {
+	obj, _ = unwrapBean(obj)
+	return obj
+}
+
+/**
+Alias registers the object with additional names used by Container.Lookup and inject:"bean=..." qualifier.
+Aliases of the factory bean name the produced bean.
+*/
+
+func Alias(obj any, aliases ...string) any {
+	return wrapBean(obj, func(b *bean) {
+		b.aliases = append(b.aliases, aliases...)
+	})
+}
+
+/**
+Primary registers the object as the primary bean selected for single-valued injection among several candidates.
+Slice and map injections still receive all candidates. Primary factory bean produces the primary bean.
+*/
+
+func Primary(obj any) any {
+	return wrapBean(obj, func(b *bean) {
+		b.primary = true
+	})
+}
+
+/**
+ConcreteOnly registers the object injectable only by its own pointer type and name, never by interfaces it implements.
+Lifecycle and listener interfaces of the object are still called by the container.
+Concrete only factory produces the bean injectable only by the product type.
+*/
+
+func ConcreteOnly(obj any) any {
+	return wrapBean(obj, func(b *bean) {
+		b.concreteOnly = true
+	})
+}
+
+/**
+LifecycleTimeout limits PostConstruct and Destroy of the object, zero keeps the container timeout.
+*/
+
+func LifecycleTimeout(obj any, postConstruct, destroy time.Duration) any {
+	return wrapBean(obj, func(b *bean) {
+		b.postConstructTimeout = postConstruct
+		b.destroyTimeout = destroy
+	})
+}
+
+/**
+Described registers the object with the human readable description shown in Container.Describe() and Graph().
+Overrides the description returned by DescribedBean.
+*/
+
+func Described(obj any, description string) any {
+	return wrapBean(obj, func(b *bean) {
+		b.description = description
+	})
+}
+
+/**
+Order registers the object with the order used for collection injection and lookups, as if it implemented OrderedBean,
+for third-party beans that can not implement the interface. Ordered factory produces the ordered bean.
+*/
+
+func Order(obj any, order int) any {
+	return wrapBean(obj, func(b *bean) {
+		b.ordered = true
+		b.order = order
+	})
+}